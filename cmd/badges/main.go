@@ -66,7 +66,7 @@ func main() {
 
 	addr := ":8080"
 	log.Info().Str(uniqueCode, "1876ce1e").Str("addr", addr).Msg("serving")
-	http.ListenAndServe(addr, nil).Error()
+	_ = http.ListenAndServe(addr, nil)
 }
 
 func calculate(category string, wage int, res []processor.LanguageSummary) (string, int64) {
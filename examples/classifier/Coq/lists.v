@@ -0,0 +1,44 @@
+(* Basic facts about lists used by the rest of the development *)
+Require Import Coq.Lists.List.
+Require Import Coq.Arith.PeanoNat.
+Import ListNotations.
+
+Set Implicit Arguments.
+
+Section Lists.
+
+Variable A : Type.
+
+Fixpoint rev_append (l acc : list A) : list A :=
+  match l with
+  | [] => acc
+  | x :: xs => rev_append xs (x :: acc)
+  end.
+
+Definition fast_rev (l : list A) : list A := rev_append l [].
+
+Lemma rev_append_spec : forall l acc,
+  rev_append l acc = rev l ++ acc.
+Proof.
+  induction l as [| x xs IH]; intros acc; simpl.
+  - reflexivity.
+  - rewrite IH. rewrite <- app_assoc. reflexivity.
+Qed.
+
+Theorem fast_rev_correct : forall l, fast_rev l = rev l.
+Proof.
+  intros l. unfold fast_rev. rewrite rev_append_spec.
+  apply app_nil_r.
+Qed.
+
+Lemma length_app : forall l1 l2 : list A,
+  length (l1 ++ l2) = length l1 + length l2.
+Proof.
+  intros l1 l2. induction l1 as [| x xs IH].
+  - reflexivity.
+  - simpl. rewrite IH. reflexivity.
+Qed.
+
+End Lists.
+
+Hint Rewrite rev_append_spec : lists.
@@ -0,0 +1,46 @@
+Inductive day : Type :=
+  | monday
+  | tuesday
+  | wednesday
+  | thursday
+  | friday
+  | saturday
+  | sunday.
+
+Definition next_weekday (d : day) : day :=
+  match d with
+  | monday => tuesday
+  | tuesday => wednesday
+  | wednesday => thursday
+  | thursday => friday
+  | _ => monday
+  end.
+
+Example test_next_weekday :
+  next_weekday (next_weekday saturday) = tuesday.
+Proof. simpl. reflexivity. Qed.
+
+Fixpoint double (n : nat) : nat :=
+  match n with
+  | O => O
+  | S n' => S (S (double n'))
+  end.
+
+Lemma double_plus : forall n, double n = n + n.
+Proof.
+  intros n. induction n as [| n' IHn'].
+  - reflexivity.
+  - simpl. rewrite IHn'. rewrite <- plus_n_Sm. reflexivity.
+Qed.
+
+Theorem plus_comm' : forall n m : nat, n + m = m + n.
+Proof.
+  intros n m. induction n as [| n' IHn'].
+  - simpl. rewrite <- plus_n_O. reflexivity.
+  - simpl. rewrite IHn'. rewrite plus_n_Sm. reflexivity.
+Qed.
+
+Module Playground.
+  Definition b : bool := true.
+  Check b.
+End Playground.
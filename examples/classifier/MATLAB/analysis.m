@@ -0,0 +1,38 @@
+% Load the measurements and plot the spectrum of each channel
+clear; close all; clc;
+
+data = load('measurements.mat');
+fs = 1000;              % sampling frequency in Hz
+t = (0:length(data.signal) - 1) / fs;
+
+figure('Name', 'Signal');
+subplot(2, 1, 1);
+plot(t, data.signal, 'b-', 'LineWidth', 1.5);
+xlabel('Time (s)');
+ylabel('Amplitude');
+title('Raw signal');
+grid on;
+
+nfft = 2^nextpow2(length(data.signal));
+spectrum = abs(fft(data.signal, nfft)) / nfft;
+f = fs / 2 * linspace(0, 1, nfft / 2 + 1);
+
+subplot(2, 1, 2);
+plot(f, 2 * spectrum(1:nfft / 2 + 1), 'r');
+xlabel('Frequency (Hz)');
+ylabel('|Y(f)|');
+title('Single sided amplitude spectrum');
+
+[peak, idx] = max(spectrum(1:nfft / 2 + 1));
+fprintf('Peak of %.3f at %.1f Hz\n', peak, f(idx));
+
+results = struct('peak', peak, 'frequency', f(idx));
+save('results.mat', '-struct', 'results');
+
+for channel = 1:size(data.matrix, 2)
+    if any(isnan(data.matrix(:, channel)))
+        disp(['Channel ', num2str(channel), ' has missing values']);
+    else
+        fprintf('Channel %d mean %.2f\n', channel, mean(data.matrix(:, channel)));
+    end
+end
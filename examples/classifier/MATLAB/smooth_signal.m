@@ -0,0 +1,28 @@
+function y = smooth_signal(x, window)
+%SMOOTH_SIGNAL Moving average of a signal.
+%   Y = SMOOTH_SIGNAL(X, WINDOW) returns the moving average of X using
+%   a window of WINDOW samples. The ends are padded with the first and
+%   last values.
+
+if nargin < 2
+    window = 5;
+end
+
+if ~isvector(x)
+    error('smooth_signal:input', 'X must be a vector');
+end
+
+n = numel(x);
+half = floor(window / 2);
+padded = [repmat(x(1), 1, half), x(:)', repmat(x(end), 1, half)];
+y = zeros(size(x));
+
+for k = 1:n
+    y(k) = mean(padded(k:k + window - 1));
+end
+
+% Keep the orientation of the input
+if iscolumn(x)
+    y = y(:);
+end
+end
@@ -0,0 +1,33 @@
+#import "AppDelegate.h"
+#import "RootViewController.h"
+#import <UIKit/UIKit.h>
+
+@interface AppDelegate ()
+@property (nonatomic, strong) RootViewController *rootViewController;
+@end
+
+@implementation AppDelegate
+
+- (BOOL)application:(UIApplication *)application didFinishLaunchingWithOptions:(NSDictionary *)launchOptions {
+    self.window = [[UIWindow alloc] initWithFrame:[[UIScreen mainScreen] bounds]];
+    self.rootViewController = [[RootViewController alloc] initWithNibName:nil bundle:nil];
+
+    UINavigationController *navigation = [[UINavigationController alloc] initWithRootViewController:self.rootViewController];
+    self.window.rootViewController = navigation;
+    [self.window makeKeyAndVisible];
+
+    NSLog(@"Launched with options %@", launchOptions);
+    return YES;
+}
+
+- (void)applicationDidEnterBackground:(UIApplication *)application {
+    [[NSUserDefaults standardUserDefaults] synchronize];
+}
+
+- (void)applicationWillTerminate:(UIApplication *)application {
+    if (self.rootViewController != nil) {
+        [self.rootViewController saveState];
+    }
+}
+
+@end
@@ -0,0 +1,45 @@
+#import <Foundation/Foundation.h>
+
+@interface Person : NSObject <NSCopying>
+
+@property (nonatomic, copy) NSString *name;
+@property (nonatomic, assign) NSInteger age;
+
+- (instancetype)initWithName:(NSString *)name age:(NSInteger)age;
+- (NSString *)greeting;
+
+@end
+
+@implementation Person
+
+- (instancetype)initWithName:(NSString *)name age:(NSInteger)age {
+    self = [super init];
+    if (self) {
+        _name = [name copy];
+        _age = age;
+    }
+    return self;
+}
+
+- (NSString *)greeting {
+    return [NSString stringWithFormat:@"Hello, my name is %@ and I am %ld", self.name, (long)self.age];
+}
+
+- (id)copyWithZone:(NSZone *)zone {
+    return [[Person allocWithZone:zone] initWithName:self.name age:self.age];
+}
+
+@end
+
+int main(int argc, const char * argv[]) {
+    @autoreleasepool {
+        NSMutableArray<Person *> *people = [NSMutableArray array];
+        [people addObject:[[Person alloc] initWithName:@"Ada" age:36]];
+        [people addObject:[[Person alloc] initWithName:@"Alan" age:41]];
+
+        for (Person *person in people) {
+            NSLog(@"%@", [person greeting]);
+        }
+    }
+    return 0;
+}
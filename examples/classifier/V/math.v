@@ -0,0 +1,46 @@
+module geometry
+
+import math
+
+pub struct Point {
+pub:
+	x f64
+	y f64
+}
+
+pub fn (p Point) distance(q Point) f64 {
+	dx := p.x - q.x
+	dy := p.y - q.y
+	return math.sqrt(dx * dx + dy * dy)
+}
+
+pub fn centroid(points []Point) ?Point {
+	if points.len == 0 {
+		return error('no points')
+	}
+	mut sx := 0.0
+	mut sy := 0.0
+	for p in points {
+		sx += p.x
+		sy += p.y
+	}
+	return Point{sx / points.len, sy / points.len}
+}
+
+pub enum Shape {
+	circle
+	square
+}
+
+pub fn area(shape Shape, size f64) f64 {
+	return match shape {
+		.circle { math.pi * size * size }
+		.square { size * size }
+	}
+}
+
+fn test_distance() {
+	a := Point{0, 0}
+	b := Point{3, 4}
+	assert a.distance(b) == 5.0
+}
@@ -0,0 +1,57 @@
+module main
+
+import net.http
+import json
+import time
+
+struct User {
+	id   int
+	name string
+mut:
+	visits int
+}
+
+struct App {
+mut:
+	users map[string]User
+	started time.Time
+}
+
+fn (mut app App) add_user(name string) User {
+	user := User{
+		id: app.users.len + 1
+		name: name
+	}
+	app.users[name] = user
+	return user
+}
+
+fn (app App) uptime() string {
+	return '${time.since(app.started)}'
+}
+
+fn handle(mut app App, req http.Request) string {
+	match req.url {
+		'/users' {
+			return json.encode(app.users.values())
+		}
+		'/uptime' {
+			return app.uptime()
+		}
+		else {
+			return 'not found'
+		}
+	}
+}
+
+fn main() {
+	mut app := App{
+		started: time.now()
+	}
+	app.add_user('alice')
+	app.add_user('bob')
+	println('serving ${app.users.len} users')
+	for name, user in app.users {
+		println('${name}: ${user.id}')
+	}
+}
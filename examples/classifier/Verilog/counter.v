@@ -0,0 +1,50 @@
+// Simple synchronous counter with enable and terminal count output
+`timescale 1ns / 1ps
+
+module counter #(
+    parameter WIDTH = 8
+) (
+    input  wire             clk,
+    input  wire             rst_n,
+    input  wire             enable,
+    output reg  [WIDTH-1:0] count,
+    output wire             done
+);
+
+    assign done = (count == {WIDTH{1'b1}});
+
+    always @(posedge clk or negedge rst_n) begin
+        if (!rst_n) begin
+            count <= {WIDTH{1'b0}};
+        end else if (enable) begin
+            count <= count + 1'b1;
+        end
+    end
+
+endmodule
+
+module counter_tb;
+    reg clk = 0;
+    reg rst_n = 0;
+    reg enable = 0;
+    wire [7:0] count;
+    wire done;
+
+    counter #(.WIDTH(8)) dut (
+        .clk(clk),
+        .rst_n(rst_n),
+        .enable(enable),
+        .count(count),
+        .done(done)
+    );
+
+    always #5 clk = ~clk;
+
+    initial begin
+        $dumpfile("counter.vcd");
+        $dumpvars(0, counter_tb);
+        #20 rst_n = 1;
+        #10 enable = 1;
+        #3000 $finish;
+    end
+endmodule
@@ -0,0 +1,54 @@
+module uart_tx (
+    input            clk,
+    input            reset,
+    input            start,
+    input      [7:0] data,
+    output reg       tx,
+    output reg       busy
+);
+    localparam IDLE  = 2'd0;
+    localparam START = 2'd1;
+    localparam DATA  = 2'd2;
+    localparam STOP  = 2'd3;
+
+    reg [1:0] state;
+    reg [2:0] bit_index;
+    reg [7:0] shift;
+
+    always @(posedge clk) begin
+        if (reset) begin
+            state <= IDLE;
+            tx    <= 1'b1;
+            busy  <= 1'b0;
+        end else begin
+            case (state)
+                IDLE: begin
+                    tx <= 1'b1;
+                    if (start) begin
+                        shift <= data;
+                        busy  <= 1'b1;
+                        state <= START;
+                    end
+                end
+                START: begin
+                    tx        <= 1'b0;
+                    bit_index <= 3'd0;
+                    state     <= DATA;
+                end
+                DATA: begin
+                    tx    <= shift[bit_index];
+                    if (bit_index == 3'd7)
+                        state <= STOP;
+                    else
+                        bit_index <= bit_index + 3'd1;
+                end
+                STOP: begin
+                    tx    <= 1'b1;
+                    busy  <= 1'b0;
+                    state <= IDLE;
+                end
+                default: state <= IDLE;
+            endcase
+        end
+    end
+endmodule
@@ -0,0 +1,4 @@
+# this file is added
+
+if True:
+    print("hi")
@@ -0,0 +1,12 @@
+package main
+
+import "fmt"
+
+// main prints a greeting
+func main() {
+	name := "world"
+
+	if name != "" {
+		fmt.Println("hello", name)
+	}
+}
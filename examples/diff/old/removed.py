@@ -0,0 +1,2 @@
+# this file is removed
+print("bye")
@@ -0,0 +1 @@
+export const vendored = true;
@@ -0,0 +1,2 @@
+# built by widget
+x = 1
@@ -44,9 +44,7 @@ func main() {
 			if processor.ConfigureLimits != nil {
 				processor.ConfigureLimits()
 			}
-			processor.ConfigureGc()
-			processor.ConfigureLazy(true)
-			processor.Process()
+			processor.ProcessConfig(processor.FlagConfig())
 		},
 	}
 
@@ -58,12 +56,42 @@ func main() {
 		false,
 		"disable binary file detection",
 	)
+	flags.BoolVar(
+		&processor.GuessEncoding,
+		"guess-encoding",
+		false,
+		"transcode UTF-16 and UTF-32 files without a byte order mark to UTF-8 before counting",
+	)
 	flags.BoolVar(
 		&processor.Files,
 		"by-file",
 		false,
 		"display output for every file",
 	)
+	flags.BoolVar(
+		&processor.ByFunction,
+		"by-function",
+		false,
+		"display lines and complexity for every function in languages which support it",
+	)
+	flags.BoolVar(
+		&processor.ByDir,
+		"by-dir",
+		false,
+		"display output for every directory rolled up to --dir-depth with the languages in each",
+	)
+	flags.IntVar(
+		&processor.DirDepth,
+		"dir-depth",
+		1,
+		"number of directories below each path to roll up to with --by-dir",
+	)
+	flags.StringVar(
+		&processor.CacheDir,
+		"cache-dir",
+		"",
+		"cache counts in this directory between runs so unchanged files are not read again",
+	)
 	flags.BoolVar(
 		&processor.Ci,
 		"ci",
@@ -76,6 +104,18 @@ func main() {
 		false,
 		"disables .ignore file logic",
 	)
+	flags.BoolVar(
+		&processor.Sccrc,
+		"no-sccrc",
+		false,
+		"disables .sccrc file logic",
+	)
+	flags.BoolVar(
+		&processor.GitAttributes,
+		"no-gitattributes",
+		false,
+		"disables .gitattributes file logic",
+	)
 	flags.BoolVar(
 		&processor.GitIgnore,
 		"no-gitignore",
@@ -88,6 +128,12 @@ func main() {
 		false,
 		"enable debug output",
 	)
+	flags.BoolVar(
+		&processor.Diff,
+		"diff",
+		false,
+		"compare two files, directories or git revisions reporting added and removed lines [e.g. scc --diff HEAD~1 .]",
+	)
 	flags.StringSliceVar(
 		&processor.PathDenyList,
 		"exclude-dir",
@@ -105,7 +151,7 @@ func main() {
 		"format",
 		"f",
 		"tabular",
-		"set output format [tabular, wide, json, csv, csv-stream, cloc-yaml, html, html-table, sql, sql-insert, openmetrics]",
+		"set output format ["+strings.Join(processor.FormatterNames(), ", ")+"]",
 	)
 	flags.StringSliceVarP(
 		&processor.AllowListExtensions,
@@ -218,6 +264,24 @@ func main() {
 		false,
 		"identify generated files",
 	)
+	flags.BoolVar(
+		&processor.GitTracked,
+		"git-tracked",
+		false,
+		"count only files tracked by git reading the repository index",
+	)
+	flags.BoolVar(
+		&processor.GitStaged,
+		"git-staged",
+		false,
+		"count the staged content of files tracked by git rather than the working tree",
+	)
+	flags.BoolVar(
+		&processor.GitHead,
+		"git-head",
+		false,
+		"count the files and content committed at HEAD rather than the working tree",
+	)
 	flags.StringSliceVarP(
 		&processor.GeneratedMarkers,
 		"generated-markers",
@@ -243,6 +307,18 @@ func main() {
 		false,
 		"ignore generated files in output (implies --gen)",
 	)
+	flags.BoolVar(
+		&processor.Vendored,
+		"vendored",
+		false,
+		"identify vendored files",
+	)
+	flags.BoolVar(
+		&processor.IgnoreVendored,
+		"no-vendored",
+		false,
+		"ignore vendored files in output (implies --vendored)",
+	)
 	flags.IntVar(
 		&processor.MinifiedGeneratedLineByteLength,
 		"min-gen-line-length",
@@ -315,6 +391,60 @@ func main() {
 		1000000,
 		"number of bytes a file can contain before being removed from output",
 	)
+	flags.Int64Var(
+		&processor.MaxComplexity,
+		"max-complexity",
+		0,
+		"complexity above which a file is a violation, 0 to disable",
+	)
+	flags.Int64Var(
+		&processor.MaxLines,
+		"max-lines",
+		0,
+		"number of lines above which a file is a violation, 0 to disable",
+	)
+	flags.Float64Var(
+		&processor.MaxComplexityLines,
+		"max-complexity-lines",
+		0,
+		"complexity for every 100 lines of code above which a file is a violation, 0 to disable",
+	)
+	flags.BoolVar(
+		&processor.ViolationMinified,
+		"violation-min",
+		false,
+		"make any file identified as minified a violation",
+	)
+	flags.BoolVar(
+		&processor.ViolationGenerated,
+		"violation-gen",
+		false,
+		"make any file identified as generated a violation",
+	)
+	flags.BoolVar(
+		&processor.FailOnViolation,
+		"fail-on-violation",
+		false,
+		"exit with code 2 when any file is a violation",
+	)
+	flags.StringVar(
+		&processor.QualityGates,
+		"quality-gates",
+		"",
+		"YAML file of rules files, languages and the total must keep within exiting with code 3 if any fail",
+	)
+	flags.StringVar(
+		&processor.CompareTo,
+		"compare-to",
+		"",
+		"compare the count to a previous report written by --format json showing the change for each language or file with --by-file [tabular, json, markdown]",
+	)
+	flags.StringVar(
+		&processor.LanguagesFile,
+		"languages-file",
+		os.Getenv(processor.LanguagesFileEnv),
+		"load additional or overriding languages from a JSON file in the same format as languages.json (env "+processor.LanguagesFileEnv+")",
+	)
 	flags.StringVar(
 		&processor.CountAs,
 		"count-as",
@@ -345,6 +475,12 @@ func main() {
 		"",
 		"inspect every file and remap by checking for a string and remapping the language [e.g. \"-*- C++ -*-\":\"C Header\"]",
 	)
+	flags.DurationVar(
+		&processor.Timeout,
+		"timeout",
+		0,
+		"stop processing after this long outputting partial results and exiting with an error [e.g. 30s, 5m]",
+	)
 	flags.StringVar(
 		&processor.CurrencySymbol,
 		"currency-symbol",
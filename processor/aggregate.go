@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+// languageAggregator accumulates the totals for each language as files arrive so summary output only
+// needs memory for each language rather than for every file. Files are only held onto when the output
+// lists them and functions only when it lists those.
+type languageAggregator struct {
+	keepFiles     bool
+	keepFunctions bool
+	languages     map[string]*LanguageSummary
+	total         LanguageSummary // Totals over every language where Count is the number of files
+	functions     []functionResult
+}
+
+func newLanguageAggregator(keepFiles bool, keepFunctions bool) *languageAggregator {
+	return &languageAggregator{
+		keepFiles:     keepFiles,
+		keepFunctions: keepFunctions,
+		languages:     map[string]*LanguageSummary{},
+	}
+}
+
+// add includes the file in the totals for its language and the overall total
+func (a *languageAggregator) add(res *FileJob) {
+	summary, ok := a.languages[res.Language]
+	if !ok {
+		summary = &LanguageSummary{Name: res.Language}
+		a.languages[res.Language] = summary
+	}
+
+	addToSummary(summary, res)
+	addToSummary(&a.total, res)
+
+	if a.keepFiles {
+		summary.Files = append(summary.Files, res)
+	}
+	if a.keepFunctions {
+		a.functions = append(a.functions, collectFunctions([]*FileJob{res})...)
+	}
+}
+
+// consume adds every file from the input returning the aggregator once the input is closed
+func (a *languageAggregator) consume(input chan *FileJob) *languageAggregator {
+	for res := range input {
+		a.add(res)
+	}
+
+	return a
+}
+
+// summaries returns the summary for every language seen in no particular order
+func (a *languageAggregator) summaries() []LanguageSummary {
+	language := make([]LanguageSummary, 0, len(a.languages))
+	for _, summary := range a.languages {
+		if summary.Files == nil {
+			summary.Files = []*FileJob{}
+		}
+		language = append(language, *summary)
+	}
+
+	return language
+}
+
+func addToSummary(summary *LanguageSummary, res *FileJob) {
+	summary.Count++
+	summary.Bytes += res.Bytes
+	summary.Lines += res.Lines
+	summary.Code += res.Code
+	summary.Comment += res.Comment
+	summary.Blank += res.Blank
+	summary.Complexity += res.Complexity
+	summary.CognitiveComplexity += res.CognitiveComplexity
+	summary.WeightedComplexity += res.WeightedComplexity
+}
+
+// weightedComplexity is the complexity of the file for every 100 lines of code which is set once it is counted
+func weightedComplexity(res *FileJob) float64 {
+	if res.Code == 0 {
+		return 0
+	}
+
+	return (float64(res.Complexity) / float64(res.Code)) * 100
+}
+
+func aggregateLanguageSummary(input chan *FileJob, keepFiles bool) []LanguageSummary {
+	return newLanguageAggregator(keepFiles, false).consume(input).summaries()
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLanguageAggregator(t *testing.T) {
+	aggregate := newLanguageAggregator(false, false)
+	aggregate.add(&FileJob{Language: "Go", Lines: 10, Code: 8, Complexity: 2, WeightedComplexity: 25, Bytes: 100})
+	aggregate.add(&FileJob{Language: "Go", Lines: 5, Code: 4, Complexity: 4, WeightedComplexity: 100, Bytes: 50})
+	aggregate.add(&FileJob{Language: "Python", Lines: 3, Code: 0, Bytes: 30})
+
+	if aggregate.total.Count != 3 || aggregate.total.Lines != 18 || aggregate.total.Code != 12 || aggregate.total.Bytes != 180 {
+		t.Errorf("unexpected total %+v", aggregate.total)
+	}
+
+	goSummary, _ := findLanguageSummary(aggregate.summaries(), "Go")
+	if goSummary.Count != 2 || goSummary.Lines != 15 || goSummary.Complexity != 6 || goSummary.WeightedComplexity != 125 {
+		t.Errorf("unexpected Go summary %+v", goSummary)
+	}
+	if len(goSummary.Files) != 0 {
+		t.Errorf("expected files not to be kept got %d", len(goSummary.Files))
+	}
+}
+
+func TestLanguageAggregatorKeep(t *testing.T) {
+	aggregate := newLanguageAggregator(true, true)
+	aggregate.add(&FileJob{Language: "Go", Location: "a.go", Functions: []FunctionJob{{Name: "a"}, {Name: "b"}}})
+	aggregate.add(&FileJob{Language: "Go", Location: "b.go"})
+
+	goSummary, _ := findLanguageSummary(aggregate.summaries(), "Go")
+	if len(goSummary.Files) != 2 {
+		t.Errorf("expected files to be kept got %d", len(goSummary.Files))
+	}
+	if len(aggregate.functions) != 2 || aggregate.functions[0].Location != "a.go" {
+		t.Errorf("expected functions to be kept got %v", aggregate.functions)
+	}
+}
+
+func TestFileSummarizeMultiFanOut(t *testing.T) {
+	dir := t.TempDir()
+	FormatMulti = strings.Join([]string{
+		"tabular:stdout",
+		"json:" + filepath.Join(dir, "out.json"),
+		"csv-stream:" + filepath.Join(dir, "out.csv"),
+		"cloc-yaml:" + filepath.Join(dir, "out.yaml"),
+	}, ",")
+	defer func() { FormatMulti = "" }()
+
+	// More files than any of the buffers between the outputs to make sure none block the others
+	inputChan := make(chan *FileJob)
+	go func() {
+		for i := 0; i < 1000; i++ {
+			inputChan <- &FileJob{Language: "Go", Location: "main.go", Filename: "main.go", Code: 1}
+		}
+		close(inputChan)
+	}()
+
+	res := fileSummarize(inputChan)
+	if !strings.Contains(res, "1000") {
+		t.Errorf("expected tabular output on stdout got %s", res)
+	}
+
+	for name, expected := range map[string]string{
+		"out.json": `"Code":1000`,
+		"out.csv":  `Go,"main.go","main.go",0,1,`,
+		"out.yaml": "nFiles: 1000",
+	} {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("expected %s to contain %s got %s", name, expected, content)
+		}
+	}
+}
+
+func TestCounterReleasesContent(t *testing.T) {
+	dir := t.TempDir()
+	_ = os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0644)
+
+	config := NewConfig()
+	config.Files = true
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summary[0].Files[0].Content != nil {
+		t.Error("expected content to be released once counted")
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/boyter/scc/v3/processor/gitignore"
+	"github.com/minio/blake2b-simd"
+)
+
+// GitAttributesFilename is the name of the file holding the linguist attributes which is picked up during the walk
+const GitAttributesFilename = ".gitattributes"
+
+// loadGitAttributes reads the .gitattributes at path with patterns relative to base adding it to those of the
+// parent settings. Deeper files take precedence as git does.
+func (c *Counter) loadGitAttributes(path string, base string, parent *directorySettings) (*directorySettings, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if parent == nil {
+		parent = c.rootSettings()
+	}
+
+	settings := *parent
+
+	// The parent is shared with sibling directories so never append into its backing arrays
+	attributes := gitignore.NewGitAttributesFromReader(base, bytes.NewReader(data))
+	settings.attributes = append(parent.attributes[:len(parent.attributes):len(parent.attributes)], attributes)
+
+	sum := blake2b.Sum256(data)
+	settings.attributesHashes = append(parent.attributesHashes[:len(parent.attributesHashes):len(parent.attributesHashes)], fmt.Sprintf("%x", sum[:8]))
+
+	settings.fingerprint = settings.computeFingerprint()
+	return &settings, nil
+}
+
+// linguistLanguage returns the languages set by linguist-language for the file at path
+func (c *Counter) linguistLanguage(settings *directorySettings, path string) ([]string, bool) {
+	if settings == nil || len(settings.attributes) == 0 {
+		return nil, false
+	}
+
+	name, ok := gitignore.Attribute(settings.attributes, path, "linguist-language")
+	if !ok {
+		return nil, false
+	}
+
+	language, ok := c.resolveLanguage(name)
+	if !ok && Verbose {
+		printWarn(fmt.Sprintf("unknown linguist-language %s in %s for %s", name, GitAttributesFilename, path))
+	}
+
+	return language, ok
+}
+
+// linguistAttribute returns if the linguist attribute such as linguist-vendored or linguist-generated is set
+// or unset for the file at path. It is false when the attribute is not specified.
+func (settings *directorySettings) linguistAttribute(path string, name string) (bool, bool) {
+	if settings == nil || len(settings.attributes) == 0 {
+		return false, false
+	}
+
+	value, ok := gitignore.Attribute(settings.attributes, path, name)
+	if !ok {
+		return false, false
+	}
+
+	switch strings.ToLower(value) {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+
+	return false, false
+}
+
+// resolveLanguage finds the languages for a name from a .gitattributes file or modeline the same way as
+// --count-as does. Names cannot contain spaces in either so it tries again with - as a space.
+func (c *Counter) resolveLanguage(name string) ([]string, bool) {
+	language, ok := resolveCountAs(name, c.languageDatabase, c.extensionToLanguage)
+	if !ok {
+		language, ok = resolveCountAs(strings.ReplaceAll(name, "-", " "), c.languageDatabase, c.extensionToLanguage)
+	}
+
+	if ok {
+		for _, l := range language {
+			c.loadLanguageFeature(l)
+		}
+	}
+
+	return language, ok
+}
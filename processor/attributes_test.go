@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func gitAttributesFiles(t *testing.T, config Config) map[string]*FileJob {
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{"../examples/gitattributes/"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	found := map[string]*FileJob{}
+	for _, l := range summary {
+		for _, f := range l.Files {
+			found[f.Filename] = f
+		}
+	}
+	return found
+}
+
+func TestGitAttributesHierarchy(t *testing.T) {
+	config := NewConfig()
+	config.Files = true
+	config.Generated = true
+	config.Vendored = true
+
+	found := gitAttributesFiles(t, config)
+
+	expected := map[string]string{
+		"header.inc": "PHP",
+		"shared.inc": "Objective C",
+		"api.pb.go":  "Go (gen)",
+		"sub.pb.go":  "Go",
+		"lib.js":     "JavaScript (vendored)",
+		"build":      "Python",
+		"notes.txt":  "Ruby",
+	}
+
+	for name, language := range expected {
+		if f, ok := found[name]; !ok || f.Language != language {
+			t.Errorf("expected %s to be %s got %v", name, language, f)
+		}
+	}
+
+	for name, f := range found {
+		if f.Vendored != (name == "lib.js") {
+			t.Errorf("unexpected vendored %v for %s", f.Vendored, name)
+		}
+	}
+}
+
+func TestGitAttributesDisabled(t *testing.T) {
+	config := NewConfig()
+	config.Files = true
+	config.Generated = true
+	config.NoGitAttributes = true
+
+	found := gitAttributesFiles(t, config)
+
+	if _, ok := found["header.inc"]; ok {
+		t.Error("expected header.inc to be an unknown extension without .gitattributes")
+	}
+	if f := found["sub.pb.go"]; f == nil || f.Language != "Go (gen)" {
+		t.Errorf("expected the markers to decide without .gitattributes got %v", f)
+	}
+	if f := found["lib.js"]; f == nil || f.Vendored {
+		t.Errorf("expected lib.js to not be vendored without .gitattributes got %v", f)
+	}
+}
+
+func TestLoadGitAttributesInherits(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+	dir := t.TempDir()
+
+	_ = os.WriteFile(filepath.Join(dir, SccrcFilename), []byte("count-as:\n  wdg: Go\n"), 0600)
+	_ = os.WriteFile(filepath.Join(dir, GitAttributesFilename), []byte("*.wdg linguist-language=Python linguist-vendored\n"), 0600)
+
+	rc, err := counter.loadSccrc(filepath.Join(dir, SccrcFilename), nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	settings, err := counter.loadGitAttributes(filepath.Join(dir, GitAttributesFilename), dir, rc)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if language, ok := settings.countAsLanguage("a.wdg"); !ok || language[0] != "Go" {
+		t.Errorf("expected the .sccrc settings to be kept got %v", language)
+	}
+	if language, ok := counter.linguistLanguage(settings, filepath.Join(dir, "a.wdg")); !ok || language[0] != "Python" {
+		t.Errorf("expected Python got %v", language)
+	}
+	if vendored, ok := settings.linguistAttribute(filepath.Join(dir, "a.wdg"), "linguist-vendored"); !ok || !vendored {
+		t.Error("expected a.wdg to be vendored")
+	}
+	if settings.fingerprint == rc.fingerprint {
+		t.Error("expected the fingerprint to change with the attributes")
+	}
+
+	// Children of a .sccrc below keep the attributes from above
+	child, err := counter.loadSccrc(filepath.Join(dir, SccrcFilename), settings)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, ok := counter.linguistLanguage(child, filepath.Join(dir, "sub", "b.wdg")); !ok {
+		t.Error("expected the attributes to carry on below a .sccrc")
+	}
+}
+
+func TestResolveLanguage(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+
+	cases := map[string]string{
+		"python":      "Python",
+		"Objective-C": "Objective C",
+		"c++":         "C++",
+		"rb":          "Ruby",
+	}
+
+	for name, expected := range cases {
+		if language, ok := counter.resolveLanguage(name); !ok || language[0] != expected {
+			t.Errorf("expected %s for %s got %v", expected, name, language)
+		}
+	}
+
+	if _, ok := counter.resolveLanguage("not-a-language"); ok {
+		t.Error("expected unknown language to not resolve")
+	}
+}
@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/minio/blake2b-simd"
+)
+
+// cacheFormatVersion should be bumped whenever cacheEntry changes so old caches are ignored
+const cacheFormatVersion = 6
+
+// cacheEntry holds everything needed to rebuild a counted FileJob without reading the file
+type cacheEntry struct {
+	Bytes         int64
+	ModTime       int64
+	ContentHash   []byte
+	DuplicateHash []byte
+	Settings      string
+	Language      string
+	Confidence    float64
+	Lines         int64
+	Code          int64
+	Comment       int64
+	Blank         int64
+	Complexity    int64
+	Cognitive     int64
+	Binary        bool
+	Minified      bool
+	Generated     bool
+	GeneratedRule string
+	Functions     []FunctionJob
+}
+
+type cacheFile struct {
+	Version int
+	Entries map[string]cacheEntry
+}
+
+// fileCache is the on disk cache of file counts used to skip reading and counting unchanged files.
+// Entries are keyed by file location and checked against size and modification time first, falling
+// back to a content hash when only the modification time has changed.
+type fileCache struct {
+	path    string
+	mux     sync.Mutex
+	old     map[string]cacheEntry
+	entries map[string]cacheEntry
+}
+
+// cacheFingerprint identifies everything which changes how a file is counted. Any difference in the
+// language database, the version of scc or the counting options results in a different cache file.
+func (c *Counter) cacheFingerprint(paths []string) string {
+	database, _ := json.Marshal(c.languageDatabase)
+
+	absPaths := []string{}
+	for _, p := range paths {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			abs = p
+		}
+		absPaths = append(absPaths, abs)
+	}
+
+	options, _ := json.Marshal(struct {
+		Version                         string
+		Paths                           []string
+		NoComplexity                    bool
+		Functions                       bool
+		DisableCheckBinary              bool
+		GuessEncoding                   bool
+		Minified                        bool
+		Generated                       bool
+		GeneratedMarkers                []string
+		MinifiedGeneratedLineByteLength int
+		Vendored                        bool
+		CountAs                         string
+		RemapUnknown                    string
+		RemapAll                        string
+	}{
+		Version:                         Version,
+		Paths:                           absPaths,
+		NoComplexity:                    c.Config.NoComplexity,
+		Functions:                       c.Config.Functions,
+		DisableCheckBinary:              c.Config.DisableCheckBinary,
+		GuessEncoding:                   c.Config.GuessEncoding,
+		Minified:                        c.Config.Minified,
+		Generated:                       c.Config.Generated,
+		GeneratedMarkers:                c.Config.GeneratedMarkers,
+		MinifiedGeneratedLineByteLength: c.Config.MinifiedGeneratedLineByteLength,
+		Vendored:                        c.Config.Vendored,
+		CountAs:                         c.Config.CountAs,
+		RemapUnknown:                    c.Config.RemapUnknown,
+		RemapAll:                        c.Config.RemapAll,
+	})
+
+	digest := blake2b.New256()
+	_, _ = digest.Write(database)
+	_, _ = digest.Write(options)
+	return hex.EncodeToString(digest.Sum(nil))[:32]
+}
+
+// loadFileCache loads the cache for the fingerprint from the directory. A missing or unreadable
+// cache is not an error as it will just be rebuilt.
+func loadFileCache(dir string, fingerprint string) *fileCache {
+	cache := &fileCache{
+		path:    filepath.Join(dir, "scc-"+fingerprint+".cache"),
+		old:     map[string]cacheEntry{},
+		entries: map[string]cacheEntry{},
+	}
+
+	data, err := os.ReadFile(cache.path)
+	if err != nil {
+		if Verbose && !os.IsNotExist(err) {
+			printWarn(fmt.Sprintf("unable to read cache %s: %v", cache.path, err))
+		}
+		return cache
+	}
+
+	var stored cacheFile
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&stored); err != nil || stored.Version != cacheFormatVersion {
+		if Verbose {
+			printWarn(fmt.Sprintf("ignoring invalid cache %s", cache.path))
+		}
+		return cache
+	}
+
+	cache.old = stored.Entries
+	if Debug {
+		printDebug(fmt.Sprintf("loaded %d cache entries from %s", len(cache.old), cache.path))
+	}
+
+	return cache
+}
+
+// lookup returns the cached entry for the job if its size and modification time are unchanged
+func (cache *fileCache) lookup(job *FileJob) (cacheEntry, bool) {
+	cache.mux.Lock()
+	defer cache.mux.Unlock()
+
+	entry, ok := cache.old[job.Location]
+	if !ok || entry.Bytes != job.Bytes || entry.ModTime != job.modTime || entry.Settings != job.settingsFingerprint() {
+		return cacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+// lookupContent returns the cached entry for the job if the file has been touched but its content
+// is the same as when it was cached
+func (cache *fileCache) lookupContent(job *FileJob, contentHash []byte) (cacheEntry, bool) {
+	cache.mux.Lock()
+	defer cache.mux.Unlock()
+
+	entry, ok := cache.old[job.Location]
+	if !ok || entry.Bytes != job.Bytes || entry.Settings != job.settingsFingerprint() || !bytes.Equal(entry.ContentHash, contentHash) {
+		return cacheEntry{}, false
+	}
+
+	entry.ModTime = job.modTime
+	return entry, true
+}
+
+func (cache *fileCache) store(location string, entry cacheEntry) {
+	cache.mux.Lock()
+	cache.entries[location] = entry
+	cache.mux.Unlock()
+}
+
+// save writes out the cache. When the run was complete only the files seen are kept so
+// deleted files do not build up, otherwise everything previously cached is kept as well.
+func (cache *fileCache) save(complete bool) error {
+	cache.mux.Lock()
+	defer cache.mux.Unlock()
+
+	entries := cache.entries
+	if !complete {
+		entries = map[string]cacheEntry{}
+		for k, v := range cache.old {
+			entries[k] = v
+		}
+		for k, v := range cache.entries {
+			entries[k] = v
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(cacheFile{Version: cacheFormatVersion, Entries: entries}); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cache.path), 0755); err != nil {
+		return err
+	}
+
+	// Write then rename so a run killed part way never leaves a truncated cache behind
+	tmp, err := os.CreateTemp(filepath.Dir(cache.path), "scc-*.tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), cache.path)
+}
+
+// newCacheEntry captures the counts of a job which has just been processed
+func newCacheEntry(job *FileJob, contentHash []byte, duplicateHash []byte) cacheEntry {
+	return cacheEntry{
+		Bytes:         job.Bytes,
+		ModTime:       job.modTime,
+		ContentHash:   contentHash,
+		DuplicateHash: duplicateHash,
+		Settings:      job.settingsFingerprint(),
+		Language:      job.Language,
+		Confidence:    job.LanguageConfidence,
+		Lines:         job.Lines,
+		Code:          job.Code,
+		Comment:       job.Comment,
+		Blank:         job.Blank,
+		Complexity:    job.Complexity,
+		Cognitive:     job.CognitiveComplexity,
+		Binary:        job.Binary,
+		Minified:      job.Minified,
+		Generated:     job.Generated,
+		GeneratedRule: job.GeneratedRule,
+		Functions:     job.Functions,
+	}
+}
+
+// apply restores the cached counts onto the job
+func (entry cacheEntry) apply(job *FileJob) {
+	job.Language = entry.Language
+	job.LanguageConfidence = entry.Confidence
+	job.Lines = entry.Lines
+	job.Code = entry.Code
+	job.Comment = entry.Comment
+	job.Blank = entry.Blank
+	job.Complexity = entry.Complexity
+	job.CognitiveComplexity = entry.Cognitive
+	job.WeightedComplexity = weightedComplexity(job)
+	job.Binary = entry.Binary
+	job.Minified = entry.Minified
+	job.Generated = entry.Generated
+	job.GeneratedRule = entry.GeneratedRule
+	job.Functions = entry.Functions
+	job.cachedHash = entry.DuplicateHash
+}
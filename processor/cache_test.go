@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeCacheTestFile(t *testing.T, path string, content string, modTime time.Time) {
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCacheSkipsUnchangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	cacheDir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	modTime := time.Now().Add(-time.Hour)
+
+	writeCacheTestFile(t, path, "package main\n\nfunc main() {\n}\n", modTime)
+
+	config := NewConfig()
+	config.CacheDir = cacheDir
+
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{dir})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if summary[0].Code != 3 || summary[0].Blank != 1 {
+		t.Fatalf("unexpected first run %+v", summary[0])
+	}
+
+	// Same size and modification time so the file must not be read again which
+	// means the stale counts from the cache are returned
+	writeCacheTestFile(t, path, "package main\n// comment\n}}}}}\n", modTime)
+
+	summary, err = mustNewCounter(t, config).Run(context.Background(), []string{dir})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if summary[0].Code != 3 || summary[0].Blank != 1 || summary[0].Comment != 0 {
+		t.Errorf("expected cached counts got %+v", summary[0])
+	}
+
+	// A new modification time with different content must be counted again
+	writeCacheTestFile(t, path, "package main\n// comment\n}}}}}\n", modTime.Add(time.Minute))
+
+	summary, err = mustNewCounter(t, config).Run(context.Background(), []string{dir})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if summary[0].Code != 2 || summary[0].Blank != 0 || summary[0].Comment != 1 {
+		t.Errorf("expected recounted file got %+v", summary[0])
+	}
+}
+
+func TestCacheHitKeepsJSONStreamHash(t *testing.T) {
+	dir := t.TempDir()
+	writeCacheTestFile(t, filepath.Join(dir, "main.go"), "package main\n\nfunc main() {\n}\n", time.Now().Add(-time.Hour))
+
+	config := NewConfig()
+	config.CacheDir = t.TempDir()
+	config.NoDuplicates = true
+
+	stream := func() string {
+		queue, wait := mustNewCounter(t, config).start(context.Background(), []string{dir})
+
+		var buf bytes.Buffer
+		if err := writeJSONStream(queue, &buf); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if err := wait(); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		return buf.String()
+	}
+
+	counted := stream()
+	if !bytes.Contains([]byte(counted), []byte(`"Hash":"`)) {
+		t.Fatalf("expected a hash for the counted file got %s", counted)
+	}
+
+	if cached := stream(); cached != counted {
+		t.Errorf("expected the cached run to match the counted one got\n%s\nexpected\n%s", cached, counted)
+	}
+}
+
+func TestCacheContentHashFallback(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	modTime := time.Now().Add(-time.Hour)
+
+	writeCacheTestFile(t, path, "package main\n", modTime)
+
+	counter := mustNewCounter(t, NewConfig())
+	fingerprint := counter.cacheFingerprint([]string{dir})
+	cache := loadFileCache(t.TempDir(), fingerprint)
+
+	contentHash := []byte{1, 2, 3}
+	cache.old[path] = cacheEntry{Bytes: 13, ModTime: modTime.UnixNano(), ContentHash: contentHash, Language: "Go", Code: 1}
+
+	job := &FileJob{Location: path, Bytes: 13, modTime: modTime.Add(time.Second).UnixNano()}
+	if _, ok := cache.lookup(job); ok {
+		t.Error("expected lookup to miss on modification time change")
+	}
+
+	entry, ok := cache.lookupContent(job, contentHash)
+	if !ok || entry.Code != 1 || entry.ModTime != job.modTime {
+		t.Errorf("expected content hash hit with updated time got %+v %v", entry, ok)
+	}
+
+	if _, ok := cache.lookupContent(job, []byte{3, 2, 1}); ok {
+		t.Error("expected lookup to miss on content change")
+	}
+}
+
+func TestCacheSaveLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	cache := loadFileCache(dir, "test")
+	cache.old["removed.go"] = cacheEntry{Bytes: 1}
+	cache.store("main.go", cacheEntry{Bytes: 10, Language: "Go", Code: 5})
+
+	if err := cache.save(true); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	loaded := loadFileCache(dir, "test")
+	if len(loaded.old) != 1 || loaded.old["main.go"].Code != 5 {
+		t.Errorf("expected only seen entries after complete run got %+v", loaded.old)
+	}
+
+	loaded.store("other.go", cacheEntry{Bytes: 2})
+	if err := loaded.save(false); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	loaded = loadFileCache(dir, "test")
+	if len(loaded.old) != 2 {
+		t.Errorf("expected old entries kept after incomplete run got %+v", loaded.old)
+	}
+}
+
+func TestCacheInvalidFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "scc-test.cache"), []byte("not a cache"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cache := loadFileCache(dir, "test")
+	if len(cache.old) != 0 {
+		t.Error("expected invalid cache to be ignored")
+	}
+}
+
+func TestCacheFingerprint(t *testing.T) {
+	config := NewConfig()
+	base := mustNewCounter(t, config).cacheFingerprint([]string{"."})
+
+	if base != mustNewCounter(t, config).cacheFingerprint([]string{"."}) {
+		t.Error("expected fingerprint to be stable")
+	}
+
+	config.NoComplexity = true
+	if base == mustNewCounter(t, config).cacheFingerprint([]string{"."}) {
+		t.Error("expected no complexity to change fingerprint")
+	}
+
+	config = NewConfig()
+	config.CountAs = "go:python"
+	if base == mustNewCounter(t, config).cacheFingerprint([]string{"."}) {
+		t.Error("expected count as to change fingerprint")
+	}
+
+	config = NewConfig()
+	if base == mustNewCounter(t, config).cacheFingerprint([]string{"processor"}) {
+		t.Error("expected paths to change fingerprint")
+	}
+
+	// Options which only filter results can reuse the same counts
+	config.NoLarge = true
+	if base != mustNewCounter(t, config).cacheFingerprint([]string{"."}) {
+		t.Error("expected no large to keep fingerprint")
+	}
+}
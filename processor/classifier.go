@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// classifierMinimumConfidence is the probability the classifier needs to give a language before it is
+// trusted over the keyword heuristic
+const classifierMinimumConfidence = 0.8
+
+// classifierMaxBytes is how much of each file is looked at which matches the keyword heuristic
+const classifierMaxBytes = 20_000
+
+// languageClassifier is a naive Bayes classifier over the tokens of the samples in examples/classifier
+// which go generate builds into classifierSamples
+type languageClassifier struct {
+	languages map[string]*classifierLanguage
+	// vocabularies caches the vocabulary of each set of possible languages keyed by their joined names
+	vocabularies sync.Map
+}
+
+type classifierLanguage struct {
+	tokens map[string]int
+	total  int
+}
+
+var classifierOnce sync.Once
+var classifierModel *languageClassifier
+
+// getClassifier trains the classifier the first time a file with more than one possible language is seen
+func getClassifier() *languageClassifier {
+	classifierOnce.Do(func() {
+		startTime := makeTimestampMilli()
+
+		data, err := base64.StdEncoding.DecodeString(classifierSamples)
+		if err != nil {
+			panic(fmt.Sprintf("failed to base64 decode classifier samples: %v", err))
+		}
+
+		var samples map[string][]string
+		if err := json.Unmarshal(data, &samples); err != nil {
+			panic(fmt.Sprintf("classifier samples json invalid: %v", err))
+		}
+
+		classifierModel = newLanguageClassifier(samples)
+
+		if Trace {
+			printTrace(fmt.Sprintf("milliseconds to train classifier: %d", makeTimestampMilli()-startTime))
+		}
+	})
+
+	return classifierModel
+}
+
+func newLanguageClassifier(samples map[string][]string) *languageClassifier {
+	classifier := &languageClassifier{languages: map[string]*classifierLanguage{}}
+
+	for name, contents := range samples {
+		language := &classifierLanguage{tokens: map[string]int{}}
+		for _, content := range contents {
+			for _, token := range classifierTokens([]byte(content)) {
+				language.tokens[token]++
+				language.total++
+			}
+		}
+		classifier.languages[name] = language
+	}
+
+	return classifier
+}
+
+// vocabulary returns every token in the samples of the possible languages or nil if any of them has no samples.
+// Files with the same extension ask about the same languages so it is only built once for each set of them.
+func (c *languageClassifier) vocabulary(possibleLanguages []string) map[string]bool {
+	key := strings.Join(possibleLanguages, ",")
+	if vocabulary, ok := c.vocabularies.Load(key); ok {
+		return vocabulary.(map[string]bool)
+	}
+
+	vocabulary := map[string]bool{}
+	for _, name := range possibleLanguages {
+		language, ok := c.languages[name]
+		if !ok {
+			vocabulary = nil
+			break
+		}
+		for token := range language.tokens {
+			vocabulary[token] = true
+		}
+	}
+
+	c.vocabularies.Store(key, vocabulary)
+	return vocabulary
+}
+
+// classify returns the most likely of the possible languages for the content along with its probability
+// and the probability of every other language. It is false when there is nothing to go on which is when
+// any of the languages has no samples or none of the tokens in the content appear in them.
+func (c *languageClassifier) classify(possibleLanguages []string, content []byte) (string, map[string]float64, bool) {
+	if len(possibleLanguages) < 2 {
+		return "", nil, false
+	}
+
+	vocabulary := c.vocabulary(possibleLanguages)
+	if vocabulary == nil {
+		return "", nil, false
+	}
+
+	if len(content) > classifierMaxBytes {
+		content = content[:classifierMaxBytes]
+	}
+
+	// Every language is equally likely up front so only the tokens count, with add one smoothing for those
+	// a language has not seen. Tokens no language has seen say nothing and would favour the smallest samples.
+	// Each token is only counted once so the punctuation of long files does not drown out the words.
+	scores := map[string]float64{}
+	seen := map[string]bool{}
+	for _, token := range classifierTokens(content) {
+		if !vocabulary[token] || seen[token] {
+			continue
+		}
+		seen[token] = true
+
+		for _, name := range possibleLanguages {
+			language := c.languages[name]
+			scores[name] += math.Log(float64(language.tokens[token]+1) / float64(language.total+len(vocabulary)))
+		}
+	}
+
+	if len(seen) == 0 {
+		return "", nil, false
+	}
+
+	names := append([]string{}, possibleLanguages...)
+	sort.Slice(names, func(i, j int) bool {
+		if scores[names[i]] == scores[names[j]] {
+			return names[i] < names[j]
+		}
+		return scores[names[i]] > scores[names[j]]
+	})
+
+	// Turn the log likelihoods into probabilities relative to the best to avoid underflow
+	var sum float64
+	probabilities := map[string]float64{}
+	for _, name := range names {
+		probabilities[name] = math.Exp(scores[name] - scores[names[0]])
+		sum += probabilities[name]
+	}
+	for name := range probabilities {
+		probabilities[name] /= sum
+	}
+
+	return names[0], probabilities, true
+}
+
+// classifierTokens splits content into words and single punctuation characters. Words keep a leading @, #,
+// \ or ! as those set apart things such as @interface, #import, \begin and !Ref. Numbers and whitespace are
+// dropped as they say little about the language.
+func classifierTokens(content []byte) []string {
+	var tokens []string
+
+	for i := 0; i < len(content); {
+		b := content[i]
+
+		switch {
+		case isClassifierWordStart(b), (b == '@' || b == '#' || b == '\\' || b == '!') && i+1 < len(content) && isClassifierWordStart(content[i+1]):
+			j := i + 1
+			for j < len(content) && isClassifierWord(content[j]) {
+				j++
+			}
+			tokens = append(tokens, string(content[i:j]))
+			i = j
+		case b >= '0' && b <= '9':
+			for i < len(content) && isClassifierWord(content[i]) {
+				i++
+			}
+		case b <= ' ' || b >= 0x80:
+			i++
+		default:
+			tokens = append(tokens, string(b))
+			i++
+		}
+	}
+
+	return tokens
+}
+
+func isClassifierWordStart(b byte) bool {
+	return b == '_' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+func isClassifierWord(b byte) bool {
+	return isClassifierWordStart(b) || (b >= '0' && b <= '9')
+}
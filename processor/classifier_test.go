@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClassifierTokens(t *testing.T) {
+	got := classifierTokens([]byte("@interface Foo : NSObject\n#import x_1 = 42;\n\\begin{doc} !Ref a1b"))
+	expected := []string{"@interface", "Foo", ":", "NSObject", "#import", "x_1", "=", ";", "\\begin", "{", "doc", "}", "!Ref", "a1b"}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v got %v", expected, got)
+	}
+}
+
+func TestClassifierTokensEmpty(t *testing.T) {
+	if got := classifierTokens([]byte(" \n\t 123 ")); len(got) != 0 {
+		t.Errorf("expected no tokens got %v", got)
+	}
+}
+
+func TestClassifierClassify(t *testing.T) {
+	classifier := newLanguageClassifier(map[string][]string{
+		"Cats": {"meow purr whiskers meow", "purr hiss"},
+		"Dogs": {"woof bark fetch woof", "growl bark"},
+	})
+
+	language, probabilities, ok := classifier.classify([]string{"Cats", "Dogs"}, []byte("the dog would bark and woof"))
+	if !ok || language != "Dogs" {
+		t.Fatalf("expected Dogs got %s %v", language, ok)
+	}
+	if probabilities["Dogs"] <= probabilities["Cats"] || probabilities["Dogs"]+probabilities["Cats"] < 0.999 {
+		t.Errorf("unexpected probabilities %v", probabilities)
+	}
+}
+
+func TestClassifierClassifyNothingToCompare(t *testing.T) {
+	classifier := newLanguageClassifier(map[string][]string{
+		"Cats": {"meow purr"},
+		"Dogs": {"woof bark"},
+	})
+
+	if _, _, ok := classifier.classify([]string{"Cats", "Dogs"}, []byte("nothing in common")); ok {
+		t.Error("expected no result when no token is known")
+	}
+	if _, _, ok := classifier.classify([]string{"Cats", "Birds"}, []byte("meow")); ok {
+		t.Error("expected no result when a language has no samples")
+	}
+	if _, _, ok := classifier.classify([]string{"Cats"}, []byte("meow")); ok {
+		t.Error("expected no result for a single language")
+	}
+}
+
+func TestClassifierVocabularyCached(t *testing.T) {
+	classifier := newLanguageClassifier(map[string][]string{
+		"Cats": {"meow purr"},
+		"Dogs": {"woof bark"},
+	})
+
+	first := classifier.vocabulary([]string{"Cats", "Dogs"})
+	if len(first) != 4 {
+		t.Fatalf("expected 4 tokens got %v", first)
+	}
+
+	first["added"] = true
+	if second := classifier.vocabulary([]string{"Cats", "Dogs"}); !second["added"] {
+		t.Error("expected the same vocabulary to be returned for the same languages")
+	}
+
+	if vocabulary := classifier.vocabulary([]string{"Cats", "Birds"}); vocabulary != nil {
+		t.Errorf("expected no vocabulary when a language has no samples got %v", vocabulary)
+	}
+	if _, ok := classifier.vocabularies.Load("Cats,Birds"); !ok {
+		t.Error("expected languages without samples to be cached as well")
+	}
+}
+
+func TestClassifierClassifyTie(t *testing.T) {
+	classifier := newLanguageClassifier(map[string][]string{
+		"Dogs": {"pet"},
+		"Cats": {"pet"},
+	})
+
+	language, probabilities, ok := classifier.classify([]string{"Dogs", "Cats"}, []byte("pet"))
+	if !ok || language != "Cats" || probabilities["Cats"] != 0.5 {
+		t.Errorf("expected a tie broken by name got %s %v", language, probabilities)
+	}
+}
+
+func TestClassifierSamples(t *testing.T) {
+	tests := []struct {
+		possible []string
+		content  string
+		want     string
+	}{
+		{[]string{"MATLAB", "Objective C"}, "function y = double_it(x)\n  % doubles\n  y = x * 2;\nend\n", "MATLAB"},
+		{[]string{"MATLAB", "Objective C"}, "#import <Foundation/Foundation.h>\n@implementation Thing\n- (void)run {\n  NSLog(@\"hi\");\n}\n@end\n", "Objective C"},
+		{[]string{"Coq", "Verilog", "V"}, "Theorem plus_zero : forall n : nat, n + 0 = n.\nProof.\n  induction n; simpl; auto.\nQed.\n", "Coq"},
+		{[]string{"Coq", "Verilog", "V"}, "module blink(input wire clk, output reg led);\n  always @(posedge clk) led <= ~led;\nendmodule\n", "Verilog"},
+		{[]string{"Coq", "Verilog", "V"}, "fn main() {\n\tmut name := 'world'\n\tprintln('hello \\$name')\n}\n", "V"},
+	}
+
+	for _, test := range tests {
+		language, probabilities, ok := getClassifier().classify(test.possible, []byte(test.content))
+		if !ok || language != test.want {
+			t.Errorf("expected %s got %s %v for %s", test.want, language, probabilities, test.content)
+		}
+	}
+}
+
+func TestDetermineLanguageConfidence(t *testing.T) {
+	ProcessConstants()
+
+	language, confidence := globalCounter().determineLanguageConfidence("thing.m", "", []string{"MATLAB", "Objective C"}, []byte("#import <Foundation/Foundation.h>\n@interface Thing : NSObject\n@property (nonatomic) NSString *name;\n@end\n"))
+	if language != "Objective C" || confidence < classifierMinimumConfidence {
+		t.Errorf("expected Objective C with confidence got %s %f", language, confidence)
+	}
+
+	language, confidence = globalCounter().determineLanguageConfidence("thing.rs", "", []string{"Rust"}, []byte("fn main() {}"))
+	if language != "Rust" || confidence != 0 {
+		t.Errorf("expected Rust with no confidence got %s %f", language, confidence)
+	}
+}
+
+func TestDetermineLanguageConfidenceFallsBackToKeywords(t *testing.T) {
+	ProcessConstants()
+
+	// Nothing here is in the samples so the keywords decide, and YAML being the primary wins
+	language, confidence := globalCounter().determineLanguageConfidence("thing.yml", "", []string{"YAML", "CloudFormation (YAML)"}, []byte("zzz"))
+	if language != "YAML" || confidence != 0 {
+		t.Errorf("expected YAML from keywords got %s %f", language, confidence)
+	}
+}
@@ -0,0 +1,409 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var tabularCompareBreak = "──────────────────────────────────────────────────────────────────────────────────────\n"
+var tabularCompareBreakCi = "--------------------------------------------------------------------------------------\n"
+var tabularCompareFormatHead = "%-20s %6s %8s %8s %9s %8s %11s %9s\n"
+var tabularCompareFormatBody = "%-20s %+6d %+8d %+8d %+9d %+8d %+11d %9s\n"
+var tabularCompareFormatFile = "%s %+8d %+8d %+9d %+8d %+11d %9s\n"
+
+// CompareUnchanged is the status of a language or file with the same counts as the previous report
+const CompareUnchanged = "unchanged"
+
+// FileCompare holds the change in the counts of a file since the previous report. The status is one of
+// DiffAdded, DiffRemoved, DiffModified or CompareUnchanged.
+type FileCompare struct {
+	Language   string
+	Location   string
+	Status     string
+	Lines      int64
+	Code       int64
+	Comment    int64
+	Blank      int64
+	Complexity int64
+}
+
+// LanguageCompare holds the change in the counts of a language since the previous report where Count is
+// the change in the number of files
+type LanguageCompare struct {
+	Name       string
+	Status     string
+	Count      int64
+	Lines      int64
+	Code       int64
+	Comment    int64
+	Blank      int64
+	Complexity int64
+	Files      []*FileCompare `json:",omitempty"`
+}
+
+// loadCompareReport reads the output of a previous run using --format json
+func loadCompareReport(path string) ([]LanguageSummary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var language []LanguageSummary
+	if err := json.Unmarshal(data, &language); err != nil {
+		return nil, fmt.Errorf("unable to read %s as the output of --format json: %v", path, err)
+	}
+
+	return language, nil
+}
+
+// reportHasFiles is true when the report was written with --by-file and so lists every file
+func reportHasFiles(language []LanguageSummary) bool {
+	for _, summary := range language {
+		if summary.Count != 0 && len(summary.Files) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compareStatus works out the status from if it existed before and now along with if anything changed
+func compareStatus(before bool, after bool, changed bool) string {
+	switch {
+	case !before:
+		return DiffAdded
+	case !after:
+		return DiffRemoved
+	case changed:
+		return DiffModified
+	}
+
+	return CompareUnchanged
+}
+
+// compareLanguageSummary works out the change in every language and with --by-file every file between
+// the previous report and the current one. Languages and files only in one of them are added or removed.
+func compareLanguageSummary(previous []LanguageSummary, current []LanguageSummary) []LanguageCompare {
+	before := map[string]LanguageSummary{}
+	for _, summary := range previous {
+		before[summary.Name] = summary
+	}
+	after := map[string]LanguageSummary{}
+	for _, summary := range current {
+		after[summary.Name] = summary
+	}
+
+	names := map[string]bool{}
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+
+	language := []LanguageCompare{}
+	for name := range names {
+		b, inBefore := before[name]
+		a, inAfter := after[name]
+
+		compare := LanguageCompare{
+			Name:       name,
+			Count:      a.Count - b.Count,
+			Lines:      a.Lines - b.Lines,
+			Code:       a.Code - b.Code,
+			Comment:    a.Comment - b.Comment,
+			Blank:      a.Blank - b.Blank,
+			Complexity: a.Complexity - b.Complexity,
+		}
+
+		if Files {
+			compare.Files = compareFiles(name, b.Files, a.Files)
+		}
+
+		changed := compare.Count != 0 || compare.Lines != 0 || compare.Code != 0 || compare.Comment != 0 || compare.Blank != 0 || compare.Complexity != 0
+		for _, f := range compare.Files {
+			changed = changed || f.Status != CompareUnchanged
+		}
+		compare.Status = compareStatus(inBefore, inAfter, changed)
+
+		language = append(language, compare)
+	}
+
+	sort.Slice(language, func(i, j int) bool {
+		ci := abs(language[i].Code)
+		cj := abs(language[j].Code)
+		if ci == cj {
+			return strings.Compare(language[i].Name, language[j].Name) < 0
+		}
+
+		return ci > cj
+	})
+
+	return language
+}
+
+// compareLocation is the location relative to the working directory with forward slashes so files counted
+// from ., ./ or the absolute path of the same directory are matched to each other
+func compareLocation(wd string, location string) string {
+	location = filepath.Clean(location)
+	if filepath.IsAbs(location) && wd != "" {
+		if rel, err := filepath.Rel(wd, location); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			location = rel
+		}
+	}
+
+	return filepath.ToSlash(location)
+}
+
+func compareFiles(language string, previous []*FileJob, current []*FileJob) []*FileCompare {
+	wd, _ := os.Getwd()
+
+	before := map[string]*FileJob{}
+	for _, f := range previous {
+		before[compareLocation(wd, f.Location)] = f
+	}
+
+	var files []*FileCompare
+	seen := map[string]bool{}
+	for _, a := range current {
+		location := compareLocation(wd, a.Location)
+		seen[location] = true
+
+		b, inBefore := before[location]
+		if !inBefore {
+			b = &FileJob{}
+		}
+
+		f := &FileCompare{
+			Language:   language,
+			Location:   location,
+			Lines:      a.Lines - b.Lines,
+			Code:       a.Code - b.Code,
+			Comment:    a.Comment - b.Comment,
+			Blank:      a.Blank - b.Blank,
+			Complexity: a.Complexity - b.Complexity,
+		}
+		f.Status = compareStatus(inBefore, true, f.Lines != 0 || f.Code != 0 || f.Comment != 0 || f.Blank != 0 || f.Complexity != 0)
+		files = append(files, f)
+	}
+
+	for location, b := range before {
+		if seen[location] {
+			continue
+		}
+
+		files = append(files, &FileCompare{
+			Language:   language,
+			Location:   location,
+			Status:     DiffRemoved,
+			Lines:      -b.Lines,
+			Code:       -b.Code,
+			Comment:    -b.Comment,
+			Blank:      -b.Blank,
+			Complexity: -b.Complexity,
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return strings.Compare(files[i].Location, files[j].Location) < 0
+	})
+
+	return files
+}
+
+func abs(i int64) int64 {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+func compareTotal(language []LanguageCompare) LanguageCompare {
+	total := LanguageCompare{Name: "Total"}
+	for _, summary := range language {
+		total.Count += summary.Count
+		total.Lines += summary.Lines
+		total.Code += summary.Code
+		total.Comment += summary.Comment
+		total.Blank += summary.Blank
+		total.Complexity += summary.Complexity
+	}
+
+	return total
+}
+
+func getTabularCompareBreak() string {
+	if Ci {
+		return tabularCompareBreakCi
+	}
+
+	return tabularCompareBreak
+}
+
+func compareToJSON(language []LanguageCompare) string {
+	jsonString, _ := json.Marshal(language)
+	return string(jsonString)
+}
+
+func compareToTabular(language []LanguageCompare) string {
+	var str strings.Builder
+
+	str.WriteString(getTabularCompareBreak())
+	str.WriteString(fmt.Sprintf(tabularCompareFormatHead, "Language", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity", "Status"))
+
+	if !Files {
+		str.WriteString(getTabularCompareBreak())
+	}
+
+	for _, summary := range language {
+		if Files {
+			str.WriteString(getTabularCompareBreak())
+		}
+
+		trimmedName := summary.Name
+		if len(summary.Name) > shortNameTruncate {
+			trimmedName = summary.Name[:shortNameTruncate-1] + "…"
+		}
+
+		str.WriteString(fmt.Sprintf(tabularCompareFormatBody, trimmedName, summary.Count, summary.Lines, summary.Code, summary.Comment, summary.Blank, summary.Complexity, summary.Status))
+
+		if Files {
+			str.WriteString(getTabularCompareBreak())
+
+			for _, res := range summary.Files {
+				tmp := unicodeAwareTrim(res.Location, diffFormatFileTruncate)
+				tmp = unicodeAwareRightPad(tmp, 27)
+
+				str.WriteString(fmt.Sprintf(tabularCompareFormatFile, tmp, res.Lines, res.Code, res.Comment, res.Blank, res.Complexity, res.Status))
+			}
+		}
+	}
+
+	total := compareTotal(language)
+	str.WriteString(getTabularCompareBreak())
+	str.WriteString(fmt.Sprintf(tabularCompareFormatBody, "Total", total.Count, total.Lines, total.Code, total.Comment, total.Blank, total.Complexity, ""))
+	str.WriteString(getTabularCompareBreak())
+
+	writeCompareChanges(&str, language)
+
+	return strings.TrimRight(str.String(), "\n")
+}
+
+func compareToMarkdown(language []LanguageCompare) string {
+	var str strings.Builder
+
+	str.WriteString("| Language | Files | Lines | Code | Comments | Blanks | Complexity | Status |\n")
+	str.WriteString("|:---------|------:|------:|-----:|---------:|-------:|-----------:|:-------|\n")
+	for _, summary := range language {
+		str.WriteString(fmt.Sprintf("| %s | %+d | %+d | %+d | %+d | %+d | %+d | %s |\n", markdownEscape(summary.Name), summary.Count, summary.Lines, summary.Code, summary.Comment, summary.Blank, summary.Complexity, summary.Status))
+	}
+	total := compareTotal(language)
+	str.WriteString(fmt.Sprintf("| **Total** | %+d | %+d | %+d | %+d | %+d | %+d | |\n", total.Count, total.Lines, total.Code, total.Comment, total.Blank, total.Complexity))
+
+	if Files {
+		str.WriteString("\n| File | Language | Lines | Code | Comments | Blanks | Complexity | Status |\n")
+		str.WriteString("|:-----|:---------|------:|-----:|---------:|-------:|-----------:|:-------|\n")
+		for _, summary := range language {
+			for _, res := range summary.Files {
+				str.WriteString(fmt.Sprintf("| %s | %s | %+d | %+d | %+d | %+d | %+d | %s |\n", markdownEscape(res.Location), markdownEscape(res.Language), res.Lines, res.Code, res.Comment, res.Blank, res.Complexity, res.Status))
+			}
+		}
+	}
+
+	str.WriteString("\n")
+	writeCompareChanges(&str, language)
+
+	return strings.TrimRight(str.String(), "\n")
+}
+
+// writeCompareChanges lists the languages and files which were added or removed since the previous report
+func writeCompareChanges(str *strings.Builder, language []LanguageCompare) {
+	var lines []string
+	for _, summary := range language {
+		switch summary.Status {
+		case DiffAdded:
+			lines = append(lines, fmt.Sprintf("New language %s", summary.Name))
+		case DiffRemoved:
+			lines = append(lines, fmt.Sprintf("Removed language %s", summary.Name))
+		}
+	}
+	for _, summary := range language {
+		for _, res := range summary.Files {
+			switch res.Status {
+			case DiffAdded:
+				lines = append(lines, fmt.Sprintf("New file %s", res.Location))
+			case DiffRemoved:
+				lines = append(lines, fmt.Sprintf("Removed file %s", res.Location))
+			}
+		}
+	}
+
+	for _, line := range lines {
+		str.WriteString("- " + line + "\n")
+	}
+}
+
+func compareSummarize(language []LanguageCompare) string {
+	switch strings.ToLower(Format) {
+	case "json":
+		return compareToJSON(language)
+	case "markdown", "md":
+		return compareToMarkdown(language)
+	}
+
+	return compareToTabular(language)
+}
+
+// validateCompareFormat checks --format is one --compare-to can write
+func validateCompareFormat() error {
+	switch strings.ToLower(Format) {
+	case "", "tabular", "json", "markdown", "md":
+		return nil
+	}
+
+	return fmt.Errorf("unknown format %q for --compare-to expected one of [tabular, json, markdown]", Format)
+}
+
+// processCompare is the entry point for --compare-to which counts the paths as normal and compares them to
+// the previous report. Every file counted is passed through watch first.
+func processCompare(ctx context.Context, counter *Counter, watch func(chan *FileJob) chan *FileJob) {
+	previous, err := loadCompareReport(CompareTo)
+	if err != nil {
+		printError(err.Error())
+		os.Exit(1)
+	}
+
+	if Files && !reportHasFiles(previous) {
+		printError(fmt.Sprintf("%s was not written with --by-file so files cannot be compared", CompareTo))
+		os.Exit(1)
+	}
+
+	fileSummaryJobQueue, wait := counter.start(ctx, DirFilePaths)
+	current := newLanguageAggregator(Files, false).consume(watch(fileSummaryJobQueue)).summaries()
+
+	if err := wait(); err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+
+	result := compareSummarize(compareLanguageSummary(previous, current))
+
+	if FileOutput == "" {
+		fmt.Println(result)
+	} else {
+		_ = os.WriteFile(FileOutput, []byte(result), 0644)
+		fmt.Println("results written to " + FileOutput)
+	}
+
+	if err := ctx.Err(); err != nil {
+		printError(fmt.Sprintf("%v: %v", ErrIncomplete, err))
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func compareInput(files ...*FileJob) []LanguageSummary {
+	inputChan := make(chan *FileJob, len(files))
+	for _, f := range files {
+		inputChan <- f
+	}
+	close(inputChan)
+
+	return aggregateLanguageSummary(inputChan, true)
+}
+
+func findLanguageCompare(language []LanguageCompare, name string) LanguageCompare {
+	for _, l := range language {
+		if l.Name == name {
+			return l
+		}
+	}
+	return LanguageCompare{}
+}
+
+func TestLoadCompareReport(t *testing.T) {
+	Files = true
+	defer func() {
+		Files = false
+	}()
+
+	inputChan := make(chan *FileJob, 1)
+	inputChan <- &FileJob{Language: "Go", Location: "a.go", Filename: "a.go", Lines: 10, Code: 8, Complexity: 2}
+	close(inputChan)
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := os.WriteFile(path, []byte(toJSON(inputChan)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	language, err := loadCompareReport(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(language) != 1 || language[0].Code != 8 || len(language[0].Files) != 1 || language[0].Files[0].Location != "a.go" {
+		t.Errorf("expected the report read back got %v", language)
+	}
+	if !reportHasFiles(language) {
+		t.Error("expected report written with --by-file to have files")
+	}
+
+	if err := os.WriteFile(path, []byte(`{"Name":"Go"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadCompareReport(path); err == nil {
+		t.Error("expected error for a report which is not --format json")
+	}
+}
+
+func TestCompareLanguageSummary(t *testing.T) {
+	Files = false
+
+	previous := compareInput(
+		&FileJob{Language: "Go", Location: "a.go", Lines: 10, Code: 8, Complexity: 2},
+		&FileJob{Language: "Java", Location: "b.java", Lines: 5, Code: 5},
+		&FileJob{Language: "Python", Location: "c.py", Lines: 1, Code: 1},
+	)
+	current := compareInput(
+		&FileJob{Language: "Go", Location: "a.go", Lines: 15, Code: 10, Comment: 2, Blank: 3, Complexity: 3},
+		&FileJob{Language: "Go", Location: "d.go", Lines: 1, Code: 1},
+		&FileJob{Language: "Rust", Location: "e.rs", Lines: 4, Code: 4},
+		&FileJob{Language: "Python", Location: "c.py", Lines: 1, Code: 1},
+	)
+
+	language := compareLanguageSummary(previous, current)
+
+	expected := map[string]LanguageCompare{
+		"Go":     {Name: "Go", Status: DiffModified, Count: 1, Lines: 6, Code: 3, Comment: 2, Blank: 3, Complexity: 1},
+		"Java":   {Name: "Java", Status: DiffRemoved, Count: -1, Lines: -5, Code: -5},
+		"Rust":   {Name: "Rust", Status: DiffAdded, Count: 1, Lines: 4, Code: 4},
+		"Python": {Name: "Python", Status: CompareUnchanged},
+	}
+	if len(language) != len(expected) {
+		t.Fatalf("expected %d languages got %v", len(expected), language)
+	}
+	for name, e := range expected {
+		if l := findLanguageCompare(language, name); l.Name != e.Name || l.Status != e.Status || l.Count != e.Count || l.Lines != e.Lines || l.Code != e.Code || l.Comment != e.Comment || l.Blank != e.Blank || l.Complexity != e.Complexity || l.Files != nil {
+			t.Errorf("expected %v got %v", e, l)
+		}
+	}
+
+	// Largest change in code first
+	if language[0].Name != "Java" || language[len(language)-1].Name != "Python" {
+		t.Errorf("unexpected order %v", language)
+	}
+
+	total := compareTotal(language)
+	if total.Count != 1 || total.Code != 2 {
+		t.Errorf("unexpected total %v", total)
+	}
+}
+
+func TestCompareLanguageSummaryByFile(t *testing.T) {
+	Files = true
+	defer func() {
+		Files = false
+	}()
+
+	previous := compareInput(
+		&FileJob{Language: "Go", Location: "a.go", Lines: 10, Code: 8},
+		&FileJob{Language: "Go", Location: "b.go", Lines: 5, Code: 5},
+		&FileJob{Language: "Go", Location: "c.go", Lines: 3, Code: 3},
+	)
+	current := compareInput(
+		&FileJob{Language: "Go", Location: "a.go", Lines: 10, Code: 8},
+		&FileJob{Language: "Go", Location: "c.go", Lines: 4, Code: 4},
+		&FileJob{Language: "Go", Location: "d.go", Lines: 5, Code: 5},
+	)
+
+	language := compareLanguageSummary(previous, current)
+	if len(language) != 1 || language[0].Status != DiffModified {
+		t.Fatalf("expected the language modified by moving lines between files got %v", language)
+	}
+
+	var statuses []string
+	for _, f := range language[0].Files {
+		statuses = append(statuses, f.Location+" "+f.Status)
+	}
+	if strings.Join(statuses, ",") != "a.go unchanged,b.go removed,c.go modified,d.go added" {
+		t.Errorf("unexpected file statuses %v", statuses)
+	}
+	if language[0].Files[1].Code != -5 {
+		t.Errorf("expected removed file to lose all its code got %v", language[0].Files[1])
+	}
+
+	tabular := compareToTabular(language)
+	for _, s := range []string{"- Removed file b.go", "- New file d.go", "unchanged"} {
+		if !strings.Contains(tabular, s) {
+			t.Errorf("expected %q in %s", s, tabular)
+		}
+	}
+}
+
+func TestCompareFilesMismatchedPrefixes(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	previous := []*FileJob{
+		{Location: "./a.go", Code: 8},
+		{Location: filepath.Join(wd, "b.go"), Code: 5},
+		{Location: filepath.Join("sub", "c.go"), Code: 3},
+		{Location: "d.go", Code: 1},
+	}
+	current := []*FileJob{
+		{Location: "a.go", Code: 8},
+		{Location: "b.go", Code: 6},
+		{Location: filepath.Join(wd, "sub", "c.go"), Code: 3},
+		{Location: filepath.Join(wd, "..", "e.go"), Code: 2},
+	}
+
+	var statuses []string
+	for _, f := range compareFiles("Go", previous, current) {
+		statuses = append(statuses, f.Location+" "+f.Status)
+	}
+
+	e := filepath.ToSlash(filepath.Join(filepath.Dir(wd), "e.go"))
+	if expected := e + " added,a.go unchanged,b.go modified,d.go removed,sub/c.go unchanged"; strings.Join(statuses, ",") != expected {
+		t.Errorf("expected %s got %v", expected, statuses)
+	}
+}
+
+func TestCompareToMarkdown(t *testing.T) {
+	Files = true
+	defer func() {
+		Files = false
+	}()
+
+	language := compareLanguageSummary(
+		compareInput(&FileJob{Language: "Go", Location: "a|b.go", Lines: 1, Code: 1}),
+		compareInput(&FileJob{Language: "Rust", Location: "c.rs", Lines: 2, Code: 2}),
+	)
+
+	markdown := compareToMarkdown(language)
+	for _, s := range []string{
+		"| Language | Files | Lines | Code | Comments | Blanks | Complexity | Status |",
+		"| Rust | +1 | +2 | +2 | +0 | +0 | +0 | added |",
+		"| Go | -1 | -1 | -1 | +0 | +0 | +0 | removed |",
+		"| **Total** | +0 | +1 | +1 | +0 | +0 | +0 | |",
+		`| a\|b.go | Go | -1 | -1 | +0 | +0 | +0 | removed |`,
+		"- New language Rust",
+		"- Removed language Go",
+	} {
+		if !strings.Contains(markdown, s) {
+			t.Errorf("expected %q in %s", s, markdown)
+		}
+	}
+}
+
+func TestValidateCompareFormat(t *testing.T) {
+	defer func() {
+		Format = ""
+	}()
+
+	for _, f := range []string{"", "tabular", "JSON", "markdown", "md"} {
+		Format = f
+		if err := validateCompareFormat(); err != nil {
+			t.Errorf("expected %s to be valid got %v", f, err)
+		}
+	}
+
+	Format = "csv"
+	if err := validateCompareFormat(); err == nil {
+		t.Error("expected csv to be invalid")
+	}
+}
@@ -1,5 +1,7 @@
 package processor 
 
 const (
-languages = `ewogICJBQkFQIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhYmFwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIqIiwKICAgICAgIlxcXCIiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFQTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiOkZvciAiLAogICAgICAiOklmICIsCiAgICAgICI6Q2FzZSAiLAogICAgICAiOkNhc2VMaXN0ICIsCiAgICAgICI6V2hpbGUgIiwKICAgICAgIjpSZXBlYXQgIiwKICAgICAgIjpFbHNlICIsCiAgICAgICLiiKgiLAogICAgICAi4oinIiwKICAgICAgIuKJoCIsCiAgICAgICJ+IiwKICAgICAgIsKoIiwKICAgICAgIj0iLAogICAgICAiOiIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFwbCIsCiAgICAgICJhcGxmIiwKICAgICAgImFwbG4iLAogICAgICAiYXBsYyIsCiAgICAgICJkeWFsb2ciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIuKNnSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJBcHBsZVNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiY29uc2lkZXJpbmcgIiwKICAgICAgImlnbm9yaW5nICIsCiAgICAgICJyZXBlYXQgIiwKICAgICAgIndoaWxlICIsCiAgICAgICJpZiAiLAogICAgICAiZWxzZSAiLAogICAgICAiZWxzZSBpZiAiLAogICAgICAidHJ5ICIsCiAgICAgICJvbiBlcnJvciAiLAogICAgICAiYW5kICIsCiAgICAgICJvciAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhcHBsZXNjcmlwdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIsCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFtdCiAgfSwKICAiQVNQIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhc2EiLAogICAgICAiYXNwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICInIiwKICAgICAgIlJFTSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQVNQLk5FVCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYXNheCIsCiAgICAgICJhc2N4IiwKICAgICAgImFzbXgiLAogICAgICAiYXNweCIsCiAgICAgICJtYXN0ZXIiLAogICAgICAic2l0ZW1hcCIsCiAgICAgICJ3ZWJpbmZvIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiPCUtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFUUyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICIgdGhlbiAiLAogICAgICAiIGVsc2UgIiwKICAgICAgImNhc2UrICIsCiAgICAgICJpZmNhc2UiLAogICAgICAibGV0ICIsCiAgICAgICJhbmQgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZGF0cyIsCiAgICAgICJzYXRzIiwKICAgICAgImF0cyIsCiAgICAgICJoYXRzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdLAogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiLy8vLyIsCiAgICAgICAgIlRISVNTSE9VTERORVZFUkFQUEVBUldFSE9QRSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJBV0siOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhd2siCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJhd2siLAogICAgICAiZ2F3ayIsCiAgICAgICJtYXdrIgogICAgXQogIH0sCiAgIkFjdGlvblNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQWRhIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhZGEiLAogICAgICAiYWRiIiwKICAgICAgImFkcyIsCiAgICAgICJwYWQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBZ2RhIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhZ2RhIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7LSIsCiAgICAgICAgIi19IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQWxjaGVtaXN0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICIrIiwKICAgICAgIi0+IiwKICAgICAgIiEiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjcm4iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFsZXgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBbGxveSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiaW1wbGllcyAiLAogICAgICAiZWxzZSAiLAogICAgICAiZm9yICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiLAogICAgICAiPD0gIiwKICAgICAgIj49ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFscyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iLAogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFuZHJvaWQgSW50ZXJmYWNlIERlZmluaXRpb24gTGFuZ3VhZ2UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFpZGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qKiIsCiAgICAgICAgIiovIgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQXZybyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImF2ZGwiLAogICAgICAiYXZwciIsCiAgICAgICJhdnNjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBc2NpaURvYyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFkb2MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFzc2VtYmx5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzIiwKICAgICAgImFzbSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiQXV0b0hvdEtleSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYWhrIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBdXRvY29uZiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaW4iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiLAogICAgICAiZG5sIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJiYWl0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImlmICIsCiAgICAgICJlbHNlICIsCiAgICAgICIgb3IgIiwKICAgICAgIiBhbmQgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJidCIKICAgIF0sCiAgICAia2V5d29yZHMiOiBbCiAgICAgICJhbmQiLAogICAgICAiYnJlYWsiLAogICAgICAiY29uc3QgIiwKICAgICAgImNvbnRpbnVlIiwKICAgICAgImVsc2UgIiwKICAgICAgImZ1biAiLAogICAgICAiaW1wb3J0ICIsCiAgICAgICJub3QgIiwKICAgICAgIm9yICIsCiAgICAgICJwYWNrYWdlICIsCiAgICAgICJyZXR1cm4iLAogICAgICAic3RydWN0ICIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogImAiLAogICAgICAgICJzdGFydCI6ICJgIgogICAgICB9CiAgICBdCiAgfSwKICAiQkFTSCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmFzaCIsCiAgICAgICJiYXNoX2xvZ2luIiwKICAgICAgImJhc2hfbG9nb3V0IiwKICAgICAgImJhc2hfcHJvZmlsZSIsCiAgICAgICJiYXNocmMiCiAgICBdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgIi5iYXNoX2xvZ2luIiwKICAgICAgIi5iYXNoX2xvZ291dCIsCiAgICAgICIuYmFzaF9wcm9maWxlIiwKICAgICAgIi5iYXNocmMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgImJhc2giCiAgICBdCiAgfSwKICAiQmFzaWMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgImVsc2VpZiAiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmFzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICInIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkJhdGNoIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJiYXQiLAogICAgICAiYnRtIiwKICAgICAgImNtZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiUkVNIiwKICAgICAgIjo6IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJCYXplbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYnpsIiwKICAgICAgImJ1aWxkLmJhemVsIiwKICAgICAgImJ1aWxkIiwKICAgICAgIndvcmtzcGFjZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkJpY2VwIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJAbWluTGVuZ3RoKCIsCiAgICAgICJAbWF4TGVuZ3RoKCIsCiAgICAgICJAc2VjdXJlKCIsCiAgICAgICJbZm9yICIsCiAgICAgICJpZigiLAogICAgICAiaWYgKCIsCiAgICAgICIgPT0gIiwKICAgICAgIiAhPSAiLAogICAgICAiID8gIiwKICAgICAgInVzaW5nICIsCiAgICAgICJyYW5nZSgiLAogICAgICAidHlwZSAiLAogICAgICAiZnVuYyAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJiaWNlcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiQml0YmFrZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmIiLAogICAgICAiYmJhcHBlbmQiLAogICAgICAiYmJjbGFzcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkJpdGJ1Y2tldCBQaXBlbGluZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImJpdGJ1Y2tldC1waXBlbGluZXMueW1sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJCbGFkZSB0ZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiQGZvciAiLAogICAgICAiQGZvcigiLAogICAgICAiQGZvcmVhY2ggIiwKICAgICAgIkBmb3JlYWNoKCIsCiAgICAgICJAZm9yZWxzZSAiLAogICAgICAiQGZvcmVsc2UoIiwKICAgICAgIkBlYWNoICIsCiAgICAgICJAZWFjaCAoIiwKICAgICAgIkB3aGlsZSAiLAogICAgICAiQHdoaWxlKCIsCiAgICAgICJAaWYgIiwKICAgICAgIkBpZigiLAogICAgICAiQHVubGVzcyAiLAogICAgICAiQHVubGVzcygiLAogICAgICAiQGlzc2V0ICIsCiAgICAgICJAaXNzZXQoIiwKICAgICAgIkBlbXB0eSAiLAogICAgICAiQGVtcHR5KCIsCiAgICAgICJAZWxzZSAiLAogICAgICAiQGVsc2VpZiAiLAogICAgICAiQGVsc2VpZigiLAogICAgICAiQHdoaWxlICIsCiAgICAgICJAd2hpbGUoIiwKICAgICAgIkBzd2l0Y2ggIiwKICAgICAgIkBzd2l0Y2ggKCIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJibGFkZS5waHAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAie3stLSIsCiAgICAgICAgIi0tfX0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkJvbyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJpZiAiLAogICAgICAiZWxpZiAiLAogICAgICAidW5sZXNzICIsCiAgICAgICIgYW5kICIsCiAgICAgICJmb3IgIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJib28iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiXCJcIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCJcIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQm9zcXVlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAibWF0Y2ggIiwKICAgICAgImNhc2UgIiwKICAgICAgInwgIiwKICAgICAgInx8ICIsCiAgICAgICImICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIiE9PSAiLAogICAgICAiPT0gIiwKICAgICAgIj09PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJic3EiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIkJyYWluZnVjayI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiWyIsCiAgICAgICJdIiwKICAgICAgIjwiLAogICAgICAiPiIsCiAgICAgICIrIiwKICAgICAgIi0iLAogICAgICAiLiIsCiAgICAgICIsIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmYiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkJ1aWxkU3RyZWFtIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYnN0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjIiwKICAgICAgImVjIiwKICAgICAgInBnYyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDIEhlYWRlciI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDIFNoZWxsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc2giCiAgICBdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgIi5jc2hyYyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdLAogICAgInNoZWJhbmdzIjogWwogICAgICAiY3NoIiwKICAgICAgInRjc2giCiAgICBdCiAgfSwKICAiQyMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNzIiwKICAgICAgImNzeCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAiaWdub3JlRXNjYXBlIjogdHJ1ZSwKICAgICAgICAic3RhcnQiOiAiQFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQysrIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjYyIsCiAgICAgICJjcHAiLAogICAgICAiY3h4IiwKICAgICAgImMrKyIsCiAgICAgICJwY2MiLAogICAgICAiaW5vIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkMrKyBIZWFkZXIiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImhoIiwKICAgICAgImhwcCIsCiAgICAgICJoeHgiLAogICAgICAiaW5sIiwKICAgICAgImlwcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDdWRhIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjdSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDTWFrZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY21ha2UiLAogICAgICAiY21ha2VsaXN0cy50eHQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQ09CT0wiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNvYiIsCiAgICAgICJjYmwiLAogICAgICAiY2NwIiwKICAgICAgImNvYm9sIiwKICAgICAgImNweSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiKiIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQ29kZVFMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJhbmQgIiwKICAgICAgIm9yICIsCiAgICAgICJpbXBsaWVzICIsCiAgICAgICJpZiAiLAogICAgICAiZWxzZSAiLAogICAgICAibm90ICIsCiAgICAgICJpbnN0YW5jZW9mICIsCiAgICAgICJpbiAiLAogICAgICAiZXhpc3RzKCIsCiAgICAgICJmb3JhbGwoICIsCiAgICAgICJhdmcoIiwKICAgICAgImNvbmNhdCgiLAogICAgICAiY291bnQoIiwKICAgICAgIm1heCgiLAogICAgICAibWluKCIsCiAgICAgICJyYW5rKCIsCiAgICAgICJzdHJpY3Rjb25jYXQoIiwKICAgICAgInN0cmljdGNvdW50KCIsCiAgICAgICJzdHJpY3RzdW0oIiwKICAgICAgInN1bSgiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJxbCIsCiAgICAgICJxbGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQ1NTIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQ1NWIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3N2IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDYWJhbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY2FiYWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInstIiwKICAgICAgICAiLX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDYWlybyI6IHsKICAgICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICAgImxvb3AgIiwKICAgICAgICAiaWYgIiwKICAgICAgICAiaWYoIiwKICAgICAgICAibWF0Y2ggIiwKICAgICAgICAibWF0Y2goIiwKICAgICAgICAiZWxzZSAiLAogICAgICAgICJ8fCAiLAogICAgICAgICImJiAiLAogICAgICAgICIhPSAiLAogICAgICAgICI9PSAiCiAgICAgIF0sCiAgICAgICJleHRlbnNpb25zIjogWwogICAgICAgICJjYWlybyIKICAgICAgXSwKICAgICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgICAiLy8iCiAgICAgIF0sCiAgICAgICJtdWx0aV9saW5lIjogW10sCiAgICAgICJxdW90ZXMiOiBbCiAgICAgICAgewogICAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAgICJzdGFydCI6ICInIgogICAgICAgIH0KICAgICAgXQogIH0sCiAgIkNhc3NpdXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNhc3NpdXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQ2V5bG9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjZXlsb24iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQ2xvanVyZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiKGZvciAiLAogICAgICAiKHdoZW4gIiwKICAgICAgIihsb29wICIsCiAgICAgICIoZG9zZXEgIiwKICAgICAgIihjb25kICIsCiAgICAgICIoaWYiLAogICAgICAiKGlmLW5vdCAiLAogICAgICAiKGFuZCAiLAogICAgICAiKG9yICIsCiAgICAgICIobm90ICIsCiAgICAgICIoPSAiLAogICAgICAiKG5vdD0gIiwKICAgICAgIihyZWN1ciAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjbGoiLAogICAgICAiY2xqYyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQ2xvanVyZVNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY2xqcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQ2xvc3VyZSBUZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIiwKICAgICAgIj49ICIsCiAgICAgICI8PSAiLAogICAgICAiPzogIiwKICAgICAgIj8gOiAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzb3kiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qKiIsCiAgICAgICAgIiovIgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQ29mZmVlU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjb2ZmZWUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiIyMjIiwKICAgICAgICAiIyMjIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiQ29nZW50IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjb2dlbnQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDb2xkRnVzaW9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjZm0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLS0iLAogICAgICAgICItLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiQ29sZEZ1c2lvbiBDRlNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY2ZjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkNvcSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidiIKICAgIF0sCiAgICAia2V5d29yZHMiOiBbCiAgICAgICJSZXF1aXJlIiwKICAgICAgIkh5cG90aGVzaXMiLAogICAgICAiSW5kdWN0aXZlIiwKICAgICAgIlJlbWFyayIsCiAgICAgICJMZW1tYSIsCiAgICAgICJQcm9vZiIsCiAgICAgICJEZWZpbml0aW9uIiwKICAgICAgIlRoZW9yZW0iLAogICAgICAiQ2xhc3MiLAogICAgICAiSW5zdGFuY2UiLAogICAgICAiTW9kdWxlIiwKICAgICAgIkNvbnRleHQiLAogICAgICAiU2VjdGlvbiIsCiAgICAgICJOb3RhdGlvbiIsCiAgICAgICJFbmQiLAogICAgICAiRml4cG9pbnQiLAogICAgICAiRnJvbSBDb3EiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDcmVvbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjcmVvbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkNyeXN0YWwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJDeXRob24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgImFuZCAiLAogICAgICAib3IgIiwKICAgICAgIm5vdCAiLAogICAgICAiaW4gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHl4IiwKICAgICAgInB4aSIsCiAgICAgICJweGQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIlxcXCJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInJyciLAogICAgICAgICJzdGFydCI6ICInJyciCiAgICAgIH0KICAgIF0KICB9LAogICJEIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8rIiwKICAgICAgICAiKy8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAicmRtZCIKICAgIF0KICB9LAogICJEYXJ0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkYXJ0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkRldmljZSBUcmVlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkdHMiLAogICAgICAiZHRzaSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJEaGFsbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImRoYWxsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7LSIsCiAgICAgICAgIi19IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkRNIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgInN3aXRjaCgiLAogICAgICAid2hpbGUgIiwKICAgICAgIndoaWxlKCIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCIsCiAgICAgICImJiIsCiAgICAgICIhPSIsCiAgICAgICI8PiIsCiAgICAgICI9PSIsCiAgICAgICJpbiAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkbSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJEb2NrZXIgaWdub3JlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogW10sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAiLmRvY2tlcmlnbm9yZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIsCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRG9ja2VyZmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZG9ja2VyZmlsZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAiZG9ja2VyZmlsZSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiRG9jdW1lbnQgVHlwZSBEZWZpbml0aW9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZHRkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJFbGl4aXIiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImV4IiwKICAgICAgImV4cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIlxcXCJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJycnIiwKICAgICAgICAic3RhcnQiOiAiJycnIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIkVsbSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAiY2FzZSAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImVsbSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJFbWFjcyBEZXYgRW52IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJlZGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkVtYWNzIExpc3AiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImVsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJFcmxhbmciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImVybCIsCiAgICAgICJocmwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgImVzY3JpcHQiCiAgICBdCiAgfSwKICAiRXhwZWN0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJleHAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIkV4dGVuc2libGUgU3R5bGVzaGVldCBMYW5ndWFnZSBUcmFuc2Zvcm1hdGlvbnMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4c2x0IiwKICAgICAgInhzbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRiMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgIm1hdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZnMiLAogICAgICAiZnNpIiwKICAgICAgImZzeCIsCiAgICAgICJmc3NjcmlwdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkZhY3RvciIgOnsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiaWYiLAogICAgICAid2hlbiIsCiAgICAgICJ1bmxlc3MiLAogICAgICAiaWYqIiwKICAgICAgIndoZW4qIiwKICAgICAgInVubGVzcyoiLAogICAgICAiP2lmIiwKICAgICAgIj8iLAogICAgICAiY29uZCIsCiAgICAgICJjYXNlIiwKCiAgICAgICJjb25kPnF1b3QiLAogICAgICAiY2FzZT5xdW90IiwKICAgICAgImFsaXN0PnF1b3QiLAoKICAgICAgIndoaWxlIiwKICAgICAgInVudGlsIiwKICAgICAgImxvb3AiLAoKICAgICAgIjAmJiIsCiAgICAgICIxJiYiLAogICAgICAiMiYmIiwKICAgICAgIjMmJiIsCiAgICAgICJuJiYiLAogICAgICAiJiYiLAoKICAgICAgIjB8fCIsCiAgICAgICIxfHwiLAogICAgICAiMnx8IiwKICAgICAgIjN8fCIsCiAgICAgICJufHwiLAogICAgICAifHwiLAoKICAgICAgImFuZCIsCiAgICAgICJvciIsCiAgICAgICJ4b3IiLAoKICAgICAgImVxIiwKICAgICAgIj0iLAoKICAgICAgInNtYXJ0LWlmIiwKICAgICAgInNtYXJ0LWlmKiIsCiAgICAgICJzbWFydC13aGVuIiwKICAgICAgInNtYXJ0LXdoZW4qIiwKICAgICAgInNtYXJ0LXVubGVzcyIsCiAgICAgICJzbWFydC11bmxlc3MqIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZmFjdG9yIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIiFbWyIsCiAgICAgICAgIl1dIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiFbPVsiLAogICAgICAgICJdPV0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiIVs9PVsiLAogICAgICAgICJdPT1dIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiFbPT09WyIsCiAgICAgICAgIl09PT1dIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiFbPT09PVsiLAogICAgICAgICJdPT09PV0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiIVs9PT09PVsiLAogICAgICAgICJdPT09PT1dIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiFbPT09PT09WyIsCiAgICAgICAgIl09PT09PT1dIgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiKCgiLAogICAgICAgICIpKSIKICAgICAgXQogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIhIgogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAic3RhcnQiOiAiXCIiLAogICAgICAgICJlbmQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAic3RhcnQiOiAiU1RSSU5HOiIsCiAgICAgICAgImVuZCI6ICI7IgogICAgICB9LAogICAgICB7CiAgICAgICAgInN0YXJ0IjogIls9PT09PT1bIiwKICAgICAgICAiZW5kIjogIl09PT09PT1dIgogICAgICB9LAogICAgICB7CiAgICAgICAgInN0YXJ0IjogIls9PT09PVsiLAogICAgICAgICJlbmQiOiAiXT09PT1dIgogICAgICB9LAogICAgICB7CiAgICAgICAgInN0YXJ0IjogIls9PT09WyIsCiAgICAgICAgImVuZCI6ICJdPT09PV0iCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAic3RhcnQiOiAiWz09PVsiLAogICAgICAgICJlbmQiOiAiXT09PV0iCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAic3RhcnQiOiAiWz09WyIsCiAgICAgICAgImVuZCI6ICJdPT1dIgogICAgICB9LAogICAgICB7CiAgICAgICAgInN0YXJ0IjogIls9WyIsCiAgICAgICAgImVuZCI6ICJdPV0iCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAic3RhcnQiOiAiW1siLAogICAgICAgICJlbmQiOiAiXV0iCiAgICAgIH0KICAgIF0KICB9LAogICJGZW5uZWwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIihmb3IiLAogICAgICAiKGVhY2giLAogICAgICAiKGlmIiwKICAgICAgIih3aGVuIiwKICAgICAgIih3aGlsZSIsCiAgICAgICIoc3dpdGNoIiwKICAgICAgIihkbyIsCiAgICAgICIoLi4iLAogICAgICAiKD0iLAogICAgICAiKGFuZCIsCiAgICAgICIob3IiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmbmwiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAic3RhcnQiOiAiXCIiLAogICAgICAgICJlbmQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAic3RhcnQiOiAiLCIsCiAgICAgICAgImVuZCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiRioiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZzdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkZJREwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZpZGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiRk9SVFJBTiBMZWdhY3kiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImYiLAogICAgICAiZm9yIiwKICAgICAgImZ0biIsCiAgICAgICJmNzciLAogICAgICAicGZvIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICJjIiwKICAgICAgIkMiLAogICAgICAiISIsCiAgICAgICIqIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJGT1JUUkFOIE1vZGVybiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZjAzIiwKICAgICAgImYwOCIsCiAgICAgICJmOTAiLAogICAgICAiZjk1IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIhIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkZpc2giOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZpc2giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgImZpc2giCiAgICBdCiAgfSwKICAiRmxvdzkiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAiZWxzZSAiLAogICAgICAiZWxzZXsiLAogICAgICAiZm9yaSAiLAogICAgICAiZm9yaSgiLAogICAgICAic3dpdGNoICIsCiAgICAgICJzd2l0Y2goIiwKICAgICAgImZvbGQgIiwKICAgICAgImZvbGQoIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIsCiAgICAgICJ8PiAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmbG93IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkZvcnRoIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICI0dGgiLAogICAgICAiZm9ydGgiLAogICAgICAiZnIiLAogICAgICAiZnJ0IiwKICAgICAgImZ0aCIsCiAgICAgICJmODMiLAogICAgICAiZmIiLAogICAgICAiZnBtIiwKICAgICAgImU0IiwKICAgICAgInJ4IiwKICAgICAgImZ0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICJcXFxcIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIiggIiwKICAgICAgICAiKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkZyYWdtZW50IFNoYWRlciBGaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmc2giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiRnJlZW1hcmtlciBUZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiPCNsaXN0ICIsCiAgICAgICI8I2Fzc2lnbiAiLAogICAgICAiPCNpZiAiLAogICAgICAiPCNlbHNlaWYgIiwKICAgICAgIjwjZWxzZT4iLAogICAgICAiPCNlbHNlPiAiLAogICAgICAiPCNzd2l0Y2ggICIsCiAgICAgICI8I2Nhc2UgIiwKICAgICAgIjwjZGVmYXVsdD4iLAogICAgICAiPCNkZWZhdWx0PiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZ0bCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8Iy0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRlNMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICItPiIsCiAgICAgICI8LSIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZzbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkZ1dGhhcmsiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ0aGVuICIsCiAgICAgICJmb3IgIiwKICAgICAgImxvb3AgIiwKICAgICAgIndoaWxlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI+PSAiLAogICAgICAiPD0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZnV0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRlhNTCI6IHsKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZnhtbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIklOSSI6IHsKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaW5pIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIiwKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkdEU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJnZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiXFxcIlxcXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJHTFNMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2ZXJ0IiwKICAgICAgInRlc2MiLAogICAgICAidGVzZSIsCiAgICAgICJnZW9tIiwKICAgICAgImZyYWciLAogICAgICAiY29tcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJHTiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiaWYoIiwKICAgICAgImlmICgiLAogICAgICAiZWxzZSBpZigiLAogICAgICAiZWxzZSBpZiAoIiwKICAgICAgImVsc2UoIiwKICAgICAgImVsc2UgKCIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJnbiIsCiAgICAgICJnbmkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJHYW1lIE1ha2VyIExhbmd1YWdlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJyZXBlYXQgIiwKICAgICAgInJlcGVhdCgiLAogICAgICAifHwgIiwKICAgICAgIm9yICIsCiAgICAgICImJiAiLAogICAgICAiYW5kICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZ21sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIsCiAgICAgICIvLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJHYW1lIE1ha2VyIFByb2plY3QiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ5eXAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkdlbWZpbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbXSwKICAgICJmaWxlbmFtZXMiOiBbCiAgICAgICJnZW1maWxlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJHaGVya2luIFNwZWNpZmljYXRpb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImdpdmVuIiwKICAgICAgIndoZW4iLAogICAgICAidGhlbiIsCiAgICAgICJhbmQiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmZWF0dXJlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJHbyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZ28gIiwKICAgICAgImRlZmVyICIsCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJnbyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogImAiLAogICAgICAgICJpZ25vcmVFc2NhcGUiOiB0cnVlLAogICAgICAgICJzdGFydCI6ICJgIgogICAgICB9CiAgICBdCiAgfSwKICAiR28gVGVtcGxhdGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgInt7aWYgIiwKICAgICAgInt7IGlmICIsCiAgICAgICJ7e2Vsc2UiLAogICAgICAie3sgZWxzZSIsCiAgICAgICJ7e3JhbmdlICIsCiAgICAgICJ7eyByYW5nZSAiLAogICAgICAie3t3aXRoIiwKICAgICAgInt7IHdpdGgiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0bXBsIiwKICAgICAgImdvaHRtbCIsCiAgICAgICJnb3R4dCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7ey8qIiwKICAgICAgICAiKi99fSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkdyYWRsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImdyYWRsZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiR3JhcGhRTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAidHlwZSAiLAogICAgICAiaW5wdXQgIiwKICAgICAgInF1ZXJ5ICIsCiAgICAgICJtdXRhdGlvbiAiLAogICAgICAic3Vic2NyaXB0aW9uICIsCiAgICAgICJkaXJlY3RpdmUgIiwKICAgICAgInNjYWxhciAiLAogICAgICAiZW51bSAiLAogICAgICAiaW50ZXJmYWNlICIsCiAgICAgICJ1bmlvbiAiLAogICAgICAiZnJhZ21lbnQgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZ3JhcGhxbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJcIlwiXCIiLAogICAgICAgICJcIlwiXCIiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImRvY1N0cmluZyI6IHRydWUsCiAgICAgICAgImVuZCI6ICJcIlwiXCIiLAogICAgICAgICJzdGFydCI6ICJcIlwiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJET1QiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkb3QiLAogICAgICAiZ3YiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIiwKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkdyb292eSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZ3Jvb3Z5IiwKICAgICAgImdydCIsCiAgICAgICJndHBsIiwKICAgICAgImd2eSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJIRVgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJoZXgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkhUTUwiOiB7CiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImh0bWwiLAogICAgICAiaHRtIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiSEFNTCI6IHsKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaGFtbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLSMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkhhbWxldCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaGFtbGV0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiSGFuZGxlYmFycyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaGJzIiwKICAgICAgImhhbmRsZWJhcnMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJ7eyEiLAogICAgICAgICJ9fSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIkhhcHB5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieSIsCiAgICAgICJseSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSGFyZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJpZiAiLAogICAgICAiZWxzZSAiLAogICAgICAibWF0Y2ggIiwKICAgICAgInN3aXRjaCAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaGEiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKCSJlbmQiOiAiYCIsCiAgICAgICAgInN0YXJ0IjogImAiCiAgICAgIH0KICAgIF0KICB9LAogICJIYXNrZWxsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZiAiLAogICAgICAidGhlbiAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIvPSAiLAogICAgICAiPT0gIiwKICAgICAgImNhc2UgIiwKICAgICAgImRvIHsiLAogICAgICAiZm9yYWxsICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImhzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7LSIsCiAgICAgICAgIi19IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSGF4ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaHgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiaG9vbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiJSsgIHR1cm4iLAogICAgICAiKHR1cm4gIiwKICAgICAgIiUrICByb2xsIiwKICAgICAgIihyb2xsICIsCiAgICAgICIlKyAgcmVlbCIsCiAgICAgICIocmVlbCAiLAogICAgICAifC4gICIsCiAgICAgICJ8LigiLAogICAgICAifC0gICIsCiAgICAgICJ8LSgiLAogICAgICAifD8gICIsCiAgICAgICJ8PygiLAogICAgICAiP3wgICIsCiAgICAgICI/fCgiLAogICAgICAifCgiLAogICAgICAiPy0gICIsCiAgICAgICI/LSgiLAogICAgICAiPzogICIsCiAgICAgICI/OigiLAogICAgICAiPy4gICIsCiAgICAgICI/LigiLAogICAgICAiP14gICIsCiAgICAgICI/XigiLAogICAgICAiPzwgICIsCiAgICAgICI/PCgiLAogICAgICAiPz4gICIsCiAgICAgICI/PigiLAogICAgICAiPysgICIsCiAgICAgICI/KygiLAogICAgICAiPyYgICIsCiAgICAgICI/JigiLAogICAgICAiJigiLAogICAgICAiP0AgICIsCiAgICAgICI/QCgiLAogICAgICAiP34gICIsCiAgICAgICI/figiLAogICAgICAiPz0gICIsCiAgICAgICI/PSgiLAogICAgICAiLj0gICIsCiAgICAgICI9KCIsCiAgICAgICIhPSgiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJob29uIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI6OiIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogImBgYCIsCiAgICAgICAgInN0YXJ0IjogImBgYCIKICAgICAgfQogICAgXQogIH0sCiAgIklETCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaWRsIiwKICAgICAgIndlYmlkbCIsCiAgICAgICJ3aWRsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIklkcmlzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpZHIiLAogICAgICAibGlkciIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCJcXFwiXFxcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkludGVsIEhFWCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImloZXgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIklzYWJlbGxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0aHkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInsqIiwKICAgICAgICAiKn0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXSwKICAgICAgWwogICAgICAgICLigLkiLAogICAgICAgICLigLoiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXFxcXDxvcGVuPiIsCiAgICAgICAgIlxcXFw8Y2xvc2U+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiJyciLAogICAgICAgICJzdGFydCI6ICInJyIKICAgICAgfQogICAgXQogIH0sCiAgIkpBSSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamFpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiSlNPTiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImpzb24iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkpTT05MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianNvbmwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkpTWCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianN4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkphZGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmICIsCiAgICAgICJlbHNlIGlmICIsCiAgICAgICJ1bmxlc3MgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamFkZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJKYW5ldCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiKGlmICIsCiAgICAgICIoZm9yICIsCiAgICAgICIoZm9yICIsCiAgICAgICIoY29uZCAiLAogICAgICAiKHN3aXRjaCAiLAogICAgICAiKHdoZW4gIiwKICAgICAgIih3aGlsZSAiLAogICAgICAiKGxvb3AgIiwKICAgICAgIihjYXNlICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImphbmV0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogZmFsc2UsCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJgYCIsCiAgICAgICAgInN0YXJ0IjogImBgIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIkBcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkphdmEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImphdmEiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiSmF2YVNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianMiLAogICAgICAibWpzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgIm5vZGUiCiAgICBdCiAgfSwKICAiSmF2YVNlcnZlciBQYWdlcyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianNwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJKZW5raW5zIEJ1aWxkZmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImplbmtpbnNmaWxlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJKaW5qYSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAieyUgZm9yICIsCiAgICAgICJ7JS0gZm9yICIsCiAgICAgICJ7JSBpZiAiLAogICAgICAieyUtIGlmICIsCiAgICAgICJ7JSBlbHNlICIsCiAgICAgICJ7JS0gZWxzZSAiLAogICAgICAieyUgZWxpZiAiLAogICAgICAieyUgbWFjcm8gIiwKICAgICAgInslLSBtYWNybyAiLAogICAgICAieyUgY2FsbCAiLAogICAgICAieyUtIGNhbGwgIiwKICAgICAgInslIGZpbHRlciAiLAogICAgICAieyUtIGZpbHRlciAiLAogICAgICAieyUgc2V0ICIsCiAgICAgICJ7JSBpbmNsdWRlICIsCiAgICAgICJ7JSBmcm9tICIsCiAgICAgICJ7JSBleHRlbmRzICIsCiAgICAgICJ7JSB3aXRoICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImppbmphIiwKICAgICAgImoyIiwKICAgICAgImppbmphMiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7IyIsCiAgICAgICAgIiN9IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSnVsaWEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgImVsc2VpZigiLAogICAgICAidHJ5ICIsCiAgICAgICJjYXRjaCAiLAogICAgICAiZmluYWxseSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImpsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIiM9IiwKICAgICAgICAiPSMiCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiXFxcIlxcXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJKdWxpdXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImp1bGl1cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJKdXB5dGVyIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaXB5bmIiLAogICAgICAianB5bmIiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkp1c3QiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImp1c3RmaWxlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfQogICAgXQogIH0sCiAgIksiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIiciLAogICAgICAiLyIsCiAgICAgICJcXCIsCiAgICAgICInOiIsCiAgICAgICIvOiIsCiAgICAgICJcXDoiLAogICAgICAifCIsCiAgICAgICImIiwKICAgICAgIiEiLAogICAgICAiPSIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImsiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8iCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIktvcm4gU2hlbGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImtzaCIKICAgIF0sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAiLmtzaHJjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJrc2giCiAgICBdCiAgfSwKICAiS290bGluIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJrdCIsCiAgICAgICJrdHMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJMRCBTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImxkcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJMRVNTIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibGVzcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJMRVgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJMT0xDT0RFIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJBV1NVTSBUSFggIiwKICAgICAgIk8gTk9FUyAiLAogICAgICAiUExaIE9QRU4gRklMRSAiLAogICAgICAiSU0gSU4gWVIgIiwKICAgICAgIk8gUkxZPyIsCiAgICAgICJPIFJMWT8gIiwKICAgICAgIldURj8iLAogICAgICAiV1RGPyAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsb2wiLAogICAgICAibG9scyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiQlRXIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIk9CVFciLAogICAgICAgICJUTERSIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkxhVGVYIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGV4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIlIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJMZWFuIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsZWFuIiwKICAgICAgImhsZWFuIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvLSIsCiAgICAgICAgIi0vIgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogW10KICB9LAogICJMaWNlbnNlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogW10sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAibGljZW5zZSIsCiAgICAgICJsaWNlbmNlIiwKICAgICAgImNvcHlpbmciLAogICAgICAiY29weWluZzMiLAogICAgICAidW5saWNlbnNlIiwKICAgICAgInVubGljZW5jZSIsCiAgICAgICJsaWNlbnNlLW1pdCIsCiAgICAgICJsaWNlbmNlLW1pdCIsCiAgICAgICJjb3B5cmlnaHQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkxpc3AiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImxpc3AiLAogICAgICAibHNwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIiN8IiwKICAgICAgICAifCMiCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgInNiY2wiCiAgICBdCiAgfSwKICAiTExWTSBJUiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAibGx2bS5sb29wIiwKICAgICAgImJyICIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgImluZGlyZWN0YnIgIiwKICAgICAgImludm9rZSAiLAogICAgICAiY2FsbGJyICIsCiAgICAgICJyZXN1bWUgIiwKICAgICAgImNhdGNoc3dpdGNoICIsCiAgICAgICJjYXRjaHJldCAiLAogICAgICAiY2xlYW51cHJldCAiLAogICAgICAic2hsICIsCiAgICAgICJsc2hyICIsCiAgICAgICJhc2hyICIsCiAgICAgICJhbmQgIiwKICAgICAgIm9yICIsCiAgICAgICJ4b3IgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbXQogIH0sCiAgIkx1YSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAid2hpbGUgIiwKICAgICAgIndoaWxlKCIsCiAgICAgICJlbHNlICIsCiAgICAgICJlbHNlKCIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgImVsc2VpZigiLAogICAgICAib3IgIiwKICAgICAgImFuZCAiLAogICAgICAifj0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImx1YSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLS1bWyIsCiAgICAgICAgIl1dIgogICAgICBdLAogICAgICBbCiAgICAgICItLVs9WyIsCiAgICAgICJdPV0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgIi0tWz09WyIsCiAgICAgICJdPT1dIgogICAgICBdLAogICAgICBbCiAgICAgICItLVs9PT1bIiwKICAgICAgIl09PT1dIgogICAgICBdLAogICAgICBbCiAgICAgICItLVs9PT09WyIsCiAgICAgICJdPT09PV0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgIi0tWz09PT09WyIsCiAgICAgICJdPT09PT1dIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJbWyIsCiAgICAgICAgInN0YXJ0IjogIl1dIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAibHVhIgogICAgXQogIH0sCiAgIkx1YXUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgIndoaWxlICIsCiAgICAgICJ3aGlsZSgiLAogICAgICAiZWxzZSAiLAogICAgICAiZWxzZSgiLAogICAgICAiZWxzZWlmICIsCiAgICAgICJlbHNlaWYoIiwKICAgICAgIm9yICIsCiAgICAgICJhbmQgIiwKICAgICAgIn49ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsdWF1IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICItLVtbIiwKICAgICAgICAiXV0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgIi0tWz1bIiwKICAgICAgIl09XSIKICAgICAgXSwKICAgICAgWwogICAgICAiLS1bPT1bIiwKICAgICAgIl09PV0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgIi0tWz09PVsiLAogICAgICAiXT09PV0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgIi0tWz09PT1bIiwKICAgICAgIl09PT09XSIKICAgICAgXSwKICAgICAgWwogICAgICAiLS1bPT09PT1bIiwKICAgICAgIl09PT09PV0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogImAiLAogICAgICAgICJzdGFydCI6ICJgIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJbWyIsCiAgICAgICAgInN0YXJ0IjogIl1dIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAibHVhdSIKICAgIF0KICB9LAogICJMdWNpdXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImx1Y2l1cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJMdW5hIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibHVuYSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiTVFMIEhlYWRlciI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibXFoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIk1RTDQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1xNCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJNUUw1IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtcTUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiTVNCdWlsZCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiQ29uZGl0aW9uIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3Nwcm9qIiwKICAgICAgInZicHJvaiIsCiAgICAgICJmc3Byb2oiLAogICAgICAidmNwcm9qIiwKICAgICAgInZjeHByb2oiLAogICAgICAidmN4cHJvai5maWx0ZXJzIiwKICAgICAgIm15YXBwIiwKICAgICAgInByb3BzIiwKICAgICAgInJkbGMiLAogICAgICAicmVzeCIsCiAgICAgICJzZXR0aW5ncyIsCiAgICAgICJzbG4iLAogICAgICAidGFyZ2V0cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIk1VTVBTIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtcHMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJNYWNyb21lZGlhIGVYdGVuc2libGUgTWFya3VwIExhbmd1YWdlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibXhtbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTWFkbGFuZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibWFkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInsjIiwKICAgICAgICAiI30iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJNYWtlZmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibWFrZWZpbGUiLAogICAgICAibWFrIiwKICAgICAgIm1rIiwKICAgICAgImJwIgogICAgXSwKICAgICJmaWxlbmFtZXMiOiBbCiAgICAgICJtYWtlZmlsZSIsCiAgICAgICJnbnVtYWtlZmlsZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTWFrbyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiJSBmb3IgIiwKICAgICAgIiUgaWYgIiwKICAgICAgIiUgZWxzZSAiLAogICAgICAiJSBlbGlmICIsCiAgICAgICI8JSBpbmNsdWRlICIsCiAgICAgICI8JWRlZiAiLAogICAgICAiPCVwYWdlICIsCiAgICAgICI8JWRlZiAiLAogICAgICAiPCVibG9jayAiLAogICAgICAiPCVuYW1lc3BhY2UgIiwKICAgICAgIjwlaW5oZXJpdCAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtYWtvIiwKICAgICAgIm1hbyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCVkb2M+IiwKICAgICAgICAiPC8lZG9jPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk1hcmtkb3duIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibWQiLAogICAgICAibWFya2Rvd24iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk1lc29uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3JlYWNoICIsCiAgICAgICJpZiAiLAogICAgICAiZWxpZiAiLAogICAgICAidW5sZXNzICIsCiAgICAgICJhbmQgIiwKICAgICAgIm9yICIsCiAgICAgICJlbHNlICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1lc29uLmJ1aWxkIiwKICAgICAgIm1lc29uX29wdGlvbnMudHh0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInJyciLAogICAgICAgICJzdGFydCI6ICInJyciCiAgICAgIH0KICAgIF0KICB9LAogICJNQVRMQUIiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmICIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgImNhc2UgIiwKICAgICAgIm90aGVyd2lzZSAiLAogICAgICAidHJ5IiwKICAgICAgImZvciAiLAogICAgICAid2hpbGUgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibSIKICAgIF0sCiAgICAia2V5d29yZHMiOiBbCiAgICAgICJleWUoIiwKICAgICAgInplcm9zKCIsCiAgICAgICJvbmVzKCIsCiAgICAgICJ0cmFuc3Bvc2UoIiwKICAgICAgIm1lc2hncmlkKCIsCiAgICAgICJtb2QoIiwKICAgICAgImNsYXNzZGVmIiwKICAgICAgImZ1bmN0aW9uIiwKICAgICAgImRpc3AoIiwKICAgICAgInNpbigiLAogICAgICAidGFuKCIsCiAgICAgICJjb3MoIiwKICAgICAgInBsb3QiLAogICAgICAic3FydCgiLAogICAgICAiZGVibGFuaygiLAogICAgICAiZmluZHN0cigiLAogICAgICAic3RycmVwKCIsCiAgICAgICJzdHJjbXAoIiwKICAgICAgImRpc3BsYXkoIiwKICAgICAgInN0cmNhdCgiLAogICAgICAiaXNjZWxsc3RyKCIsCiAgICAgICJzdHJmaW5kKCIsCiAgICAgICIlIiwKICAgICAgImZwcmludGYoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIlIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIiV7IiwKICAgICAgICAifSUiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIk1vZHVsYTMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm0zIiwKICAgICAgIm1nIiwKICAgICAgImlnIiwKICAgICAgImkzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiTW9kdWxlLURlZmluaXRpb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImRlZiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTW9ua2V5IEMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1jIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIk11c3RhY2hlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtdXN0YWNoZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7eyEiLAogICAgICAgICJ9fSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIk5pYWwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImNhc2UgIiwKICAgICAgImZvciAiLAogICAgICAiaWYgIiwKICAgICAgInJlcGVhdCAiLAogICAgICAid2hpbGUgIiwKICAgICAgIm9yICIsCiAgICAgICJhbmQgIiwKICAgICAgIj0gIiwKICAgICAgImVxdWFsICIsCiAgICAgICJ+PSAiLAogICAgICAidW5lcXVhbCAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJuZGYiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiTmltIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJuaW0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiXFxcIlxcXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJOaXgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm5peCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJPQ2FtbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibWwiLAogICAgICAibWxpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiT2JqZWN0aXZlIEMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm0iCiAgICBdLAogICAgImtleXdvcmRzIjogWwogICAgICAiI2luY2x1ZGUiLAogICAgICAicHJpbnRmIiwKICAgICAgInN0ZGlvLmgiLAogICAgICAiLmgiLAogICAgICAiQGltcG9ydCIsCiAgICAgICJAaW50ZXJmYWNlIiwKICAgICAgIkBwcm9wZXJ0eSIsCiAgICAgICJAaW1wbGVtZW50YXRpb24iLAogICAgICAiTlNBcnJheSIsCiAgICAgICIjcHJhZ21hIiwKICAgICAgInN0YXRpYyIsCiAgICAgICJjb25zdCIsCiAgICAgICJhdG9taWMiLAogICAgICAiQGVuZCIsCiAgICAgICIvLyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJPYmplY3RpdmUgQysrIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtbSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJPcGFsYW5nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJvcGEiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiT3JnIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJvcmciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMgIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJPeiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAib3oiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJQSFAiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBocCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIsCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAicGhwIiwKICAgICAgInBocDUiCiAgICBdCiAgfSwKICAiUEtHQlVJTEQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwa2didWlsZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUEwvU1FMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJhbmQgIiwKICAgICAgImFuZCgiLAogICAgICAiZWxzZSAiLAogICAgICAiZWxzZSgiLAogICAgICAiZWxzZWlmICIsCiAgICAgICJlbHNlaWYoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAibG9vcCAiLAogICAgICAibm90ICIsCiAgICAgICJub3QoIiwKICAgICAgIm9yICIsCiAgICAgICJvcigiLAogICAgICAiPD4gIiwKICAgICAgIjw+KCIsCiAgICAgICI9ICIsCiAgICAgICI9KCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZuYyIsCiAgICAgICJwa2IiLAogICAgICAicGtzIiwKICAgICAgInByYyIsCiAgICAgICJ0cmciLAogICAgICAidnciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIlBTTCBBc3NlcnRpb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBzbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJQYXNjYWwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBhcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAieyIsCiAgICAgICAgIn0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiUGF0Y2giOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwYXRjaCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUGVybCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicGwiLAogICAgICAicG0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPXBvZCIsCiAgICAgICAgIj1jdXQiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJwZXJsIiwKICAgICAgInBlcmw1IgogICAgXQogIH0sCiAgIlBpY2F0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJkbyAiLAogICAgICAiZm9yZWFjaCAiLAogICAgICAiZm9yZWFjaCgiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJ3aGlsZSgiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicGkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJQbGFpbiBUZXh0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGV4dCIsCiAgICAgICJ0eHQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlBvbGx5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwb2xseSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlBvbnkiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiaWYgIiwKICAgICAgIm1hdGNoICIsCiAgICAgICJyZXBlYXQiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgImVsc2VpZiAiLAogICAgICAifCAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicG9ueSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCJcXFwiXFxcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlBvd2Vyc2hlbGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIndoaWxlICIsCiAgICAgICJ3aGlsZSgiLAogICAgICAidW50aWwgIiwKICAgICAgInVudGlsKCIsCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiZm9yZWFjaCAiLAogICAgICAiZm9yZWFjaCgiLAogICAgICAiaWYgIiwKICAgICAgImVsc2VpZiAiLAogICAgICAiZWxzZSAiLAogICAgICAic3dpdGNoIiwKICAgICAgInN3aXRjaCgiLAogICAgICAiLWd0IiwKICAgICAgIi1sdCIsCiAgICAgICItZXEiLAogICAgICAiLW5lIiwKICAgICAgIi1nZSIsCiAgICAgICItbGUiLAogICAgICAiLWluIiwKICAgICAgIi1ub3RpbiIsCiAgICAgICItY29udGFpbnMiLAogICAgICAiLW5vdGNvbnRhaW5zIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHMxIiwKICAgICAgInBzbTEiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCMiLAogICAgICAgICIjPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJQcm9jZXNzaW5nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwZGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUHJvbG9nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwIiwKICAgICAgInBybyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlByb3BlcnRpZXMgRmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInByb3BlcnRpZXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlByb3RvY29sIEJ1ZmZlcnMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInByb3RvIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUHVwcGV0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI9YmVnaW4iLAogICAgICAgICI9ZW5kIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiUHVyZVNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHVycyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlB5dGhvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgIndoaWxlICIsCiAgICAgICJ3aGlsZSgiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJlbGlmICIsCiAgICAgICJlbGlmKCIsCiAgICAgICJlbHNlICIsCiAgICAgICJlbHNlOiIsCiAgICAgICJtYXRjaCAiLAogICAgICAibWF0Y2goIiwKICAgICAgInRyeSAiLAogICAgICAidHJ5OiIsCiAgICAgICJleGNlcHQgIiwKICAgICAgImV4Y2VwdCgiLAogICAgICAiZmluYWxseSAiLAogICAgICAiZmluYWxseToiLAogICAgICAid2l0aCAiLAogICAgICAid2l0aCAoIiwKICAgICAgImFuZCAiLAogICAgICAiYW5kKCIsCiAgICAgICJvciAiLAogICAgICAib3IoIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImRvY1N0cmluZyI6IHRydWUsCiAgICAgICAgImVuZCI6ICJcIlwiXCIiLAogICAgICAgICJzdGFydCI6ICJcIlwiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZG9jU3RyaW5nIjogdHJ1ZSwKICAgICAgICAiZW5kIjogIicnJyIsCiAgICAgICAgInN0YXJ0IjogIicnJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJkb2NTdHJpbmciOiB0cnVlLAogICAgICAgICJlbmQiOiAiXCJcIlwiIiwKICAgICAgICAic3RhcnQiOiAiclwiXCJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJkb2NTdHJpbmciOiB0cnVlLAogICAgICAgICJlbmQiOiAiJycnIiwKICAgICAgICAic3RhcnQiOiAicicnJyIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgInB5dGhvbiIsCiAgICAgICJweXRob24yIiwKICAgICAgInB5dGhvbjMiCiAgICBdCiAgfSwKICAiUFJRTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiY2FzZSAiLAogICAgICAiJiYgIiwKICAgICAgInx8ICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIiwKICAgICAgIn49ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBycWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImRvY1N0cmluZyI6IHRydWUsCiAgICAgICAgImVuZCI6ICJcIlwiXCIiLAogICAgICAgICJzdGFydCI6ICJcIlwiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZG9jU3RyaW5nIjogdHJ1ZSwKICAgICAgICAiZW5kIjogIicnJyIsCiAgICAgICAgInN0YXJ0IjogIicnJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJkb2NTdHJpbmciOiB0cnVlLAogICAgICAgICJlbmQiOiAiXCJcIlwiIiwKICAgICAgICAic3RhcnQiOiAiclwiXCJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJkb2NTdHJpbmciOiB0cnVlLAogICAgICAgICJlbmQiOiAiJycnIiwKICAgICAgICAic3RhcnQiOiAicicnJyIKICAgICAgfQogICAgXQogIH0sCiAgIlEjIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAicmVwZWF0ICIsCiAgICAgICJyZXBlYXR7IiwKICAgICAgInVudGlsICgiLAogICAgICAidW50aWwoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAiZWxpZiAiLAogICAgICAiZWxpZnsiLAogICAgICAiZWxzZSAiLAogICAgICAiZWxzZXsiLAogICAgICAifHx8ICIsCiAgICAgICImJiYgIiwKICAgICAgIjw8PCIsCiAgICAgICI+Pj4iLAogICAgICAiXl5eIiwKICAgICAgIn5+fiIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUUNMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJxY2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUU1MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJxbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiciIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUmFrZWZpbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFtdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgInJha2UiLAogICAgICAicmFrZWZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPWJlZ2luIiwKICAgICAgICAiPWVuZCIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIlJha3UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIj09ICIsCiAgICAgICLiiaEgIiwKICAgICAgIiE9ICIsCiAgICAgICLiiaAgIiwKICAgICAgIiE9PSAiLAogICAgICAi4omiICIsCiAgICAgICI8ICIsCiAgICAgICLiioIgIiwKICAgICAgIiE8ICIsCiAgICAgICLiioQgIiwKICAgICAgIjw9ICIsCiAgICAgICLiiaQgIiwKICAgICAgIuKKhiAiLAogICAgICAiITw9ICIsCiAgICAgICLiioggIiwKICAgICAgIj4gIiwKICAgICAgIuKKgyAiLAogICAgICAiIT4gIiwKICAgICAgIuKKhSAiLAogICAgICAiPj0gIiwKICAgICAgIuKJpSAiLAogICAgICAi4oqHICIsCiAgICAgICIhPj0gIiwKICAgICAgIuKKiSAiLAogICAgICAiPX49ICIsCiAgICAgICLiiYUgIiwKICAgICAgIj09PSAiLAogICAgICAiZXEgIiwKICAgICAgIiFlcSAiLAogICAgICAiZXF2ICIsCiAgICAgICJuZSAiLAogICAgICAiZ3QgIiwKICAgICAgImdlICIsCiAgICAgICJsdCAiLAogICAgICAibGUgIiwKICAgICAgIj06PSIsCiAgICAgICJDQVRDSCAiLAogICAgICAiQ09OVFJPTCAiLAogICAgICAiRE9DICIsCiAgICAgICJORVhUICIsCiAgICAgICJhbmQgIiwKICAgICAgImRlZmF1bHQgIiwKICAgICAgImRvIHsiLAogICAgICAiZWxzZSAiLAogICAgICAiZWxzaWYgIiwKICAgICAgImVtaXQgIiwKICAgICAgImZvciAiLAogICAgICAiZ2F0aGVyICIsCiAgICAgICJnaXZlbiAiLAogICAgICAiaWYgIiwKICAgICAgImxhc3QgIiwKICAgICAgImxvb3AgKCIsCiAgICAgICJuZXh0ICIsCiAgICAgICJvbmNlICIsCiAgICAgICJvciAiLAogICAgICAib3J3aXRoICIsCiAgICAgICJyZWFjdCB7IiwKICAgICAgInJlZG8gIiwKICAgICAgInJlcGVhdCAiLAogICAgICAic3RhcnQgeyIsCiAgICAgICJzdXBwbHkgIiwKICAgICAgInVubGVzcyAiLAogICAgICAidW50aWwgIiwKICAgICAgIndoZW4gIiwKICAgICAgIndoZW5ldmVyICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAid2l0aCAiLAogICAgICAid2l0aG91dCAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyYWt1IiwKICAgICAgInJha3Vtb2QiLAogICAgICAicmFrdXRlc3QiLAogICAgICAicmFrdWRvYyIsCiAgICAgICJ0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIj1iZWdpbiIsCiAgICAgICAgIj1lbmQiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiI2AoIiwKICAgICAgICAiKSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIjYFsiLAogICAgICAgICJdIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiNgeyIsCiAgICAgICAgIn0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiI2DvvaIiLAogICAgICAgICLvvaMiCiAgICAgIF0KCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICLigJwiLAogICAgICAgICJzdGFydCI6ICLigJ4iCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIsK7IiwKICAgICAgICAic3RhcnQiOiAiwqsiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIj4+IiwKICAgICAgICAic3RhcnQiOiAiPDwiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIuKAnSIsCiAgICAgICAgInN0YXJ0IjogIuKAnCIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAi4oCYIiwKICAgICAgICAic3RhcnQiOiAi4oCZIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICLvvaMiLAogICAgICAgICJzdGFydCI6ICLvvaIiCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJyYWt1IgogICAgXQogIH0sCiAgIlJhem9yIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc2h0bWwiLAogICAgICAicmF6b3IiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJAKiIsCiAgICAgICAgIipAIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUmVTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIsCiAgICAgICI9PT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicmVzIiwgCiAgICAgICJyZXNpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsIAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJSZVN0cnVjdHVyZWRUZXh0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicnN0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJSZXBvcnQgRGVmaW5pdGlvbiBMYW5ndWFnZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJkbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlJvYm90IEZyYW1ld29yayI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJvYm90IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJSdWJ5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyYiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI9YmVnaW4iLAogICAgICAgICI9ZW5kIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAicnVieSIKICAgIF0KICB9LAogICJSdWJ5IEhUTUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJodG1sIiwKICAgICAgImVyYiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlJ1c3QiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIsCiAgICAgICJtYXRjaCAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJycyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlNBUyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZG8iLAogICAgICAiJWRvIiwKICAgICAgImlmIiwKICAgICAgIiVpZiIsCiAgICAgICJlbHNlIiwKICAgICAgIiVlbHNlIiwKICAgICAgImNhc2UiLAogICAgICAib3IiLAogICAgICAiYW5kIiwKICAgICAgIl49IiwKICAgICAgIsKsPSIsCiAgICAgICJ+PSIsCiAgICAgICJuZSIsCiAgICAgICJlcSIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNhcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiKiIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJTS0lMTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJTTk9CT0wiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIjooIiwKICAgICAgIjpzKCIsCiAgICAgICI6ZigiLAogICAgICAiZXEgIiwKICAgICAgIm5lICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNubyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiKiIKICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIlNQRFgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzcGR4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJTUUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNxbCIsCiAgICAgICJkbWwiLAogICAgICAiZGRsIiwKICAgICAgImRxbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiU1JlY29kZSBUZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3J0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7OyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiU1ZHIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzdmciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJTYXNzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzYXNzIiwKICAgICAgInNjc3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiU2NhbGEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNjIiwKICAgICAgInNjYWxhIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlNjaGVtZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2NtIiwKICAgICAgInNzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIiN8IiwKICAgICAgICAifCMiCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbXSwKICAgICJzaGViYW5ncyI6IFtdCiAgfSwKICAiUmFja2V0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICIoaWYiLAogICAgICAiKGNvbmQiLAogICAgICAiW2Vsc2UiLAogICAgICAiKGFuZCIsCiAgICAgICIob3IiLAogICAgICAiKGZvciIsCiAgICAgICIjOndoZW4iLAogICAgICAiIzp1bmxlc3MiLAogICAgICAiIzpicmVhayIsCiAgICAgICIjOmZpbmFsIiwKICAgICAgIihkbyIsCiAgICAgICIod2hlbiIsCiAgICAgICIodW5sZXNzIiwKICAgICAgIihzaGFyZWQiLAogICAgICAiKGNhc2UiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJya3QiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAifCMiLAogICAgICAgICIjfCIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgInJhY2tldCIKICAgIF0KICB9LAogICJTY29ucyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3NpZyIsCiAgICAgICJzY29uc3RydWN0IiwKICAgICAgInNjb25zY3JpcHQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIlxcXCJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInJyciLAogICAgICAgICJzdGFydCI6ICInJyciCiAgICAgIH0KICAgIF0KICB9LAogICJTaGVsbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2giCiAgICBdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgIi50Y3NocmMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgInNoIgogICAgXQogIH0sCiAgIk51c2hlbGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZG8geyAiLAogICAgICAiZWFjaCB7IiwKICAgICAgImlmICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJudSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJudSIKICAgIF0KICB9LAogICJTbWFydHkgVGVtcGxhdGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIsCiAgICAgICJpbmNsdWRlICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRwbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7KiIsCiAgICAgICAgIip9IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiU29mdGJyaWRnZSBCYXNpYyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAiZWxzZWlmICIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzYmwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiciCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9CiAgICBdCiAgfSwKICAiU29saWRpdHkiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIsCiAgICAgICJhc3NlbWJseSAiLAogICAgICAiYXNzZW1ibHl7IiwKICAgICAgInVuY2hlY2tlZCAiLAogICAgICAidW5jaGVja2VkeyIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNvbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJTcGVjbWFuIGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIiwKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIic+IiwKICAgICAgICAiPCciCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJTcGljZSBOZXRsaXN0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJja3QiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIioiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlN0YW5kYXJkIE1MIChTTUwpIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJTdGF0YSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yZWFjaCIsCiAgICAgICJmb3J2YWx1ZXMiLAogICAgICAiaWYiLAogICAgICAiZWxzZSIsCiAgICAgICJ3aGlsZSIsCiAgICAgICJzd2l0Y2giLAogICAgICAifCIsCiAgICAgICImIiwKICAgICAgIiE9IiwKICAgICAgIj09IgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZG8iLAogICAgICAiYWRvIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIsCiAgICAgICIqIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiciLAogICAgICAgICJzdGFydCI6ICJgXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJTdHlsdXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiaWYgIiwKICAgICAgInVubGVzcyAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3R5bCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJTdmVsdGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInN2ZWx0ZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXSwKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlN3aWZ0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzd2lmdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlN3aWciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiU3lzdGVtVmVyaWxvZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifCAiLAogICAgICAiJiAiLAogICAgICAiIT0gIiwKICAgICAgIiE9PSAiLAogICAgICAiPT0gIiwKICAgICAgImZvcmVhY2ggIiwKICAgICAgImZvcmVhY2goIiwKICAgICAgImNhc2UgIiwKICAgICAgImNhc2UoIiwKICAgICAgImNhc2V4ICIsCiAgICAgICJjYXNleCgiLAogICAgICAiY2FzZXogIiwKICAgICAgImNhc2V6KCIsCiAgICAgICJjYXNleHogIiwKICAgICAgImNhc2V4eigiLAogICAgICAiZm9yayAiLAogICAgICAiID8gIiwKICAgICAgImluc2lkZSIsCiAgICAgICJ3aXRoIiwKICAgICAgImV2ZW50ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInN2IiwKICAgICAgInN2aCIKICAgIF0sCiAgICAia2V5d29yZHMiOiBbCiAgICAgICJlbmRtb2R1bGUiLAogICAgICAicG9zZWRnZSIsCiAgICAgICJlZGdlIiwKICAgICAgImFsd2F5cyIsCiAgICAgICJ3aXJlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlN5c3RlbWQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhdXRvbW91bnQiLAogICAgICAiZGV2aWNlIiwKICAgICAgImxpbmsiLAogICAgICAibW91bnQiLAogICAgICAicGF0aCIsCiAgICAgICJzY29wZSIsCiAgICAgICJzZXJ2aWNlIiwKICAgICAgInNsaWNlIiwKICAgICAgInNvY2tldCIsCiAgICAgICJzd2FwIiwKICAgICAgInRhcmdldCIsCiAgICAgICJ0aW1lciIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVENMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0Y2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgInRjbCIKICAgIF0KICB9LAogICJUT01MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0b21sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCJcXFwiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJycnIiwKICAgICAgICAic3RhcnQiOiAiJycnIgogICAgICB9CiAgICBdCiAgfSwKICAiVGFza1BhcGVyIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGFza3BhcGVyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJUZWFsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJsb29wOiIsCiAgICAgICJyZXRzdWIiLAogICAgICAiY2FsbHN1YiAiLAogICAgICAiJiYiLAogICAgICAiPT0iLAogICAgICAifHwiLAogICAgICAiPD0iLAogICAgICAiPj0iCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0ZWFsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVGVtcGxhdGVUb29sa2l0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJbJSBCTE9DSyIsCiAgICAgICJbJSBGSUxURVIiLAogICAgICAiWyUgRk9SIiwKICAgICAgIlslIEZPUkVBQ0giLAogICAgICAiWyUgSUYiLAogICAgICAiWyUgSU5DTFVERSIsCiAgICAgICJbJSBNQUNSTyIsCiAgICAgICJbJSBQUk9DRVNTIiwKICAgICAgIlslIFNXSVRDSCIsCiAgICAgICJbJSBVTkxFU1MiLAogICAgICAiWyUgV1JBUFBFUiIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInR0IiwKICAgICAgInR0MiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJbJSMiLAogICAgICAgICIlXSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlRlbXBsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZiAiLAogICAgICAiIGVsc2UgIiwKICAgICAgInN3aXRjaCAiLAogICAgICAiY2FzZSAiLAogICAgICAiZGVmYXVsdDoiLAogICAgICAiZm9yICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0ZW1wbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogImAiLAogICAgICAgICJpZ25vcmVFc2NhcGUiOiB0cnVlLAogICAgICAgICJzdGFydCI6ICJgIgogICAgICB9CiAgICBdCiAgfSwKICAiVGVycmFmb3JtIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJjb3VudCIsCiAgICAgICJmb3IiLAogICAgICAiZm9yX2VhY2giLAogICAgICAiaWYiLAogICAgICAiOiAiLAogICAgICAiPyAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPiAiLAogICAgICAiPj0gIiwKICAgICAgIjwgIiwKICAgICAgIjw9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0ZiIsCiAgICAgICJ0Zi5qc29uIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIiwKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJUZVgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRleCIsCiAgICAgICJzdHkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlRleHRpbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0ZXh0aWxlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIyMuICIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIjIyMuLiAiLAogICAgICAgICJwLiAiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJUaHJpZnQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0aHJpZnQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIiwKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiVHdpZyBUZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAieyUgZm9yICIsCiAgICAgICJ7JSBpZiAiLAogICAgICAieyUgZWxzZSAiLAogICAgICAieyUgZWxzZWlmICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInR3aWciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlR5cGVTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInwgIiwKICAgICAgInx8ICIsCiAgICAgICImICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIiE9PSAiLAogICAgICAiPT0gIiwKICAgICAgIj09PSAiLAogICAgICAiZm9yZWFjaCgiLAogICAgICAiY2FzZSAiLAogICAgICAiY2FzZSgiLAogICAgICAiY2FzZXggIiwKICAgICAgImNhc2V4KCIsCiAgICAgICJjYXNleiAiLAogICAgICAiY2FzZXooIiwKICAgICAgImNhc2V4eiAiLAogICAgICAiY2FzZXh6KCIsCiAgICAgICJmb3JrIiwKICAgICAgIj8iLAogICAgICAiOiIsCiAgICAgICJpbnNpZGUiLAogICAgICAid2l0aCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRzIiwKICAgICAgInRzeCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJUeXBlU2NyaXB0IFR5cGluZ3MiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInwgIiwKICAgICAgInx8ICIsCiAgICAgICImICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIiE9PSAiLAogICAgICAiPT0gIiwKICAgICAgIj09PSAiLAogICAgICAiZm9yZWFjaCgiLAogICAgICAiY2FzZSAiLAogICAgICAiY2FzZSgiLAogICAgICAiY2FzZXggIiwKICAgICAgImNhc2V4KCIsCiAgICAgICJjYXNleiAiLAogICAgICAiY2FzZXooIiwKICAgICAgImNhc2V4eiAiLAogICAgICAiY2FzZXh6KCIsCiAgICAgICJmb3JrIiwKICAgICAgIj8iLAogICAgICAiOiIsCiAgICAgICJpbnNpZGUiLAogICAgICAid2l0aCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImQudHMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIlRMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIiwKICAgICAgIi8vLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVW5yZWFsIFNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidWMiLAogICAgICAidWNpIiwKICAgICAgInVwa2ciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiVXIvV2ViIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ1ciIsCiAgICAgICJ1cnMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJVci9XZWIgUHJvamVjdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidXJwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJWIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2IgogICAgXSwKICAgICJrZXl3b3JkcyI6IFsKICAgICAgImJyZWFrIiwKICAgICAgImNvbnN0ICIsCiAgICAgICJjb250aW51ZSIsCiAgICAgICJkZWZlciIsCiAgICAgICJlbHNlICIsCiAgICAgICJlbnVtIiwKICAgICAgImZuICIsCiAgICAgICJnb3RvIiwKICAgICAgImltcG9ydCAiLAogICAgICAiaW4gIiwKICAgICAgImludGVyZmFjZSIsCiAgICAgICJtYXRjaCIsCiAgICAgICJtdXQiLAogICAgICAicHJpbnRsbiIsCiAgICAgICJwdWIiLAogICAgICAicmV0dXJuIiwKICAgICAgInN0cnVjdCAiLAogICAgICAidHlwZSAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJgIiwKICAgICAgICAic3RhcnQiOiAiYCIKICAgICAgfQogICAgXQogIH0sCiAgIlZIREwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZoZCIsCiAgICAgICJ2aGRsIgogICAgXSwKICAgICJrZXl3b3JkcyI6IFsKICAgICAgImFicyIsCiAgICAgICJhY2Nlc3MiLAogICAgICAiYWZ0ZXIiLAogICAgICAiYWxpYXMiLAogICAgICAiYWxsIiwKICAgICAgImFuZCIsCiAgICAgICJhcmNoaXRlY3R1cmUiLAogICAgICAiYXJyYXkiLAogICAgICAiYXNzZXJ0IiwKICAgICAgImF0dHJpYnV0ZSIsCiAgICAgICJiZWdpbiIsCiAgICAgICJibG9jayIsCiAgICAgICJib2R5IiwKICAgICAgImJ1ZmZlciIsCiAgICAgICJidXMiLAogICAgICAiY2FzZSIsCiAgICAgICJjb21wb25lbnQiLAogICAgICAiY29uZmlndXJhdGlvbiIsCiAgICAgICJjb25zdGFudCIsCiAgICAgICJkaXNjb25uZWN0IiwKICAgICAgImRvd250byIsCiAgICAgICJlbHNlIiwKICAgICAgImVsc2lmIiwKICAgICAgImVuZCIsCiAgICAgICJlbnRpdHkiLAogICAgICAiZXhpdCIsCiAgICAgICJmaWxlIiwKICAgICAgImZvciIsCiAgICAgICJmdW5jdGlvbiIsCiAgICAgICJnZW5lcmF0ZSIsCiAgICAgICJnZW5lcmljIiwKICAgICAgImdyb3VwIiwKICAgICAgImd1YXJkZWQiLAogICAgICAiaWYiLAogICAgICAiaW1wdXJlIiwKICAgICAgImluIiwKICAgICAgImluZXJ0aWFsIiwKICAgICAgImlub3V0IiwKICAgICAgImlzIiwKICAgICAgImxhYmVsIiwKICAgICAgImxpYnJhcnkiLAogICAgICAibGlua2FnZSIsCiAgICAgICJsaXRlcmFsIiwKICAgICAgImxvb3AiLAogICAgICAibWFwIiwKICAgICAgIm1vZCIsCiAgICAgICJuYW5kIiwKICAgICAgIm5ldyIsCiAgICAgICJuZXh0IiwKICAgICAgIm5vciIsCiAgICAgICJub3QiLAogICAgICAibnVsbCIsCiAgICAgICJvZiIsCiAgICAgICJvbiIsCiAgICAgICJvcGVuIiwKICAgICAgIm9yIiwKICAgICAgIm90aGVycyIsCiAgICAgICJvdXQiLAogICAgICAicGFja2FnZSIsCiAgICAgICJwb3J0IiwKICAgICAgInBvc3Rwb25lZCIsCiAgICAgICJwcm9jZWR1cmUiLAogICAgICAicHJvY2VzcyIsCiAgICAgICJwdXJlIiwKICAgICAgInJhbmdlIiwKICAgICAgInJlY29yZCIsCiAgICAgICJyZWdpc3RlciIsCiAgICAgICJyZWplY3QiLAogICAgICAicmVtIiwKICAgICAgInJlcG9ydCIsCiAgICAgICJyZXR1cm4iLAogICAgICAicm9sIiwKICAgICAgInJvciIsCiAgICAgICJzZWxlY3QiLAogICAgICAic2V2ZXJpdHkiLAogICAgICAic2hhcmVkIiwKICAgICAgInNpZ25hbCIsCiAgICAgICJzbGEiLAogICAgICAic2xsIiwKICAgICAgInNyYSIsCiAgICAgICJzcmwiLAogICAgICAic3VidHlwZSIsCiAgICAgICJ0aGVuIiwKICAgICAgInRvIiwKICAgICAgInRyYW5zcG9ydCIsCiAgICAgICJ0eXBlIiwKICAgICAgInVuYWZmZWN0ZWQiLAogICAgICAidW5pdHMiLAogICAgICAidW50aWwiLAogICAgICAidXNlIiwKICAgICAgInZhcmlhYmxlIiwKICAgICAgIndhaXQiLAogICAgICAid2hlbiIsCiAgICAgICJ3aGlsZSIsCiAgICAgICJ3aXRoIiwKICAgICAgInhub3IiLAogICAgICAieG9yIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVmFsYSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidmFsYSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJWYXJuaXNoIENvbmZpZ3VyYXRpb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2Y2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiLAogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlZlcmlsb2ciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZnIiwKICAgICAgInZoIiwKICAgICAgInYiCiAgICBdLAogICAgImtleXdvcmRzIjogWwogICAgICAibW9kdWxlIiwKICAgICAgImVuZG1vZHVsZSIsCiAgICAgICJ0aW1lc2NhbGUiLAogICAgICAiaW5wdXQiLAogICAgICAib3V0cHV0IiwKICAgICAgInJlZyIsCiAgICAgICJ3aXJlIiwKICAgICAgInBvc2VkZ2UiLAogICAgICAibmVnZWRnZSIsCiAgICAgICJhbHdheXMiLAogICAgICAiYmVnaW4iLAogICAgICAic3dpdGNoIiwKICAgICAgImNhc2UiLAogICAgICAiZW5kIiwKICAgICAgImVuZGNhc2UiLAogICAgICAiZWxzZSIsCiAgICAgICJsb2NhbHBhcmFtIiwKICAgICAgImluaXRpYWwiLAogICAgICAic2lnbmVkIiwKICAgICAgImFzc2lnbiIsCiAgICAgICJnZW5lcmF0ZSIsCiAgICAgICJnZW52YXIiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiVmVyaWxvZyBBcmdzIEZpbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpcnVuYXJncyIsCiAgICAgICJ4cnVuYXJncyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVmVydGV4IFNoYWRlciBGaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2c2giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiVmltIFNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidmltIiwKICAgICAgInZpbXJjIiwKICAgICAgImd2aW1yYyIKICAgIF0sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAiX3ZpbXJjIiwKICAgICAgIi52aW1yYyIsCiAgICAgICJfZ3ZpbXJjIiwKICAgICAgIi5ndmltcmMiLAogICAgICAidmltcmMiLAogICAgICAiZ3ZpbXJjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICJcIiIsCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJWaXN1YWwgQmFzaWMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgImVsc2VpZiAiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidmIiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiciCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9CiAgICBdCiAgfSwKICAiVmlzdWFsIEJhc2ljIGZvciBBcHBsaWNhdGlvbnMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgImVsc2VpZiAiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY2xzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICInIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlZ1ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidnVlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIldlYiBTZXJ2aWNlcyBEZXNjcmlwdGlvbiBMYW5ndWFnZSI6IHsKICAgICJleHRlbnNpb25zIjogWwogICAgICAid3NkbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIldvbGZyYW0iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm5iIiwKICAgICAgIndsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiV3JlbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAid3JlbiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlhBTUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInhhbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJYY29kZSBDb25maWciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4Y2NvbmZpZyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlhNTCI6IHsKICAgICJleHRlbnNpb25zIjogWwogICAgICAieG1sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiWE1MIFNjaGVtYSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInhzZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiWHRlbmQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInh0ZW5kIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIllhcm4iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIjw8aWYgIiwKICAgICAgIjw8ZWxzZWlmICIsCiAgICAgICI8PGVsc2UgIiwKICAgICAgIiBlcSAiLAogICAgICAiID09ICIsCiAgICAgICIgbmVxICIsCiAgICAgICIgISAiLAogICAgICAiIGd0ICIsCiAgICAgICIgPiAiLAogICAgICAiIGx0ICIsCiAgICAgICIgPCAiLAogICAgICAiIGx0ZSAiLAogICAgICAiIDw9ICIsCiAgICAgICIgZ3RlICIsCiAgICAgICIgPj0gIiwKICAgICAgIiB4b3IgIiwKICAgICAgIiBeICIsCiAgICAgICIgYW5kICIsCiAgICAgICIgJiYgIiwKICAgICAgIiB8fCAiLAogICAgICAiIG9yICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInlhcm4iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiWUFNTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInlhbWwiLAogICAgICAieW1sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDbG91ZEZvcm1hdGlvbiAoWUFNTCkiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIiFHZXRBdHQiLAogICAgICAiIVN1YiIsCiAgICAgICIhU2VsZWN0IiwKICAgICAgIiFFcXVhbHMiLAogICAgICAiIUlmIiwKICAgICAgIkRlcGVuZHNPbjoiLAogICAgICAiIVNlbGVjdCIsCiAgICAgICIhRXF1YWxzIiwKICAgICAgIiFJZiIsCiAgICAgICJGbjo6SWYiLAogICAgICAiRm46OkFuZCIsCiAgICAgICJGbjo6RXF1YWxzIiwKICAgICAgIkZuOjpOb3QiLAogICAgICAiRm46Ok9yIiwKICAgICAgIkZuOjpCYXNlNjQiLAogICAgICAiRm46OkNpZHIiLAogICAgICAiRm46OkZpbmRJbk1hcCIsCiAgICAgICJGbjo6R2V0QXR0IiwKICAgICAgIkZuOjpHZXRBWnMiLAogICAgICAiRm46OkltcG9ydFZhbHVlIiwKICAgICAgIkZuOjpKb2luIiwKICAgICAgIkZuOjpTZWxlY3QiLAogICAgICAiRm46OlNwbGl0IiwKICAgICAgIkZuOjpTdWIiLAogICAgICAiRm46OlRyYW5zZm9ybSIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInlhbWwiLAogICAgICAieW1sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10sCiAgICAia2V5d29yZHMiOiBbCiAgICAgICJSZXNvdXJjZXM6IiwKICAgICAgIkFXU1RlbXBsYXRlRm9ybWF0VmVyc2lvbjoiLAogICAgICAiRGVzY3JpcHRpb246IiwKICAgICAgIkFXUzo6IiwKICAgICAgIlByb3BlcnRpZXM6IiwKICAgICAgIk5hbWU6IiwKICAgICAgIlR5cGU6IiwKICAgICAgIiFHZXRBdHQiLAogICAgICAiIVN1YiIsCiAgICAgICJTdGF0ZW1lbnQ6IiwKICAgICAgIlJlZjoiLAogICAgICAiRGVwZW5kc09uOiIsCiAgICAgICIhU2VsZWN0IiwKICAgICAgIiFFcXVhbHMiLAogICAgICAiIUlmIiwKICAgICAgIkZuOjpJZiIsCiAgICAgICJGbjo6QW5kIiwKICAgICAgIkZuOjpFcXVhbHMiLAogICAgICAiRm46Ok5vdCIsCiAgICAgICJGbjo6T3IiLAogICAgICAiRm46OkJhc2U2NCIsCiAgICAgICJGbjo6Q2lkciIsCiAgICAgICJGbjo6RmluZEluTWFwIiwKICAgICAgIkZuOjpHZXRBdHQiLAogICAgICAiRm46OkdldEFacyIsCiAgICAgICJGbjo6SW1wb3J0VmFsdWUiLAogICAgICAiRm46OkpvaW4iLAogICAgICAiRm46OlNlbGVjdCIsCiAgICAgICJGbjo6U3BsaXQiLAogICAgICAiRm46OlN1YiIsCiAgICAgICJGbjo6VHJhbnNmb3JtIgogICAgXQogIH0sCiAgIkNsb3VkRm9ybWF0aW9uIChKU09OKSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiIUdldEF0dCIsCiAgICAgICIhU3ViIiwKICAgICAgIiFTZWxlY3QiLAogICAgICAiIUVxdWFscyIsCiAgICAgICIhSWYiLAogICAgICAiRGVwZW5kc09uOiIsCiAgICAgICIhU2VsZWN0IiwKICAgICAgIiFFcXVhbHMiLAogICAgICAiIUlmIiwKICAgICAgIkZuOjpJZiIsCiAgICAgICJGbjo6QW5kIiwKICAgICAgIkZuOjpFcXVhbHMiLAogICAgICAiRm46Ok5vdCIsCiAgICAgICJGbjo6T3IiLAogICAgICAiRm46OkJhc2U2NCIsCiAgICAgICJGbjo6Q2lkciIsCiAgICAgICJGbjo6RmluZEluTWFwIiwKICAgICAgIkZuOjpHZXRBdHQiLAogICAgICAiRm46OkdldEFacyIsCiAgICAgICJGbjo6SW1wb3J0VmFsdWUiLAogICAgICAiRm46OkpvaW4iLAogICAgICAiRm46OlNlbGVjdCIsCiAgICAgICJGbjo6U3BsaXQiLAogICAgICAiRm46OlN1YiIsCiAgICAgICJGbjo6VHJhbnNmb3JtIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianNvbiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdLAogICAgImtleXdvcmRzIjogWwogICAgICAiXCJBV1NUZW1wbGF0ZUZvcm1hdFZlcnNpb25cIiIsCiAgICAgICJBV1M6OiIsCiAgICAgICIhR2V0QXR0IiwKICAgICAgIiFTdWIiLAogICAgICAiXCJEZXBlbmRzT25cIiIsCiAgICAgICIhU2VsZWN0IiwKICAgICAgIiFFcXVhbHMiLAogICAgICAiIUlmIiwKICAgICAgIkZuOjpJZiIsCiAgICAgICJGbjo6QW5kIiwKICAgICAgIkZuOjpFcXVhbHMiLAogICAgICAiRm46Ok5vdCIsCiAgICAgICJGbjo6T3IiLAogICAgICAiRm46OkJhc2U2NCIsCiAgICAgICJGbjo6Q2lkciIsCiAgICAgICJGbjo6RmluZEluTWFwIiwKICAgICAgIkZuOjpHZXRBdHQiLAogICAgICAiRm46OkdldEFacyIsCiAgICAgICJGbjo6SW1wb3J0VmFsdWUiLAogICAgICAiRm46OkpvaW4iLAogICAgICAiRm46OlNlbGVjdCIsCiAgICAgICJGbjo6U3BsaXQiLAogICAgICAiRm46OlN1YiIsCiAgICAgICJGbjo6VHJhbnNmb3JtIgogICAgXQogIH0sCiAgIlppZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAid2hpbGUgIiwKICAgICAgImZvciAiLAogICAgICAiaWYgIiwKICAgICAgImVsc2UgIiwKICAgICAgImRlZmVyICIsCiAgICAgICJzd2l0Y2giLAogICAgICAifHwiLAogICAgICAiJiYiLAogICAgICAiIT0iLAogICAgICAiPT0iCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ6aWciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcbiIsCiAgICAgICAgInN0YXJ0IjogIlxcXFwiCiAgICAgIH0KICAgIF0KICB9LAogICJac2giOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInpzaCIsCiAgICAgICJ6c2hlbnYiLAogICAgICAiemxvZ2luIiwKICAgICAgInpsb2dvdXQiLAogICAgICAienByb2ZpbGUiLAogICAgICAienNocmMiCiAgICBdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgIi56c2hlbnYiLAogICAgICAiLnpsb2dpbiIsCiAgICAgICIuemxvZ291dCIsCiAgICAgICIuenByb2ZpbGUiLAogICAgICAiLnpzaHJjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJ6c2giCiAgICBdCiAgfSwKICAiZ2l0aWdub3JlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogW10sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAiLmdpdGlnbm9yZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiaWdub3JlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogW10sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAiLmlnbm9yZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAibTQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtNCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAibnVzcGVjIjogewogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJudXNwZWMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJzZWQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgImFuZCAiLAogICAgICAib3IgIiwKICAgICAgIm5vdCAiLAogICAgICAiaW4gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2VkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJzZWQiCiAgICBdCiAgfSwKICAiU2lldmUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmIiwKICAgICAgImlmICIsCiAgICAgICJlbHNpZiIsCiAgICAgICJlbHNpZiAiLAogICAgICAiYWxsb2YiLAogICAgICAiYWxsb2YgIiwKICAgICAgImFueW9mIiwKICAgICAgImFueW9mICIsCiAgICAgICJhbGxvZigiLAogICAgICAiYW55b2YoIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2lldmUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJSZWFzb25NTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicmUiLAogICAgICAicmVpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIk9kaW4iOiB7CiAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAid2hlbiAiLAogICAgICAic3dpdGNoICIsCiAgICAgICJkZWZlciAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAib2RpbiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9Cn0K`
+languages = `ewogICJBQkFQIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhYmFwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIqIiwKICAgICAgIlxcXCIiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFQTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiOkZvciAiLAogICAgICAiOklmICIsCiAgICAgICI6Q2FzZSAiLAogICAgICAiOkNhc2VMaXN0ICIsCiAgICAgICI6V2hpbGUgIiwKICAgICAgIjpSZXBlYXQgIiwKICAgICAgIjpFbHNlICIsCiAgICAgICLiiKgiLAogICAgICAi4oinIiwKICAgICAgIuKJoCIsCiAgICAgICJ+IiwKICAgICAgIsKoIiwKICAgICAgIj0iLAogICAgICAiOiIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFwbCIsCiAgICAgICJhcGxmIiwKICAgICAgImFwbG4iLAogICAgICAiYXBsYyIsCiAgICAgICJkeWFsb2ciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIuKNnSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJBcHBsZVNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiY29uc2lkZXJpbmcgIiwKICAgICAgImlnbm9yaW5nICIsCiAgICAgICJyZXBlYXQgIiwKICAgICAgIndoaWxlICIsCiAgICAgICJpZiAiLAogICAgICAiZWxzZSAiLAogICAgICAiZWxzZSBpZiAiLAogICAgICAidHJ5ICIsCiAgICAgICJvbiBlcnJvciAiLAogICAgICAiYW5kICIsCiAgICAgICJvciAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhcHBsZXNjcmlwdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIsCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFtdCiAgfSwKICAiQVNQIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhc2EiLAogICAgICAiYXNwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICInIiwKICAgICAgIlJFTSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQVNQLk5FVCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYXNheCIsCiAgICAgICJhc2N4IiwKICAgICAgImFzbXgiLAogICAgICAiYXNweCIsCiAgICAgICJtYXN0ZXIiLAogICAgICAic2l0ZW1hcCIsCiAgICAgICJ3ZWJpbmZvIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiPCUtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFUUyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICIgdGhlbiAiLAogICAgICAiIGVsc2UgIiwKICAgICAgImNhc2UrICIsCiAgICAgICJpZmNhc2UiLAogICAgICAibGV0ICIsCiAgICAgICJhbmQgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZGF0cyIsCiAgICAgICJzYXRzIiwKICAgICAgImF0cyIsCiAgICAgICJoYXRzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdLAogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiLy8vLyIsCiAgICAgICAgIlRISVNTSE9VTERORVZFUkFQUEVBUldFSE9QRSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJBV0siOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhd2siCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJhd2siLAogICAgICAiZ2F3ayIsCiAgICAgICJtYXdrIgogICAgXQogIH0sCiAgIkFjdGlvblNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQWRhIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhZGEiLAogICAgICAiYWRiIiwKICAgICAgImFkcyIsCiAgICAgICJwYWQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBZ2RhIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhZ2RhIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7LSIsCiAgICAgICAgIi19IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQWxjaGVtaXN0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICIrIiwKICAgICAgIi0+IiwKICAgICAgIiEiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjcm4iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFsZXgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBbGxveSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiaW1wbGllcyAiLAogICAgICAiZWxzZSAiLAogICAgICAiZm9yICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiLAogICAgICAiPD0gIiwKICAgICAgIj49ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFscyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iLAogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFuZHJvaWQgSW50ZXJmYWNlIERlZmluaXRpb24gTGFuZ3VhZ2UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFpZGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qKiIsCiAgICAgICAgIiovIgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQXZybyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImF2ZGwiLAogICAgICAiYXZwciIsCiAgICAgICJhdnNjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBc2NpaURvYyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFkb2MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFzc2VtYmx5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzIiwKICAgICAgImFzbSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiQXV0b0hvdEtleSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYWhrIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBdXRvY29uZiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaW4iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiLAogICAgICAiZG5sIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJiYWl0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImlmICIsCiAgICAgICJlbHNlICIsCiAgICAgICIgb3IgIiwKICAgICAgIiBhbmQgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJidCIKICAgIF0sCiAgICAia2V5d29yZHMiOiBbCiAgICAgICJhbmQiLAogICAgICAiYnJlYWsiLAogICAgICAiY29uc3QgIiwKICAgICAgImNvbnRpbnVlIiwKICAgICAgImVsc2UgIiwKICAgICAgImZ1biAiLAogICAgICAiaW1wb3J0ICIsCiAgICAgICJub3QgIiwKICAgICAgIm9yICIsCiAgICAgICJwYWNrYWdlICIsCiAgICAgICJyZXR1cm4iLAogICAgICAic3RydWN0ICIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogImAiLAogICAgICAgICJzdGFydCI6ICJgIgogICAgICB9CiAgICBdCiAgfSwKICAiQkFTSCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmFzaCIsCiAgICAgICJiYXNoX2xvZ2luIiwKICAgICAgImJhc2hfbG9nb3V0IiwKICAgICAgImJhc2hfcHJvZmlsZSIsCiAgICAgICJiYXNocmMiCiAgICBdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgIi5iYXNoX2xvZ2luIiwKICAgICAgIi5iYXNoX2xvZ291dCIsCiAgICAgICIuYmFzaF9wcm9maWxlIiwKICAgICAgIi5iYXNocmMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgImJhc2giCiAgICBdCiAgfSwKICAiQmFzaWMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgImVsc2VpZiAiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmFzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICInIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkJhdGNoIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJiYXQiLAogICAgICAiYnRtIiwKICAgICAgImNtZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiUkVNIiwKICAgICAgIjo6IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJCYXplbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYnpsIiwKICAgICAgImJ1aWxkLmJhemVsIiwKICAgICAgImJ1aWxkIiwKICAgICAgIndvcmtzcGFjZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkJpY2VwIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJAbWluTGVuZ3RoKCIsCiAgICAgICJAbWF4TGVuZ3RoKCIsCiAgICAgICJAc2VjdXJlKCIsCiAgICAgICJbZm9yICIsCiAgICAgICJpZigiLAogICAgICAiaWYgKCIsCiAgICAgICIgPT0gIiwKICAgICAgIiAhPSAiLAogICAgICAiID8gIiwKICAgICAgInVzaW5nICIsCiAgICAgICJyYW5nZSgiLAogICAgICAidHlwZSAiLAogICAgICAiZnVuYyAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJiaWNlcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiQml0YmFrZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmIiLAogICAgICAiYmJhcHBlbmQiLAogICAgICAiYmJjbGFzcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkJpdGJ1Y2tldCBQaXBlbGluZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImJpdGJ1Y2tldC1waXBlbGluZXMueW1sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJCbGFkZSB0ZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiQGZvciAiLAogICAgICAiQGZvcigiLAogICAgICAiQGZvcmVhY2ggIiwKICAgICAgIkBmb3JlYWNoKCIsCiAgICAgICJAZm9yZWxzZSAiLAogICAgICAiQGZvcmVsc2UoIiwKICAgICAgIkBlYWNoICIsCiAgICAgICJAZWFjaCAoIiwKICAgICAgIkB3aGlsZSAiLAogICAgICAiQHdoaWxlKCIsCiAgICAgICJAaWYgIiwKICAgICAgIkBpZigiLAogICAgICAiQHVubGVzcyAiLAogICAgICAiQHVubGVzcygiLAogICAgICAiQGlzc2V0ICIsCiAgICAgICJAaXNzZXQoIiwKICAgICAgIkBlbXB0eSAiLAogICAgICAiQGVtcHR5KCIsCiAgICAgICJAZWxzZSAiLAogICAgICAiQGVsc2VpZiAiLAogICAgICAiQGVsc2VpZigiLAogICAgICAiQHdoaWxlICIsCiAgICAgICJAd2hpbGUoIiwKICAgICAgIkBzd2l0Y2ggIiwKICAgICAgIkBzd2l0Y2ggKCIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJibGFkZS5waHAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAie3stLSIsCiAgICAgICAgIi0tfX0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkJvbyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJpZiAiLAogICAgICAiZWxpZiAiLAogICAgICAidW5sZXNzICIsCiAgICAgICIgYW5kICIsCiAgICAgICJmb3IgIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJib28iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiXCJcIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCJcIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQm9zcXVlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAibWF0Y2ggIiwKICAgICAgImNhc2UgIiwKICAgICAgInwgIiwKICAgICAgInx8ICIsCiAgICAgICImICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIiE9PSAiLAogICAgICAiPT0gIiwKICAgICAgIj09PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJic3EiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIkJyYWluZnVjayI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiWyIsCiAgICAgICJdIiwKICAgICAgIjwiLAogICAgICAiPiIsCiAgICAgICIrIiwKICAgICAgIi0iLAogICAgICAiLiIsCiAgICAgICIsIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmYiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkJ1aWxkU3RyZWFtIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYnN0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDIjogewogICAgImJsb2NrX3N0eWxlIjogImJyYWNlcyIsCiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImMiLAogICAgICAiZWMiLAogICAgICAicGdjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkMgSGVhZGVyIjogewogICAgImJsb2NrX3N0eWxlIjogImJyYWNlcyIsCiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImgiCiAgICBdLAogICAgImdlbmVyYXRlZCI6IFsKICAgICAgewogICAgICAgICJmaWxlbmFtZSI6ICJcXC5wYlxcLmgkIiwKICAgICAgICAibmFtZSI6ICJwcm90b2J1ZiIKICAgICAgfQogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkMgU2hlbGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNzaCIKICAgIF0sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAiLmNzaHJjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJjc2giLAogICAgICAidGNzaCIKICAgIF0KICB9LAogICJDIyI6IHsKICAgICJibG9ja19zdHlsZSI6ICJicmFjZXMiLAogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjcyIsCiAgICAgICJjc3giCiAgICBdLAogICAgImdlbmVyYXRlZCI6IFsKICAgICAgewogICAgICAgICJmaWxlbmFtZSI6ICIoP2kpXFwuKGRlc2lnbmVyfGd8Z1xcLmkpXFwuY3MkIiwKICAgICAgICAibmFtZSI6ICJkZXNpZ25lciIKICAgICAgfSwKICAgICAgewogICAgICAgICJtYXJrZXIiOiAiPGF1dG8tZ2VuZXJhdGVkIiwKICAgICAgICAibmFtZSI6ICJhdXRvLWdlbmVyYXRlZCIKICAgICAgfQogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJpZ25vcmVFc2NhcGUiOiB0cnVlLAogICAgICAgICJzdGFydCI6ICJAXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDKysiOiB7CiAgICAiYmxvY2tfc3R5bGUiOiAiYnJhY2VzIiwKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY2MiLAogICAgICAiY3BwIiwKICAgICAgImN4eCIsCiAgICAgICJjKysiLAogICAgICAicGNjIiwKICAgICAgImlubyIKICAgIF0sCiAgICAiZ2VuZXJhdGVkIjogWwogICAgICB7CiAgICAgICAgImZpbGVuYW1lIjogIlxcLnBiXFwuY2MkIiwKICAgICAgICAibmFtZSI6ICJwcm90b2J1ZiIKICAgICAgfSwKICAgICAgewogICAgICAgICJtYXJrZXIiOiAiXi8vIEdlbmVyYXRlZCBieSB0aGUgcHJvdG9jb2wgYnVmZmVyIGNvbXBpbGVyXFwuICBETyBOT1QgRURJVCEiLAogICAgICAgICJuYW1lIjogInByb3RvYnVmLW1hcmtlciIKICAgICAgfQogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkMrKyBIZWFkZXIiOiB7CiAgICAiYmxvY2tfc3R5bGUiOiAiYnJhY2VzIiwKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaGgiLAogICAgICAiaHBwIiwKICAgICAgImh4eCIsCiAgICAgICJpbmwiLAogICAgICAiaXBwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkN1ZGEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImN1IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkNNYWtlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjbWFrZSIsCiAgICAgICJjbWFrZWxpc3RzLnR4dCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDT0JPTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY29iIiwKICAgICAgImNibCIsCiAgICAgICJjY3AiLAogICAgICAiY29ib2wiLAogICAgICAiY3B5IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIqIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDb2RlUUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImFuZCAiLAogICAgICAib3IgIiwKICAgICAgImltcGxpZXMgIiwKICAgICAgImlmICIsCiAgICAgICJlbHNlICIsCiAgICAgICJub3QgIiwKICAgICAgImluc3RhbmNlb2YgIiwKICAgICAgImluICIsCiAgICAgICJleGlzdHMoIiwKICAgICAgImZvcmFsbCggIiwKICAgICAgImF2ZygiLAogICAgICAiY29uY2F0KCIsCiAgICAgICJjb3VudCgiLAogICAgICAibWF4KCIsCiAgICAgICJtaW4oIiwKICAgICAgInJhbmsoIiwKICAgICAgInN0cmljdGNvbmNhdCgiLAogICAgICAic3RyaWN0Y291bnQoIiwKICAgICAgInN0cmljdHN1bSgiLAogICAgICAic3VtKCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInFsIiwKICAgICAgInFsbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDU1MiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNzcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDU1YiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc3YiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkNhYmFsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjYWJhbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkNhaXJvIjogewogICAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgICAibG9vcCAiLAogICAgICAgICJpZiAiLAogICAgICAgICJpZigiLAogICAgICAgICJtYXRjaCAiLAogICAgICAgICJtYXRjaCgiLAogICAgICAgICJlbHNlICIsCiAgICAgICAgInx8ICIsCiAgICAgICAgIiYmICIsCiAgICAgICAgIiE9ICIsCiAgICAgICAgIj09ICIKICAgICAgXSwKICAgICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICAgImNhaXJvIgogICAgICBdLAogICAgICAibGluZV9jb21tZW50IjogWwogICAgICAgICIvLyIKICAgICAgXSwKICAgICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICAgInF1b3RlcyI6IFsKICAgICAgICB7CiAgICAgICAgICAiZW5kIjogIiciLAogICAgICAgICAgInN0YXJ0IjogIiciCiAgICAgICAgfQogICAgICBdCiAgfSwKICAiQ2Fzc2l1cyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY2Fzc2l1cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDZXlsb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNleWxvbiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDbG9qdXJlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICIoZm9yICIsCiAgICAgICIod2hlbiAiLAogICAgICAiKGxvb3AgIiwKICAgICAgIihkb3NlcSAiLAogICAgICAiKGNvbmQgIiwKICAgICAgIihpZiIsCiAgICAgICIoaWYtbm90ICIsCiAgICAgICIoYW5kICIsCiAgICAgICIob3IgIiwKICAgICAgIihub3QgIiwKICAgICAgIig9ICIsCiAgICAgICIobm90PSAiLAogICAgICAiKHJlY3VyICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNsaiIsCiAgICAgICJjbGpjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDbG9qdXJlU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjbGpzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDbG9zdXJlIFRlbXBsYXRlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiLAogICAgICAiPj0gIiwKICAgICAgIjw9ICIsCiAgICAgICI/OiAiLAogICAgICAiPyA6ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNveSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoqIiwKICAgICAgICAiKi8iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJDb2ZmZWVTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNvZmZlZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIjIyMiLAogICAgICAgICIjIyMiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJDb2dlbnQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNvZ2VudCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkNvbGRGdXNpb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNmbSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tLSIsCiAgICAgICAgIi0tLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJDb2xkRnVzaW9uIENGU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjZmMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiQ29xIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2IgogICAgXSwKICAgICJrZXl3b3JkcyI6IFsKICAgICAgIlJlcXVpcmUiLAogICAgICAiSHlwb3RoZXNpcyIsCiAgICAgICJJbmR1Y3RpdmUiLAogICAgICAiUmVtYXJrIiwKICAgICAgIkxlbW1hIiwKICAgICAgIlByb29mIiwKICAgICAgIkRlZmluaXRpb24iLAogICAgICAiVGhlb3JlbSIsCiAgICAgICJDbGFzcyIsCiAgICAgICJJbnN0YW5jZSIsCiAgICAgICJNb2R1bGUiLAogICAgICAiQ29udGV4dCIsCiAgICAgICJTZWN0aW9uIiwKICAgICAgIk5vdGF0aW9uIiwKICAgICAgIkVuZCIsCiAgICAgICJGaXhwb2ludCIsCiAgICAgICJGcm9tIENvcSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkNyZW9sZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNyZW9sZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQ3J5c3RhbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3IiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIkN5dGhvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAiYW5kICIsCiAgICAgICJvciAiLAogICAgICAibm90ICIsCiAgICAgICJpbiAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJweXgiLAogICAgICAicHhpIiwKICAgICAgInB4ZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiXFxcIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIicnJyIsCiAgICAgICAgInN0YXJ0IjogIicnJyIKICAgICAgfQogICAgXQogIH0sCiAgIkQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiLysiLAogICAgICAgICIrLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJyZG1kIgogICAgXQogIH0sCiAgIkRhcnQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImRhcnQiCiAgICBdLAogICAgImdlbmVyYXRlZCI6IFsKICAgICAgewogICAgICAgICJmaWxlbmFtZSI6ICJcXC4oZ3xmcmVlemVkfHBifHBiZW51bXxwYmpzb258cGJncnBjKVxcLmRhcnQkIiwKICAgICAgICAibmFtZSI6ICJidWlsZC1ydW5uZXIiCiAgICAgIH0KICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJEZXZpY2UgVHJlZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZHRzIiwKICAgICAgImR0c2kiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiRGhhbGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkaGFsbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJETSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJzd2l0Y2goIiwKICAgICAgIndoaWxlICIsCiAgICAgICJ3aGlsZSgiLAogICAgICAiZWxzZSAiLAogICAgICAifHwiLAogICAgICAiJiYiLAogICAgICAiIT0iLAogICAgICAiPD4iLAogICAgICAiPT0iLAogICAgICAiaW4gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZG0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiRG9ja2VyIGlnbm9yZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFtdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgIi5kb2NrZXJpZ25vcmUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiLAogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkRvY2tlcmZpbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImRvY2tlcmZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgImRvY2tlcmZpbGUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIkRvY3VtZW50IFR5cGUgRGVmaW5pdGlvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImR0ZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRWxpeGlyIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJleCIsCiAgICAgICJleHMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCJcXFwiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIicnJyIsCiAgICAgICAgInN0YXJ0IjogIicnJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJFbG0iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgImNhc2UgIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJlbG0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInstIiwKICAgICAgICAiLX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiRW1hY3MgRGV2IEVudiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZWRlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJFbWFjcyBMaXNwIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJlbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRXJsYW5nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJlcmwiLAogICAgICAiaHJsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIlIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJlc2NyaXB0IgogICAgXQogIH0sCiAgIkV4cGVjdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZXhwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJFeHRlbnNpYmxlIFN0eWxlc2hlZXQgTGFuZ3VhZ2UgVHJhbnNmb3JtYXRpb25zIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieHNsdCIsCiAgICAgICJ4c2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkYjIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJtYXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZzIiwKICAgICAgImZzaSIsCiAgICAgICJmc3giLAogICAgICAiZnNzY3JpcHQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJGYWN0b3IiIDp7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmIiwKICAgICAgIndoZW4iLAogICAgICAidW5sZXNzIiwKICAgICAgImlmKiIsCiAgICAgICJ3aGVuKiIsCiAgICAgICJ1bmxlc3MqIiwKICAgICAgIj9pZiIsCiAgICAgICI/IiwKICAgICAgImNvbmQiLAogICAgICAiY2FzZSIsCgogICAgICAiY29uZD5xdW90IiwKICAgICAgImNhc2U+cXVvdCIsCiAgICAgICJhbGlzdD5xdW90IiwKCiAgICAgICJ3aGlsZSIsCiAgICAgICJ1bnRpbCIsCiAgICAgICJsb29wIiwKCiAgICAgICIwJiYiLAogICAgICAiMSYmIiwKICAgICAgIjImJiIsCiAgICAgICIzJiYiLAogICAgICAibiYmIiwKICAgICAgIiYmIiwKCiAgICAgICIwfHwiLAogICAgICAiMXx8IiwKICAgICAgIjJ8fCIsCiAgICAgICIzfHwiLAogICAgICAibnx8IiwKICAgICAgInx8IiwKCiAgICAgICJhbmQiLAogICAgICAib3IiLAogICAgICAieG9yIiwKCiAgICAgICJlcSIsCiAgICAgICI9IiwKCiAgICAgICJzbWFydC1pZiIsCiAgICAgICJzbWFydC1pZioiLAogICAgICAic21hcnQtd2hlbiIsCiAgICAgICJzbWFydC13aGVuKiIsCiAgICAgICJzbWFydC11bmxlc3MiLAogICAgICAic21hcnQtdW5sZXNzKiIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZhY3RvciIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIhW1siLAogICAgICAgICJdXSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIhWz1bIiwKICAgICAgICAiXT1dIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiFbPT1bIiwKICAgICAgICAiXT09XSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIhWz09PVsiLAogICAgICAgICJdPT09XSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIhWz09PT1bIiwKICAgICAgICAiXT09PT1dIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiFbPT09PT1bIiwKICAgICAgICAiXT09PT09XSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIhWz09PT09PVsiLAogICAgICAgICJdPT09PT09XSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdLAogICAgICBbCiAgICAgICAgIigoIiwKICAgICAgICAiKSkiCiAgICAgIF0KICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiISIKICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgInN0YXJ0IjogIlwiIiwKICAgICAgICAiZW5kIjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgInN0YXJ0IjogIlNUUklORzoiLAogICAgICAgICJlbmQiOiAiOyIKICAgICAgfSwKICAgICAgewogICAgICAgICJzdGFydCI6ICJbPT09PT09WyIsCiAgICAgICAgImVuZCI6ICJdPT09PT09XSIKICAgICAgfSwKICAgICAgewogICAgICAgICJzdGFydCI6ICJbPT09PT1bIiwKICAgICAgICAiZW5kIjogIl09PT09XSIKICAgICAgfSwKICAgICAgewogICAgICAgICJzdGFydCI6ICJbPT09PVsiLAogICAgICAgICJlbmQiOiAiXT09PT1dIgogICAgICB9LAogICAgICB7CiAgICAgICAgInN0YXJ0IjogIls9PT1bIiwKICAgICAgICAiZW5kIjogIl09PT1dIgogICAgICB9LAogICAgICB7CiAgICAgICAgInN0YXJ0IjogIls9PVsiLAogICAgICAgICJlbmQiOiAiXT09XSIKICAgICAgfSwKICAgICAgewogICAgICAgICJzdGFydCI6ICJbPVsiLAogICAgICAgICJlbmQiOiAiXT1dIgogICAgICB9LAogICAgICB7CiAgICAgICAgInN0YXJ0IjogIltbIiwKICAgICAgICAiZW5kIjogIl1dIgogICAgICB9CiAgICBdCiAgfSwKICAiRmVubmVsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICIoZm9yIiwKICAgICAgIihlYWNoIiwKICAgICAgIihpZiIsCiAgICAgICIod2hlbiIsCiAgICAgICIod2hpbGUiLAogICAgICAiKHN3aXRjaCIsCiAgICAgICIoZG8iLAogICAgICAiKC4uIiwKICAgICAgIig9IiwKICAgICAgIihhbmQiLAogICAgICAiKG9yIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZm5sIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgInN0YXJ0IjogIlwiIiwKICAgICAgICAiZW5kIjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgInN0YXJ0IjogIiwiLAogICAgICAgICJlbmQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIkYqIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmc3QiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJGSURMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmaWRsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkZPUlRSQU4gTGVnYWN5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmIiwKICAgICAgImZvciIsCiAgICAgICJmdG4iLAogICAgICAiZjc3IiwKICAgICAgInBmbyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiYyIsCiAgICAgICJDIiwKICAgICAgIiEiLAogICAgICAiKiIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiRk9SVFJBTiBNb2Rlcm4iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImYwMyIsCiAgICAgICJmMDgiLAogICAgICAiZjkwIiwKICAgICAgImY5NSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiISIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJGaXNoIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmaXNoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJmaXNoIgogICAgXQogIH0sCiAgIkZsb3c5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgImVsc2UgIiwKICAgICAgImVsc2V7IiwKICAgICAgImZvcmkgIiwKICAgICAgImZvcmkoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAic3dpdGNoKCIsCiAgICAgICJmb2xkICIsCiAgICAgICJmb2xkKCIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiLAogICAgICAifD4gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZmxvdyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJGb3J0aCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiNHRoIiwKICAgICAgImZvcnRoIiwKICAgICAgImZyIiwKICAgICAgImZydCIsCiAgICAgICJmdGgiLAogICAgICAiZjgzIiwKICAgICAgImZiIiwKICAgICAgImZwbSIsCiAgICAgICJlNCIsCiAgICAgICJyeCIsCiAgICAgICJmdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiXFxcXCIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIoICIsCiAgICAgICAgIikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJGcmFnbWVudCBTaGFkZXIgRmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZnNoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkZyZWVtYXJrZXIgVGVtcGxhdGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIjwjbGlzdCAiLAogICAgICAiPCNhc3NpZ24gIiwKICAgICAgIjwjaWYgIiwKICAgICAgIjwjZWxzZWlmICIsCiAgICAgICI8I2Vsc2U+IiwKICAgICAgIjwjZWxzZT4gIiwKICAgICAgIjwjc3dpdGNoICAiLAogICAgICAiPCNjYXNlICIsCiAgICAgICI8I2RlZmF1bHQ+IiwKICAgICAgIjwjZGVmYXVsdD4gIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmdGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCMtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkZTTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiLT4iLAogICAgICAiPC0iCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmc2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJGdXRoYXJrIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZiAiLAogICAgICAiZWxzZSAiLAogICAgICAidGhlbiAiLAogICAgICAiZm9yICIsCiAgICAgICJsb29wICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPj0gIiwKICAgICAgIjw9ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZ1dCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkZYTUwiOiB7CiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZ4bWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJJTkkiOiB7CiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImluaSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIsCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJHRFNjcmlwdCI6IHsKICAgICJibG9ja19zdHlsZSI6ICJpbmRlbnQiLAogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJnZCIKICAgIF0sCiAgICAiZnVuY3Rpb25fb3BlbmVycyI6IFsKICAgICAgImZ1bmMgIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCJcXFwiXFxcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkdMU0wiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZlcnQiLAogICAgICAidGVzYyIsCiAgICAgICJ0ZXNlIiwKICAgICAgImdlb20iLAogICAgICAiZnJhZyIsCiAgICAgICJjb21wIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkdOIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZigiLAogICAgICAiaWYgKCIsCiAgICAgICJlbHNlIGlmKCIsCiAgICAgICJlbHNlIGlmICgiLAogICAgICAiZWxzZSgiLAogICAgICAiZWxzZSAoIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImduIiwKICAgICAgImduaSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkdhbWUgTWFrZXIgTGFuZ3VhZ2UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInJlcGVhdCAiLAogICAgICAicmVwZWF0KCIsCiAgICAgICJ8fCAiLAogICAgICAib3IgIiwKICAgICAgIiYmICIsCiAgICAgICJhbmQgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJnbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIiwKICAgICAgIi8vLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkdhbWUgTWFrZXIgUHJvamVjdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInl5cCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiR2VtZmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFtdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgImdlbWZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIkdoZXJraW4gU3BlY2lmaWNhdGlvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZ2l2ZW4iLAogICAgICAid2hlbiIsCiAgICAgICJ0aGVuIiwKICAgICAgImFuZCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZlYXR1cmUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkdvIjogewogICAgImJsb2NrX3N0eWxlIjogImJyYWNlcyIsCiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImdvICIsCiAgICAgICJkZWZlciAiLAogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZ28iCiAgICBdLAogICAgImZ1bmN0aW9uX29wZW5lcnMiOiBbCiAgICAgICJmdW5jICIKICAgIF0sCiAgICAiZ2VuZXJhdGVkIjogWwogICAgICB7CiAgICAgICAgImJlZm9yZSI6ICJecGFja2FnZSAiLAogICAgICAgICJtYXJrZXIiOiAiXi8vIENvZGUgZ2VuZXJhdGVkIC4qIERPIE5PVCBFRElUXFwuJCIsCiAgICAgICAgIm5hbWUiOiAiZ28tY29kZS1nZW5lcmF0ZWQiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZmlsZW5hbWUiOiAiXFwucGIoXFwuZ3cpP1xcLmdvJCIsCiAgICAgICAgIm5hbWUiOiAicHJvdG9idWYiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZmlsZW5hbWUiOiAiX2dlbmVyYXRlZFxcLmdvJCIsCiAgICAgICAgIm5hbWUiOiAiZ28tZ2VuZXJhdGVkLXN1ZmZpeCIKICAgICAgfQogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiYCIsCiAgICAgICAgImlnbm9yZUVzY2FwZSI6IHRydWUsCiAgICAgICAgInN0YXJ0IjogImAiCiAgICAgIH0KICAgIF0KICB9LAogICJHbyBUZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAie3tpZiAiLAogICAgICAie3sgaWYgIiwKICAgICAgInt7ZWxzZSIsCiAgICAgICJ7eyBlbHNlIiwKICAgICAgInt7cmFuZ2UgIiwKICAgICAgInt7IHJhbmdlICIsCiAgICAgICJ7e3dpdGgiLAogICAgICAie3sgd2l0aCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRtcGwiLAogICAgICAiZ29odG1sIiwKICAgICAgImdvdHh0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInt7LyoiLAogICAgICAgICIqL319IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiR3JhZGxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZ3JhZGxlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJHcmFwaFFMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJ0eXBlICIsCiAgICAgICJpbnB1dCAiLAogICAgICAicXVlcnkgIiwKICAgICAgIm11dGF0aW9uICIsCiAgICAgICJzdWJzY3JpcHRpb24gIiwKICAgICAgImRpcmVjdGl2ZSAiLAogICAgICAic2NhbGFyICIsCiAgICAgICJlbnVtICIsCiAgICAgICJpbnRlcmZhY2UgIiwKICAgICAgInVuaW9uICIsCiAgICAgICJmcmFnbWVudCAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJncmFwaHFsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIlwiXCJcIiIsCiAgICAgICAgIlwiXCJcIiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZG9jU3RyaW5nIjogdHJ1ZSwKICAgICAgICAiZW5kIjogIlwiXCJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiXCJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkRPVCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImRvdCIsCiAgICAgICJndiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iLAogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiR3Jvb3Z5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJncm9vdnkiLAogICAgICAiZ3J0IiwKICAgICAgImd0cGwiLAogICAgICAiZ3Z5IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkhFWCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImhleCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSFRNTCI6IHsKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaHRtbCIsCiAgICAgICJodG0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJIQU1MIjogewogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJoYW1sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSGFtbGV0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJoYW1sZXQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJIYW5kbGViYXJzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJoYnMiLAogICAgICAiaGFuZGxlYmFycyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdLAogICAgICBbCiAgICAgICAgInt7ISIsCiAgICAgICAgIn19IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiSGFwcHkiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ5IiwKICAgICAgImx5IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJIYXJlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImlmICIsCiAgICAgICJlbHNlICIsCiAgICAgICJtYXRjaCAiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJoYSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewoJImVuZCI6ICJgIiwKICAgICAgICAic3RhcnQiOiAiYCIKICAgICAgfQogICAgXQogIH0sCiAgIkhhc2tlbGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmICIsCiAgICAgICJ0aGVuICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIi89ICIsCiAgICAgICI9PSAiLAogICAgICAiY2FzZSAiLAogICAgICAiZG8geyIsCiAgICAgICJmb3JhbGwgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaHMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInstIiwKICAgICAgICAiLX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJIYXhlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJoeCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJob29uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICIlKyAgdHVybiIsCiAgICAgICIodHVybiAiLAogICAgICAiJSsgIHJvbGwiLAogICAgICAiKHJvbGwgIiwKICAgICAgIiUrICByZWVsIiwKICAgICAgIihyZWVsICIsCiAgICAgICJ8LiAgIiwKICAgICAgInwuKCIsCiAgICAgICJ8LSAgIiwKICAgICAgInwtKCIsCiAgICAgICJ8PyAgIiwKICAgICAgInw/KCIsCiAgICAgICI/fCAgIiwKICAgICAgIj98KCIsCiAgICAgICJ8KCIsCiAgICAgICI/LSAgIiwKICAgICAgIj8tKCIsCiAgICAgICI/OiAgIiwKICAgICAgIj86KCIsCiAgICAgICI/LiAgIiwKICAgICAgIj8uKCIsCiAgICAgICI/XiAgIiwKICAgICAgIj9eKCIsCiAgICAgICI/PCAgIiwKICAgICAgIj88KCIsCiAgICAgICI/PiAgIiwKICAgICAgIj8+KCIsCiAgICAgICI/KyAgIiwKICAgICAgIj8rKCIsCiAgICAgICI/JiAgIiwKICAgICAgIj8mKCIsCiAgICAgICImKCIsCiAgICAgICI/QCAgIiwKICAgICAgIj9AKCIsCiAgICAgICI/fiAgIiwKICAgICAgIj9+KCIsCiAgICAgICI/PSAgIiwKICAgICAgIj89KCIsCiAgICAgICIuPSAgIiwKICAgICAgIj0oIiwKICAgICAgIiE9KCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImhvb24iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjo6IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiYGBgIiwKICAgICAgICAic3RhcnQiOiAiYGBgIgogICAgICB9CiAgICBdCiAgfSwKICAiSURMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpZGwiLAogICAgICAid2ViaWRsIiwKICAgICAgIndpZGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiSWRyaXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImlkciIsCiAgICAgICJsaWRyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7LSIsCiAgICAgICAgIi19IgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIlxcXCJcXFwiIgogICAgICB9CiAgICBdCiAgfSwKICAiSW50ZWwgSEVYIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaWhleCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSXNhYmVsbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRoeSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAieyoiLAogICAgICAgICIqfSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdLAogICAgICBbCiAgICAgICAgIuKAuSIsCiAgICAgICAgIuKAuiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJcXFxcPG9wZW4+IiwKICAgICAgICAiXFxcXDxjbG9zZT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICInJyIsCiAgICAgICAgInN0YXJ0IjogIicnIgogICAgICB9CiAgICBdCiAgfSwKICAiSkFJIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJqYWkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJKU09OIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianNvbiIKICAgIF0sCiAgICAiZ2VuZXJhdGVkIjogWwogICAgICB7CiAgICAgICAgImZpbGVuYW1lIjogIl4ocGFja2FnZS1sb2NrfG5wbS1zaHJpbmt3cmFwKVxcLmpzb24kIiwKICAgICAgICAibmFtZSI6ICJucG0tbG9ja2ZpbGUiCiAgICAgIH0KICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSlNPTkwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJqc29ubCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSlNYIjogewogICAgImJsb2NrX3N0eWxlIjogImJyYWNlcyIsCiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImpzeCIKICAgIF0sCiAgICAiZnVuY3Rpb25fb3BlbmVycyI6IFsKICAgICAgImZ1bmN0aW9uICIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJKYWRlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZiAiLAogICAgICAiZWxzZSBpZiAiLAogICAgICAidW5sZXNzICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImphZGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSmFuZXQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIihpZiAiLAogICAgICAiKGZvciAiLAogICAgICAiKGZvciAiLAogICAgICAiKGNvbmQgIiwKICAgICAgIihzd2l0Y2ggIiwKICAgICAgIih3aGVuICIsCiAgICAgICIod2hpbGUgIiwKICAgICAgIihsb29wICIsCiAgICAgICIoY2FzZSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJqYW5ldCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IGZhbHNlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiYGAiLAogICAgICAgICJzdGFydCI6ICJgYCIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJAXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJKYXZhIjogewogICAgImJsb2NrX3N0eWxlIjogImJyYWNlcyIsCiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImphdmEiCiAgICBdLAogICAgImdlbmVyYXRlZCI6IFsKICAgICAgewogICAgICAgICJtYXJrZXIiOiAiXi8vIEdlbmVyYXRlZCBieSB0aGUgcHJvdG9jb2wgYnVmZmVyIGNvbXBpbGVyXFwuICBETyBOT1QgRURJVCEiLAogICAgICAgICJuYW1lIjogInByb3RvYnVmLW1hcmtlciIKICAgICAgfSwKICAgICAgewogICAgICAgICJtYXJrZXIiOiAiXmltcG9ydCBqYXZheFxcLmFubm90YXRpb25cXC4ocHJvY2Vzc2luZ1xcLik/R2VuZXJhdGVkOyQiLAogICAgICAgICJuYW1lIjogImphdmF4LWdlbmVyYXRlZCIKICAgICAgfQogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkphdmFTY3JpcHQiOiB7CiAgICAiYmxvY2tfc3R5bGUiOiAiYnJhY2VzIiwKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianMiLAogICAgICAibWpzIgogICAgXSwKICAgICJmdW5jdGlvbl9vcGVuZXJzIjogWwogICAgICAiZnVuY3Rpb24gIgogICAgXSwKICAgICJnZW5lcmF0ZWQiOiBbCiAgICAgIHsKICAgICAgICAiZmlsZW5hbWUiOiAiXihtYWlufHBvbHlmaWxsc3xydW50aW1lfHZlbmRvcnxzdHlsZXN8c2NyaXB0cykoLWVzKDV8MjAxNSkpP1xcLlswLTlhLWZdezE2LDIwfVxcLmpzJCIsCiAgICAgICAgIm5hbWUiOiAiYW5ndWxhci1idWlsZCIKICAgICAgfSwKICAgICAgewogICAgICAgICJjb250ZW50IjogIi9cXCp7Nn0vIFxcKGZ1bmN0aW9uXFwobW9kdWxlc1xcKSBcXHsgLy8gd2VicGFja0Jvb3RzdHJhcHwvXFwqezZ9LyBcXChcXChcXCkgPT4gXFx7IC8vIHdlYnBhY2tCb290c3RyYXAiLAogICAgICAgICJuYW1lIjogIndlYnBhY2stYnVuZGxlIgogICAgICB9LAogICAgICB7CiAgICAgICAgIm1hcmtlciI6ICJeLy8gR2VuZXJhdGVkIGJ5IENvZmZlZVNjcmlwdCIsCiAgICAgICAgIm5hbWUiOiAiY29mZmVlc2NyaXB0IgogICAgICB9CiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAibm9kZSIKICAgIF0KICB9LAogICJKYXZhU2VydmVyIFBhZ2VzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJqc3AiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIkplbmtpbnMgQnVpbGRmaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamVua2luc2ZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkppbmphIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJ7JSBmb3IgIiwKICAgICAgInslLSBmb3IgIiwKICAgICAgInslIGlmICIsCiAgICAgICJ7JS0gaWYgIiwKICAgICAgInslIGVsc2UgIiwKICAgICAgInslLSBlbHNlICIsCiAgICAgICJ7JSBlbGlmICIsCiAgICAgICJ7JSBtYWNybyAiLAogICAgICAieyUtIG1hY3JvICIsCiAgICAgICJ7JSBjYWxsICIsCiAgICAgICJ7JS0gY2FsbCAiLAogICAgICAieyUgZmlsdGVyICIsCiAgICAgICJ7JS0gZmlsdGVyICIsCiAgICAgICJ7JSBzZXQgIiwKICAgICAgInslIGluY2x1ZGUgIiwKICAgICAgInslIGZyb20gIiwKICAgICAgInslIGV4dGVuZHMgIiwKICAgICAgInslIHdpdGggIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamluamEiLAogICAgICAiajIiLAogICAgICAiamluamEyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInsjIiwKICAgICAgICAiI30iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJKdWxpYSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgImVsc2VpZiAiLAogICAgICAiZWxzZWlmKCIsCiAgICAgICJ0cnkgIiwKICAgICAgImNhdGNoICIsCiAgICAgICJmaW5hbGx5ICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiIz0iLAogICAgICAgICI9IyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCJcXFwiXFxcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkp1bGl1cyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianVsaXVzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkp1cHl0ZXIiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpcHluYiIsCiAgICAgICJqcHluYiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSnVzdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianVzdGZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9CiAgICBdCiAgfSwKICAiSyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiJyIsCiAgICAgICIvIiwKICAgICAgIlxcIiwKICAgICAgIic6IiwKICAgICAgIi86IiwKICAgICAgIlxcOiIsCiAgICAgICJ8IiwKICAgICAgIiYiLAogICAgICAiISIsCiAgICAgICI9IgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiayIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLyIKICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiS29ybiBTaGVsbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAia3NoIgogICAgXSwKICAgICJmaWxlbmFtZXMiOiBbCiAgICAgICIua3NocmMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgImtzaCIKICAgIF0KICB9LAogICJLb3RsaW4iOiB7CiAgICAiYmxvY2tfc3R5bGUiOiAiYnJhY2VzIiwKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAia3QiLAogICAgICAia3RzIgogICAgXSwKICAgICJmdW5jdGlvbl9vcGVuZXJzIjogWwogICAgICAiZnVuICIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkxEIFNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibGRzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkxFU1MiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsZXNzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkxFWCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkxPTENPREUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIkFXU1VNIFRIWCAiLAogICAgICAiTyBOT0VTICIsCiAgICAgICJQTFogT1BFTiBGSUxFICIsCiAgICAgICJJTSBJTiBZUiAiLAogICAgICAiTyBSTFk/IiwKICAgICAgIk8gUkxZPyAiLAogICAgICAiV1RGPyIsCiAgICAgICJXVEY/ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImxvbCIsCiAgICAgICJsb2xzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICJCVFciCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiT0JUVyIsCiAgICAgICAgIlRMRFIiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiTGFUZVgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0ZXgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkxlYW4iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImxlYW4iLAogICAgICAiaGxlYW4iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8tIiwKICAgICAgICAiLS8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkxpY2Vuc2UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbXSwKICAgICJmaWxlbmFtZXMiOiBbCiAgICAgICJsaWNlbnNlIiwKICAgICAgImxpY2VuY2UiLAogICAgICAiY29weWluZyIsCiAgICAgICJjb3B5aW5nMyIsCiAgICAgICJ1bmxpY2Vuc2UiLAogICAgICAidW5saWNlbmNlIiwKICAgICAgImxpY2Vuc2UtbWl0IiwKICAgICAgImxpY2VuY2UtbWl0IiwKICAgICAgImNvcHlyaWdodCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTGlzcCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibGlzcCIsCiAgICAgICJsc3AiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiI3wiLAogICAgICAgICJ8IyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFtdLAogICAgInNoZWJhbmdzIjogWwogICAgICAic2JjbCIKICAgIF0KICB9LAogICJMTFZNIElSIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJsbHZtLmxvb3AiLAogICAgICAiYnIgIiwKICAgICAgInN3aXRjaCAiLAogICAgICAiaW5kaXJlY3RiciAiLAogICAgICAiaW52b2tlICIsCiAgICAgICJjYWxsYnIgIiwKICAgICAgInJlc3VtZSAiLAogICAgICAiY2F0Y2hzd2l0Y2ggIiwKICAgICAgImNhdGNocmV0ICIsCiAgICAgICJjbGVhbnVwcmV0ICIsCiAgICAgICJzaGwgIiwKICAgICAgImxzaHIgIiwKICAgICAgImFzaHIgIiwKICAgICAgImFuZCAiLAogICAgICAib3IgIiwKICAgICAgInhvciAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFtdCiAgfSwKICAiTHVhIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJ3aGlsZSAiLAogICAgICAid2hpbGUoIiwKICAgICAgImVsc2UgIiwKICAgICAgImVsc2UoIiwKICAgICAgImVsc2VpZiAiLAogICAgICAiZWxzZWlmKCIsCiAgICAgICJvciAiLAogICAgICAiYW5kICIsCiAgICAgICJ+PSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibHVhIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICItLVtbIiwKICAgICAgICAiXV0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgIi0tWz1bIiwKICAgICAgIl09XSIKICAgICAgXSwKICAgICAgWwogICAgICAiLS1bPT1bIiwKICAgICAgIl09PV0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgIi0tWz09PVsiLAogICAgICAiXT09PV0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgIi0tWz09PT1bIiwKICAgICAgIl09PT09XSIKICAgICAgXSwKICAgICAgWwogICAgICAiLS1bPT09PT1bIiwKICAgICAgIl09PT09PV0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIltbIiwKICAgICAgICAic3RhcnQiOiAiXV0iCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJsdWEiCiAgICBdCiAgfSwKICAiTHVhdSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAid2hpbGUgIiwKICAgICAgIndoaWxlKCIsCiAgICAgICJlbHNlICIsCiAgICAgICJlbHNlKCIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgImVsc2VpZigiLAogICAgICAib3IgIiwKICAgICAgImFuZCAiLAogICAgICAifj0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImx1YXUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi0tW1siLAogICAgICAgICJdXSIKICAgICAgXSwKICAgICAgWwogICAgICAiLS1bPVsiLAogICAgICAiXT1dIgogICAgICBdLAogICAgICBbCiAgICAgICItLVs9PVsiLAogICAgICAiXT09XSIKICAgICAgXSwKICAgICAgWwogICAgICAiLS1bPT09WyIsCiAgICAgICJdPT09XSIKICAgICAgXSwKICAgICAgWwogICAgICAiLS1bPT09PVsiLAogICAgICAiXT09PT1dIgogICAgICBdLAogICAgICBbCiAgICAgICItLVs9PT09PVsiLAogICAgICAiXT09PT09XSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiYCIsCiAgICAgICAgInN0YXJ0IjogImAiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIltbIiwKICAgICAgICAic3RhcnQiOiAiXV0iCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJsdWF1IgogICAgXQogIH0sCiAgIkx1Y2l1cyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibHVjaXVzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIkx1bmEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsdW5hIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJNUUwgSGVhZGVyIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtcWgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiTVFMNCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibXE0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIk1RTDUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1xNSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJNU0J1aWxkIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJDb25kaXRpb24iCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc3Byb2oiLAogICAgICAidmJwcm9qIiwKICAgICAgImZzcHJvaiIsCiAgICAgICJ2Y3Byb2oiLAogICAgICAidmN4cHJvaiIsCiAgICAgICJ2Y3hwcm9qLmZpbHRlcnMiLAogICAgICAibXlhcHAiLAogICAgICAicHJvcHMiLAogICAgICAicmRsYyIsCiAgICAgICJyZXN4IiwKICAgICAgInNldHRpbmdzIiwKICAgICAgInNsbiIsCiAgICAgICJ0YXJnZXRzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiTVVNUFMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1wcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIk1hY3JvbWVkaWEgZVh0ZW5zaWJsZSBNYXJrdXAgTGFuZ3VhZ2UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJteG1sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJNYWRsYW5nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtYWQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAieyMiLAogICAgICAgICIjfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk1ha2VmaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtYWtlZmlsZSIsCiAgICAgICJtYWsiLAogICAgICAibWsiLAogICAgICAiYnAiCiAgICBdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgIm1ha2VmaWxlIiwKICAgICAgImdudW1ha2VmaWxlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJNYWtvIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICIlIGZvciAiLAogICAgICAiJSBpZiAiLAogICAgICAiJSBlbHNlICIsCiAgICAgICIlIGVsaWYgIiwKICAgICAgIjwlIGluY2x1ZGUgIiwKICAgICAgIjwlZGVmICIsCiAgICAgICI8JXBhZ2UgIiwKICAgICAgIjwlZGVmICIsCiAgICAgICI8JWJsb2NrICIsCiAgICAgICI8JW5hbWVzcGFjZSAiLAogICAgICAiPCVpbmhlcml0ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1ha28iLAogICAgICAibWFvIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8JWRvYz4iLAogICAgICAgICI8LyVkb2M+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTWFya2Rvd24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtZCIsCiAgICAgICJtYXJrZG93biIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTWVzb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvcmVhY2ggIiwKICAgICAgImlmICIsCiAgICAgICJlbGlmICIsCiAgICAgICJ1bmxlc3MgIiwKICAgICAgImFuZCAiLAogICAgICAib3IgIiwKICAgICAgImVsc2UgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibWVzb24uYnVpbGQiLAogICAgICAibWVzb25fb3B0aW9ucy50eHQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIicnJyIsCiAgICAgICAgInN0YXJ0IjogIicnJyIKICAgICAgfQogICAgXQogIH0sCiAgIk1BVExBQiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiaWYgIiwKICAgICAgImVsc2VpZiAiLAogICAgICAiY2FzZSAiLAogICAgICAib3RoZXJ3aXNlICIsCiAgICAgICJ0cnkiLAogICAgICAiZm9yICIsCiAgICAgICJ3aGlsZSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtIgogICAgXSwKICAgICJrZXl3b3JkcyI6IFsKICAgICAgImV5ZSgiLAogICAgICAiemVyb3MoIiwKICAgICAgIm9uZXMoIiwKICAgICAgInRyYW5zcG9zZSgiLAogICAgICAibWVzaGdyaWQoIiwKICAgICAgIm1vZCgiLAogICAgICAiY2xhc3NkZWYiLAogICAgICAiZnVuY3Rpb24iLAogICAgICAiZGlzcCgiLAogICAgICAic2luKCIsCiAgICAgICJ0YW4oIiwKICAgICAgImNvcygiLAogICAgICAicGxvdCIsCiAgICAgICJzcXJ0KCIsCiAgICAgICJkZWJsYW5rKCIsCiAgICAgICJmaW5kc3RyKCIsCiAgICAgICJzdHJyZXAoIiwKICAgICAgInN0cmNtcCgiLAogICAgICAiZGlzcGxheSgiLAogICAgICAic3RyY2F0KCIsCiAgICAgICJpc2NlbGxzdHIoIiwKICAgICAgInN0cmZpbmQoIiwKICAgICAgIiUiLAogICAgICAiZnByaW50ZigiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiJXsiLAogICAgICAgICJ9JSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiTW9kdWxhMyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibTMiLAogICAgICAibWciLAogICAgICAiaWciLAogICAgICAiaTMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJNb2R1bGUtRGVmaW5pdGlvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZGVmIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJNb25rZXkgQyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibWMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiTXVzdGFjaGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm11c3RhY2hlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInt7ISIsCiAgICAgICAgIn19IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiTmlhbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiY2FzZSAiLAogICAgICAiZm9yICIsCiAgICAgICJpZiAiLAogICAgICAicmVwZWF0ICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAib3IgIiwKICAgICAgImFuZCAiLAogICAgICAiPSAiLAogICAgICAiZXF1YWwgIiwKICAgICAgIn49ICIsCiAgICAgICJ1bmVxdWFsICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm5kZiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJOaW0iOiB7CiAgICAiYmxvY2tfc3R5bGUiOiAiaW5kZW50IiwKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibmltIgogICAgXSwKICAgICJmdW5jdGlvbl9vcGVuZXJzIjogWwogICAgICAicHJvYyAiLAogICAgICAiZnVuYyAiLAogICAgICAibWV0aG9kICIsCiAgICAgICJpdGVyYXRvciAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiXFxcIlxcXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJOaXgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm5peCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJPQ2FtbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibWwiLAogICAgICAibWxpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiT2JqZWN0aXZlIEMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm0iCiAgICBdLAogICAgImdlbmVyYXRlZCI6IFsKICAgICAgewogICAgICAgICJmaWxlbmFtZSI6ICJcXC5wYm9iamNcXC5tJCIsCiAgICAgICAgIm5hbWUiOiAicHJvdG9idWYiCiAgICAgIH0KICAgIF0sCiAgICAia2V5d29yZHMiOiBbCiAgICAgICIjaW5jbHVkZSIsCiAgICAgICJwcmludGYiLAogICAgICAic3RkaW8uaCIsCiAgICAgICIuaCIsCiAgICAgICJAaW1wb3J0IiwKICAgICAgIkBpbnRlcmZhY2UiLAogICAgICAiQHByb3BlcnR5IiwKICAgICAgIkBpbXBsZW1lbnRhdGlvbiIsCiAgICAgICJOU0FycmF5IiwKICAgICAgIiNwcmFnbWEiLAogICAgICAic3RhdGljIiwKICAgICAgImNvbnN0IiwKICAgICAgImF0b21pYyIsCiAgICAgICJAZW5kIiwKICAgICAgIi8vIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIk9iamVjdGl2ZSBDKysiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1tIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIk9wYWxhbmciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm9wYSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJPcmciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm9yZyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyAiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk96IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJveiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlBIUCI6IHsKICAgICJibG9ja19zdHlsZSI6ICJicmFjZXMiLAogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwaHAiCiAgICBdLAogICAgImZ1bmN0aW9uX29wZW5lcnMiOiBbCiAgICAgICJmdW5jdGlvbiAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiLAogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgInBocCIsCiAgICAgICJwaHA1IgogICAgXQogIH0sCiAgIlBLR0JVSUxEIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicGtnYnVpbGQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlBML1NRTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiYW5kICIsCiAgICAgICJhbmQoIiwKICAgICAgImVsc2UgIiwKICAgICAgImVsc2UoIiwKICAgICAgImVsc2VpZiAiLAogICAgICAiZWxzZWlmKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgImxvb3AgIiwKICAgICAgIm5vdCAiLAogICAgICAibm90KCIsCiAgICAgICJvciAiLAogICAgICAib3IoIiwKICAgICAgIjw+ICIsCiAgICAgICI8PigiLAogICAgICAiPSAiLAogICAgICAiPSgiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmbmMiLAogICAgICAicGtiIiwKICAgICAgInBrcyIsCiAgICAgICJwcmMiLAogICAgICAidHJnIiwKICAgICAgInZ3IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJQU0wgQXNzZXJ0aW9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwc2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUGFzY2FsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwYXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInsiLAogICAgICAgICJ9IgogICAgICBdLAogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIlBhdGNoIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicGF0Y2giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlBlcmwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBsIiwKICAgICAgInBtIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIj1wb2QiLAogICAgICAgICI9Y3V0IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAicGVybCIsCiAgICAgICJwZXJsNSIKICAgIF0KICB9LAogICJQaWNhdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZG8gIiwKICAgICAgImZvcmVhY2ggIiwKICAgICAgImZvcmVhY2goIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAid2hpbGUoIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIlIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUGxhaW4gVGV4dCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRleHQiLAogICAgICAidHh0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJQb2xseSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicG9sbHkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJQb255IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImlmICIsCiAgICAgICJtYXRjaCAiLAogICAgICAicmVwZWF0IiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgInwgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBvbnkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiXFxcIlxcXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJQb3dlcnNoZWxsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJ3aGlsZSAiLAogICAgICAid2hpbGUoIiwKICAgICAgInVudGlsICIsCiAgICAgICJ1bnRpbCgiLAogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImZvcmVhY2ggIiwKICAgICAgImZvcmVhY2goIiwKICAgICAgImlmICIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgImVsc2UgIiwKICAgICAgInN3aXRjaCIsCiAgICAgICJzd2l0Y2goIiwKICAgICAgIi1ndCIsCiAgICAgICItbHQiLAogICAgICAiLWVxIiwKICAgICAgIi1uZSIsCiAgICAgICItZ2UiLAogICAgICAiLWxlIiwKICAgICAgIi1pbiIsCiAgICAgICItbm90aW4iLAogICAgICAiLWNvbnRhaW5zIiwKICAgICAgIi1ub3Rjb250YWlucyIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBzMSIsCiAgICAgICJwc20xIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwjIiwKICAgICAgICAiIz4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUHJvY2Vzc2luZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicGRlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlByb2xvZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicCIsCiAgICAgICJwcm8iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJQcm9wZXJ0aWVzIEZpbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwcm9wZXJ0aWVzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJQcm90b2NvbCBCdWZmZXJzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwcm90byIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlB1cHBldCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPWJlZ2luIiwKICAgICAgICAiPWVuZCIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIlB1cmVTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInB1cnMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInstIiwKICAgICAgICAiLX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJQeXRob24iOiB7CiAgICAiYmxvY2tfc3R5bGUiOiAiaW5kZW50IiwKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgIndoaWxlICIsCiAgICAgICJ3aGlsZSgiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJlbGlmICIsCiAgICAgICJlbGlmKCIsCiAgICAgICJlbHNlICIsCiAgICAgICJlbHNlOiIsCiAgICAgICJtYXRjaCAiLAogICAgICAibWF0Y2goIiwKICAgICAgInRyeSAiLAogICAgICAidHJ5OiIsCiAgICAgICJleGNlcHQgIiwKICAgICAgImV4Y2VwdCgiLAogICAgICAiZmluYWxseSAiLAogICAgICAiZmluYWxseToiLAogICAgICAid2l0aCAiLAogICAgICAid2l0aCAoIiwKICAgICAgImFuZCAiLAogICAgICAiYW5kKCIsCiAgICAgICJvciAiLAogICAgICAib3IoIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHkiCiAgICBdLAogICAgImZ1bmN0aW9uX29wZW5lcnMiOiBbCiAgICAgICJkZWYgIgogICAgXSwKICAgICJnZW5lcmF0ZWQiOiBbCiAgICAgIHsKICAgICAgICAiZmlsZW5hbWUiOiAiX3BiMihfZ3JwYyk/XFwucHkkIiwKICAgICAgICAibmFtZSI6ICJwcm90b2J1ZiIKICAgICAgfSwKICAgICAgewogICAgICAgICJtYXJrZXIiOiAiXiMgR2VuZXJhdGVkIGJ5IHRoZSBwcm90b2NvbCBidWZmZXIgY29tcGlsZXJcXC4gIERPIE5PVCBFRElUISIsCiAgICAgICAgIm5hbWUiOiAicHJvdG9idWYtbWFya2VyIgogICAgICB9CiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImRvY1N0cmluZyI6IHRydWUsCiAgICAgICAgImVuZCI6ICJcIlwiXCIiLAogICAgICAgICJzdGFydCI6ICJcIlwiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZG9jU3RyaW5nIjogdHJ1ZSwKICAgICAgICAiZW5kIjogIicnJyIsCiAgICAgICAgInN0YXJ0IjogIicnJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJkb2NTdHJpbmciOiB0cnVlLAogICAgICAgICJlbmQiOiAiXCJcIlwiIiwKICAgICAgICAic3RhcnQiOiAiclwiXCJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJkb2NTdHJpbmciOiB0cnVlLAogICAgICAgICJlbmQiOiAiJycnIiwKICAgICAgICAic3RhcnQiOiAicicnJyIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgInB5dGhvbiIsCiAgICAgICJweXRob24yIiwKICAgICAgInB5dGhvbjMiCiAgICBdCiAgfSwKICAiUFJRTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiY2FzZSAiLAogICAgICAiJiYgIiwKICAgICAgInx8ICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIiwKICAgICAgIn49ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBycWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImRvY1N0cmluZyI6IHRydWUsCiAgICAgICAgImVuZCI6ICJcIlwiXCIiLAogICAgICAgICJzdGFydCI6ICJcIlwiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZG9jU3RyaW5nIjogdHJ1ZSwKICAgICAgICAiZW5kIjogIicnJyIsCiAgICAgICAgInN0YXJ0IjogIicnJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJkb2NTdHJpbmciOiB0cnVlLAogICAgICAgICJlbmQiOiAiXCJcIlwiIiwKICAgICAgICAic3RhcnQiOiAiclwiXCJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJkb2NTdHJpbmciOiB0cnVlLAogICAgICAgICJlbmQiOiAiJycnIiwKICAgICAgICAic3RhcnQiOiAicicnJyIKICAgICAgfQogICAgXQogIH0sCiAgIlEjIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAicmVwZWF0ICIsCiAgICAgICJyZXBlYXR7IiwKICAgICAgInVudGlsICgiLAogICAgICAidW50aWwoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAiZWxpZiAiLAogICAgICAiZWxpZnsiLAogICAgICAiZWxzZSAiLAogICAgICAiZWxzZXsiLAogICAgICAifHx8ICIsCiAgICAgICImJiYgIiwKICAgICAgIjw8PCIsCiAgICAgICI+Pj4iLAogICAgICAiXl5eIiwKICAgICAgIn5+fiIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUUNMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJxY2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUU1MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJxbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiciIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUmFrZWZpbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFtdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgInJha2UiLAogICAgICAicmFrZWZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPWJlZ2luIiwKICAgICAgICAiPWVuZCIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIlJha3UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIj09ICIsCiAgICAgICLiiaEgIiwKICAgICAgIiE9ICIsCiAgICAgICLiiaAgIiwKICAgICAgIiE9PSAiLAogICAgICAi4omiICIsCiAgICAgICI8ICIsCiAgICAgICLiioIgIiwKICAgICAgIiE8ICIsCiAgICAgICLiioQgIiwKICAgICAgIjw9ICIsCiAgICAgICLiiaQgIiwKICAgICAgIuKKhiAiLAogICAgICAiITw9ICIsCiAgICAgICLiioggIiwKICAgICAgIj4gIiwKICAgICAgIuKKgyAiLAogICAgICAiIT4gIiwKICAgICAgIuKKhSAiLAogICAgICAiPj0gIiwKICAgICAgIuKJpSAiLAogICAgICAi4oqHICIsCiAgICAgICIhPj0gIiwKICAgICAgIuKKiSAiLAogICAgICAiPX49ICIsCiAgICAgICLiiYUgIiwKICAgICAgIj09PSAiLAogICAgICAiZXEgIiwKICAgICAgIiFlcSAiLAogICAgICAiZXF2ICIsCiAgICAgICJuZSAiLAogICAgICAiZ3QgIiwKICAgICAgImdlICIsCiAgICAgICJsdCAiLAogICAgICAibGUgIiwKICAgICAgIj06PSIsCiAgICAgICJDQVRDSCAiLAogICAgICAiQ09OVFJPTCAiLAogICAgICAiRE9DICIsCiAgICAgICJORVhUICIsCiAgICAgICJhbmQgIiwKICAgICAgImRlZmF1bHQgIiwKICAgICAgImRvIHsiLAogICAgICAiZWxzZSAiLAogICAgICAiZWxzaWYgIiwKICAgICAgImVtaXQgIiwKICAgICAgImZvciAiLAogICAgICAiZ2F0aGVyICIsCiAgICAgICJnaXZlbiAiLAogICAgICAiaWYgIiwKICAgICAgImxhc3QgIiwKICAgICAgImxvb3AgKCIsCiAgICAgICJuZXh0ICIsCiAgICAgICJvbmNlICIsCiAgICAgICJvciAiLAogICAgICAib3J3aXRoICIsCiAgICAgICJyZWFjdCB7IiwKICAgICAgInJlZG8gIiwKICAgICAgInJlcGVhdCAiLAogICAgICAic3RhcnQgeyIsCiAgICAgICJzdXBwbHkgIiwKICAgICAgInVubGVzcyAiLAogICAgICAidW50aWwgIiwKICAgICAgIndoZW4gIiwKICAgICAgIndoZW5ldmVyICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAid2l0aCAiLAogICAgICAid2l0aG91dCAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyYWt1IiwKICAgICAgInJha3Vtb2QiLAogICAgICAicmFrdXRlc3QiLAogICAgICAicmFrdWRvYyIsCiAgICAgICJ0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIj1iZWdpbiIsCiAgICAgICAgIj1lbmQiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiI2AoIiwKICAgICAgICAiKSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIjYFsiLAogICAgICAgICJdIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiNgeyIsCiAgICAgICAgIn0iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiI2DvvaIiLAogICAgICAgICLvvaMiCiAgICAgIF0KCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICLigJwiLAogICAgICAgICJzdGFydCI6ICLigJ4iCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIsK7IiwKICAgICAgICAic3RhcnQiOiAiwqsiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIj4+IiwKICAgICAgICAic3RhcnQiOiAiPDwiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIuKAnSIsCiAgICAgICAgInN0YXJ0IjogIuKAnCIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAi4oCYIiwKICAgICAgICAic3RhcnQiOiAi4oCZIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICLvvaMiLAogICAgICAgICJzdGFydCI6ICLvvaIiCiAgICAgIH0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJyYWt1IgogICAgXQogIH0sCiAgIlJhem9yIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc2h0bWwiLAogICAgICAicmF6b3IiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJAKiIsCiAgICAgICAgIipAIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUmVTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIsCiAgICAgICI9PT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicmVzIiwgCiAgICAgICJyZXNpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsIAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJSZVN0cnVjdHVyZWRUZXh0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicnN0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJSZXBvcnQgRGVmaW5pdGlvbiBMYW5ndWFnZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJkbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlJvYm90IEZyYW1ld29yayI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJvYm90IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJSdWJ5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyYiIKICAgIF0sCiAgICAiZ2VuZXJhdGVkIjogWwogICAgICB7CiAgICAgICAgImZpbGVuYW1lIjogIl9wYlxcLnJiJCIsCiAgICAgICAgIm5hbWUiOiAicHJvdG9idWYiCiAgICAgIH0KICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI9YmVnaW4iLAogICAgICAgICI9ZW5kIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAicnVieSIKICAgIF0KICB9LAogICJSdWJ5IEhUTUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJodG1sIiwKICAgICAgImVyYiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlJ1c3QiOiB7CiAgICAiYmxvY2tfc3R5bGUiOiAiYnJhY2VzIiwKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIiwKICAgICAgIm1hdGNoICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJzIgogICAgXSwKICAgICJmdW5jdGlvbl9vcGVuZXJzIjogWwogICAgICAiZm4gIgogICAgXSwKICAgICJnZW5lcmF0ZWQiOiBbCiAgICAgIHsKICAgICAgICAibWFya2VyIjogIl4vLyBAZ2VuZXJhdGVkXFxiIiwKICAgICAgICAibmFtZSI6ICJydXN0LWdlbmVyYXRlZCIKICAgICAgfQogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiU0FTIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJkbyIsCiAgICAgICIlZG8iLAogICAgICAiaWYiLAogICAgICAiJWlmIiwKICAgICAgImVsc2UiLAogICAgICAiJWVsc2UiLAogICAgICAiY2FzZSIsCiAgICAgICJvciIsCiAgICAgICJhbmQiLAogICAgICAiXj0iLAogICAgICAiwqw9IiwKICAgICAgIn49IiwKICAgICAgIm5lIiwKICAgICAgImVxIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2FzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIqIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXQogIH0sCiAgIlNLSUxMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlNOT0JPTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiOigiLAogICAgICAiOnMoIiwKICAgICAgIjpmKCIsCiAgICAgICJlcSAiLAogICAgICAibmUgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic25vIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIqIgogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiU1BEWCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNwZHgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlNRTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3FsIiwKICAgICAgImRtbCIsCiAgICAgICJkZGwiLAogICAgICAiZHFsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJTUmVjb2RlIFRlbXBsYXRlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzcnQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjs7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJTVkciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInN2ZyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlNhc3MiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNhc3MiLAogICAgICAic2NzcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJTY2FsYSI6IHsKICAgICJibG9ja19zdHlsZSI6ICJicmFjZXMiLAogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzYyIsCiAgICAgICJzY2FsYSIKICAgIF0sCiAgICAiZnVuY3Rpb25fb3BlbmVycyI6IFsKICAgICAgImRlZiAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiU2NoZW1lIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzY20iLAogICAgICAic3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiI3wiLAogICAgICAgICJ8IyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFtdLAogICAgInNoZWJhbmdzIjogW10KICB9LAogICJSYWNrZXQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIihpZiIsCiAgICAgICIoY29uZCIsCiAgICAgICJbZWxzZSIsCiAgICAgICIoYW5kIiwKICAgICAgIihvciIsCiAgICAgICIoZm9yIiwKICAgICAgIiM6d2hlbiIsCiAgICAgICIjOnVubGVzcyIsCiAgICAgICIjOmJyZWFrIiwKICAgICAgIiM6ZmluYWwiLAogICAgICAiKGRvIiwKICAgICAgIih3aGVuIiwKICAgICAgIih1bmxlc3MiLAogICAgICAiKHNoYXJlZCIsCiAgICAgICIoY2FzZSIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJrdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ8IyIsCiAgICAgICAgIiN8IgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAicmFja2V0IgogICAgXQogIH0sCiAgIlNjb25zIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc2lnIiwKICAgICAgInNjb25zdHJ1Y3QiLAogICAgICAic2NvbnNjcmlwdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiXFxcIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIicnJyIsCiAgICAgICAgInN0YXJ0IjogIicnJyIKICAgICAgfQogICAgXQogIH0sCiAgIlNoZWxsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzaCIKICAgIF0sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAiLnRjc2hyYyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAic2giCiAgICBdCiAgfSwKICAiTnVzaGVsbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJkbyB7ICIsCiAgICAgICJlYWNoIHsiLAogICAgICAiaWYgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm51IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgIm51IgogICAgXQogIH0sCiAgIlNtYXJ0eSBUZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIiwKICAgICAgImluY2x1ZGUgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidHBsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInsqIiwKICAgICAgICAiKn0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJTb2Z0YnJpZGdlIEJhc2ljIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNibCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJTb2xpZGl0eSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIiwKICAgICAgImFzc2VtYmx5ICIsCiAgICAgICJhc3NlbWJseXsiLAogICAgICAidW5jaGVja2VkICIsCiAgICAgICJ1bmNoZWNrZWR7IgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic29sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlNwZWNtYW4gZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iLAogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiJz4iLAogICAgICAgICI8JyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlNwaWNlIE5ldGxpc3QiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNrdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiKiIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiU3RhbmRhcmQgTUwgKFNNTCkiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNtbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlN0YXRhIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3JlYWNoIiwKICAgICAgImZvcnZhbHVlcyIsCiAgICAgICJpZiIsCiAgICAgICJlbHNlIiwKICAgICAgIndoaWxlIiwKICAgICAgInN3aXRjaCIsCiAgICAgICJ8IiwKICAgICAgIiYiLAogICAgICAiIT0iLAogICAgICAiPT0iCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkbyIsCiAgICAgICJhZG8iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIiwKICAgICAgIioiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiJyIsCiAgICAgICAgInN0YXJ0IjogImBcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlN0eWx1cyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJpZiAiLAogICAgICAidW5sZXNzICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzdHlsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlN2ZWx0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3ZlbHRlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiU3dpZnQiOiB7CiAgICAiYmxvY2tfc3R5bGUiOiAiYnJhY2VzIiwKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3dpZnQiCiAgICBdLAogICAgImZ1bmN0aW9uX29wZW5lcnMiOiBbCiAgICAgICJmdW5jICIKICAgIF0sCiAgICAiZ2VuZXJhdGVkIjogWwogICAgICB7CiAgICAgICAgImZpbGVuYW1lIjogIlxcLnBiXFwuc3dpZnQkIiwKICAgICAgICAibmFtZSI6ICJwcm90b2J1ZiIKICAgICAgfQogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiU3dpZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJTeXN0ZW1WZXJpbG9nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8ICIsCiAgICAgICImICIsCiAgICAgICIhPSAiLAogICAgICAiIT09ICIsCiAgICAgICI9PSAiLAogICAgICAiZm9yZWFjaCAiLAogICAgICAiZm9yZWFjaCgiLAogICAgICAiY2FzZSAiLAogICAgICAiY2FzZSgiLAogICAgICAiY2FzZXggIiwKICAgICAgImNhc2V4KCIsCiAgICAgICJjYXNleiAiLAogICAgICAiY2FzZXooIiwKICAgICAgImNhc2V4eiAiLAogICAgICAiY2FzZXh6KCIsCiAgICAgICJmb3JrICIsCiAgICAgICIgPyAiLAogICAgICAiaW5zaWRlIiwKICAgICAgIndpdGgiLAogICAgICAiZXZlbnQgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3YiLAogICAgICAic3ZoIgogICAgXSwKICAgICJrZXl3b3JkcyI6IFsKICAgICAgImVuZG1vZHVsZSIsCiAgICAgICJwb3NlZGdlIiwKICAgICAgImVkZ2UiLAogICAgICAiYWx3YXlzIiwKICAgICAgIndpcmUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiU3lzdGVtZCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImF1dG9tb3VudCIsCiAgICAgICJkZXZpY2UiLAogICAgICAibGluayIsCiAgICAgICJtb3VudCIsCiAgICAgICJwYXRoIiwKICAgICAgInNjb3BlIiwKICAgICAgInNlcnZpY2UiLAogICAgICAic2xpY2UiLAogICAgICAic29ja2V0IiwKICAgICAgInN3YXAiLAogICAgICAidGFyZ2V0IiwKICAgICAgInRpbWVyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJUQ0wiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRjbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAidGNsIgogICAgXQogIH0sCiAgIlRPTUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRvbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlxcXCIiLAogICAgICAgICJzdGFydCI6ICJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInIiwKICAgICAgICAic3RhcnQiOiAiJyIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIlxcXCJcXFwiIgogICAgICB9LAogICAgICB7CiAgICAgICAgImVuZCI6ICInJyciLAogICAgICAgICJzdGFydCI6ICInJyciCiAgICAgIH0KICAgIF0KICB9LAogICJUYXNrUGFwZXIiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0YXNrcGFwZXIiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlRlYWwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImxvb3A6IiwKICAgICAgInJldHN1YiIsCiAgICAgICJjYWxsc3ViICIsCiAgICAgICImJiIsCiAgICAgICI9PSIsCiAgICAgICJ8fCIsCiAgICAgICI8PSIsCiAgICAgICI+PSIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRlYWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJUZW1wbGF0ZVRvb2xraXQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIlslIEJMT0NLIiwKICAgICAgIlslIEZJTFRFUiIsCiAgICAgICJbJSBGT1IiLAogICAgICAiWyUgRk9SRUFDSCIsCiAgICAgICJbJSBJRiIsCiAgICAgICJbJSBJTkNMVURFIiwKICAgICAgIlslIE1BQ1JPIiwKICAgICAgIlslIFBST0NFU1MiLAogICAgICAiWyUgU1dJVENIIiwKICAgICAgIlslIFVOTEVTUyIsCiAgICAgICJbJSBXUkFQUEVSIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidHQiLAogICAgICAidHQyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIlslIyIsCiAgICAgICAgIiVdIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVGVtcGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmICIsCiAgICAgICIgZWxzZSAiLAogICAgICAic3dpdGNoICIsCiAgICAgICJjYXNlICIsCiAgICAgICJkZWZhdWx0OiIsCiAgICAgICJmb3IgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRlbXBsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiYCIsCiAgICAgICAgImlnbm9yZUVzY2FwZSI6IHRydWUsCiAgICAgICAgInN0YXJ0IjogImAiCiAgICAgIH0KICAgIF0KICB9LAogICJUZXJyYWZvcm0iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImNvdW50IiwKICAgICAgImZvciIsCiAgICAgICJmb3JfZWFjaCIsCiAgICAgICJpZiIsCiAgICAgICI6ICIsCiAgICAgICI/ICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI+ICIsCiAgICAgICI+PSAiLAogICAgICAiPCAiLAogICAgICAiPD0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRmIiwKICAgICAgInRmLmpzb24iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiLAogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlRlWCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGV4IiwKICAgICAgInN0eSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVGV4dGlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRleHRpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMjIy4gIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIiMjIy4uICIsCiAgICAgICAgInAuICIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlRocmlmdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRocmlmdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iLAogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJUd2lnIFRlbXBsYXRlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJ7JSBmb3IgIiwKICAgICAgInslIGlmICIsCiAgICAgICJ7JSBlbHNlICIsCiAgICAgICJ7JSBlbHNlaWYgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidHdpZyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVHlwZVNjcmlwdCI6IHsKICAgICJibG9ja19zdHlsZSI6ICJicmFjZXMiLAogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8ICIsCiAgICAgICJ8fCAiLAogICAgICAiJiAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICIhPT0gIiwKICAgICAgIj09ICIsCiAgICAgICI9PT0gIiwKICAgICAgImZvcmVhY2goIiwKICAgICAgImNhc2UgIiwKICAgICAgImNhc2UoIiwKICAgICAgImNhc2V4ICIsCiAgICAgICJjYXNleCgiLAogICAgICAiY2FzZXogIiwKICAgICAgImNhc2V6KCIsCiAgICAgICJjYXNleHogIiwKICAgICAgImNhc2V4eigiLAogICAgICAiZm9yayIsCiAgICAgICI/IiwKICAgICAgIjoiLAogICAgICAiaW5zaWRlIiwKICAgICAgIndpdGgiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0cyIsCiAgICAgICJ0c3giCiAgICBdLAogICAgImZ1bmN0aW9uX29wZW5lcnMiOiBbCiAgICAgICJmdW5jdGlvbiAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiVHlwZVNjcmlwdCBUeXBpbmdzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8ICIsCiAgICAgICJ8fCAiLAogICAgICAiJiAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICIhPT0gIiwKICAgICAgIj09ICIsCiAgICAgICI9PT0gIiwKICAgICAgImZvcmVhY2goIiwKICAgICAgImNhc2UgIiwKICAgICAgImNhc2UoIiwKICAgICAgImNhc2V4ICIsCiAgICAgICJjYXNleCgiLAogICAgICAiY2FzZXogIiwKICAgICAgImNhc2V6KCIsCiAgICAgICJjYXNleHogIiwKICAgICAgImNhc2V4eigiLAogICAgICAiZm9yayIsCiAgICAgICI/IiwKICAgICAgIjoiLAogICAgICAiaW5zaWRlIiwKICAgICAgIndpdGgiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkLnRzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJUTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIsCiAgICAgICIvLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlVucmVhbCBTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInVjIiwKICAgICAgInVjaSIsCiAgICAgICJ1cGtnIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlVyL1dlYiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidXIiLAogICAgICAidXJzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiVXIvV2ViIFByb2plY3QiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInVycCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiViI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidiIKICAgIF0sCiAgICAia2V5d29yZHMiOiBbCiAgICAgICJicmVhayIsCiAgICAgICJjb25zdCAiLAogICAgICAiY29udGludWUiLAogICAgICAiZGVmZXIiLAogICAgICAiZWxzZSAiLAogICAgICAiZW51bSIsCiAgICAgICJmbiAiLAogICAgICAiZ290byIsCiAgICAgICJpbXBvcnQgIiwKICAgICAgImluICIsCiAgICAgICJpbnRlcmZhY2UiLAogICAgICAibWF0Y2giLAogICAgICAibXV0IiwKICAgICAgInByaW50bG4iLAogICAgICAicHViIiwKICAgICAgInJldHVybiIsCiAgICAgICJzdHJ1Y3QgIiwKICAgICAgInR5cGUgIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiYCIsCiAgICAgICAgInN0YXJ0IjogImAiCiAgICAgIH0KICAgIF0KICB9LAogICJWSERMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2aGQiLAogICAgICAidmhkbCIKICAgIF0sCiAgICAia2V5d29yZHMiOiBbCiAgICAgICJhYnMiLAogICAgICAiYWNjZXNzIiwKICAgICAgImFmdGVyIiwKICAgICAgImFsaWFzIiwKICAgICAgImFsbCIsCiAgICAgICJhbmQiLAogICAgICAiYXJjaGl0ZWN0dXJlIiwKICAgICAgImFycmF5IiwKICAgICAgImFzc2VydCIsCiAgICAgICJhdHRyaWJ1dGUiLAogICAgICAiYmVnaW4iLAogICAgICAiYmxvY2siLAogICAgICAiYm9keSIsCiAgICAgICJidWZmZXIiLAogICAgICAiYnVzIiwKICAgICAgImNhc2UiLAogICAgICAiY29tcG9uZW50IiwKICAgICAgImNvbmZpZ3VyYXRpb24iLAogICAgICAiY29uc3RhbnQiLAogICAgICAiZGlzY29ubmVjdCIsCiAgICAgICJkb3dudG8iLAogICAgICAiZWxzZSIsCiAgICAgICJlbHNpZiIsCiAgICAgICJlbmQiLAogICAgICAiZW50aXR5IiwKICAgICAgImV4aXQiLAogICAgICAiZmlsZSIsCiAgICAgICJmb3IiLAogICAgICAiZnVuY3Rpb24iLAogICAgICAiZ2VuZXJhdGUiLAogICAgICAiZ2VuZXJpYyIsCiAgICAgICJncm91cCIsCiAgICAgICJndWFyZGVkIiwKICAgICAgImlmIiwKICAgICAgImltcHVyZSIsCiAgICAgICJpbiIsCiAgICAgICJpbmVydGlhbCIsCiAgICAgICJpbm91dCIsCiAgICAgICJpcyIsCiAgICAgICJsYWJlbCIsCiAgICAgICJsaWJyYXJ5IiwKICAgICAgImxpbmthZ2UiLAogICAgICAibGl0ZXJhbCIsCiAgICAgICJsb29wIiwKICAgICAgIm1hcCIsCiAgICAgICJtb2QiLAogICAgICAibmFuZCIsCiAgICAgICJuZXciLAogICAgICAibmV4dCIsCiAgICAgICJub3IiLAogICAgICAibm90IiwKICAgICAgIm51bGwiLAogICAgICAib2YiLAogICAgICAib24iLAogICAgICAib3BlbiIsCiAgICAgICJvciIsCiAgICAgICJvdGhlcnMiLAogICAgICAib3V0IiwKICAgICAgInBhY2thZ2UiLAogICAgICAicG9ydCIsCiAgICAgICJwb3N0cG9uZWQiLAogICAgICAicHJvY2VkdXJlIiwKICAgICAgInByb2Nlc3MiLAogICAgICAicHVyZSIsCiAgICAgICJyYW5nZSIsCiAgICAgICJyZWNvcmQiLAogICAgICAicmVnaXN0ZXIiLAogICAgICAicmVqZWN0IiwKICAgICAgInJlbSIsCiAgICAgICJyZXBvcnQiLAogICAgICAicmV0dXJuIiwKICAgICAgInJvbCIsCiAgICAgICJyb3IiLAogICAgICAic2VsZWN0IiwKICAgICAgInNldmVyaXR5IiwKICAgICAgInNoYXJlZCIsCiAgICAgICJzaWduYWwiLAogICAgICAic2xhIiwKICAgICAgInNsbCIsCiAgICAgICJzcmEiLAogICAgICAic3JsIiwKICAgICAgInN1YnR5cGUiLAogICAgICAidGhlbiIsCiAgICAgICJ0byIsCiAgICAgICJ0cmFuc3BvcnQiLAogICAgICAidHlwZSIsCiAgICAgICJ1bmFmZmVjdGVkIiwKICAgICAgInVuaXRzIiwKICAgICAgInVudGlsIiwKICAgICAgInVzZSIsCiAgICAgICJ2YXJpYWJsZSIsCiAgICAgICJ3YWl0IiwKICAgICAgIndoZW4iLAogICAgICAid2hpbGUiLAogICAgICAid2l0aCIsCiAgICAgICJ4bm9yIiwKICAgICAgInhvciIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlZhbGEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZhbGEiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiVmFybmlzaCBDb25maWd1cmF0aW9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidmNsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIiwKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJWZXJpbG9nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2ZyIsCiAgICAgICJ2aCIsCiAgICAgICJ2IgogICAgXSwKICAgICJrZXl3b3JkcyI6IFsKICAgICAgIm1vZHVsZSIsCiAgICAgICJlbmRtb2R1bGUiLAogICAgICAidGltZXNjYWxlIiwKICAgICAgImlucHV0IiwKICAgICAgIm91dHB1dCIsCiAgICAgICJyZWciLAogICAgICAid2lyZSIsCiAgICAgICJwb3NlZGdlIiwKICAgICAgIm5lZ2VkZ2UiLAogICAgICAiYWx3YXlzIiwKICAgICAgImJlZ2luIiwKICAgICAgInN3aXRjaCIsCiAgICAgICJjYXNlIiwKICAgICAgImVuZCIsCiAgICAgICJlbmRjYXNlIiwKICAgICAgImVsc2UiLAogICAgICAibG9jYWxwYXJhbSIsCiAgICAgICJpbml0aWFsIiwKICAgICAgInNpZ25lZCIsCiAgICAgICJhc3NpZ24iLAogICAgICAiZ2VuZXJhdGUiLAogICAgICAiZ2VudmFyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlZlcmlsb2cgQXJncyBGaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaXJ1bmFyZ3MiLAogICAgICAieHJ1bmFyZ3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlZlcnRleCBTaGFkZXIgRmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidnNoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlZpbSBTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZpbSIsCiAgICAgICJ2aW1yYyIsCiAgICAgICJndmltcmMiCiAgICBdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgIl92aW1yYyIsCiAgICAgICIudmltcmMiLAogICAgICAiX2d2aW1yYyIsCiAgICAgICIuZ3ZpbXJjIiwKICAgICAgInZpbXJjIiwKICAgICAgImd2aW1yYyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiXCIiLAogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdCiAgfSwKICAiVmlzdWFsIEJhc2ljIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZiIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICInIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlZpc3VhbCBCYXNpYyBmb3IgQXBwbGljYXRpb25zIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNscyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJWdWUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZ1ZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXSwKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiJyIsCiAgICAgICAgInN0YXJ0IjogIiciCiAgICAgIH0KICAgIF0KICB9LAogICJXZWIgU2VydmljZXMgRGVzY3JpcHRpb24gTGFuZ3VhZ2UiOiB7CiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIndzZGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJXb2xmcmFtIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJuYiIsCiAgICAgICJ3bCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIldyZW4iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIndyZW4iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJYQU1MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4YW1sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiWGNvZGUgQ29uZmlnIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieGNjb25maWciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJYTUwiOiB7CiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInhtbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXCIiLAogICAgICAgICJzdGFydCI6ICJcIiIKICAgICAgfQogICAgXQogIH0sCiAgIlhNTCBTY2hlbWEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4c2QiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlh0ZW5kIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4dGVuZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJZYXJuIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICI8PGlmICIsCiAgICAgICI8PGVsc2VpZiAiLAogICAgICAiPDxlbHNlICIsCiAgICAgICIgZXEgIiwKICAgICAgIiA9PSAiLAogICAgICAiIG5lcSAiLAogICAgICAiICEgIiwKICAgICAgIiBndCAiLAogICAgICAiID4gIiwKICAgICAgIiBsdCAiLAogICAgICAiIDwgIiwKICAgICAgIiBsdGUgIiwKICAgICAgIiA8PSAiLAogICAgICAiIGd0ZSAiLAogICAgICAiID49ICIsCiAgICAgICIgeG9yICIsCiAgICAgICIgXiAiLAogICAgICAiIGFuZCAiLAogICAgICAiICYmICIsCiAgICAgICIgfHwgIiwKICAgICAgIiBvciAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ5YXJuIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIllBTUwiOiB7CiAgICAiYmxvY2tfc3R5bGUiOiAiaW5kZW50IiwKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInlhbWwiLAogICAgICAieW1sIgogICAgXSwKICAgICJnZW5lcmF0ZWQiOiBbCiAgICAgIHsKICAgICAgICAiZmlsZW5hbWUiOiAiXnBucG0tbG9ja1xcLnlhbWwkIiwKICAgICAgICAibmFtZSI6ICJwbnBtLWxvY2tmaWxlIgogICAgICB9CiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkNsb3VkRm9ybWF0aW9uIChZQU1MKSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiIUdldEF0dCIsCiAgICAgICIhU3ViIiwKICAgICAgIiFTZWxlY3QiLAogICAgICAiIUVxdWFscyIsCiAgICAgICIhSWYiLAogICAgICAiRGVwZW5kc09uOiIsCiAgICAgICIhU2VsZWN0IiwKICAgICAgIiFFcXVhbHMiLAogICAgICAiIUlmIiwKICAgICAgIkZuOjpJZiIsCiAgICAgICJGbjo6QW5kIiwKICAgICAgIkZuOjpFcXVhbHMiLAogICAgICAiRm46Ok5vdCIsCiAgICAgICJGbjo6T3IiLAogICAgICAiRm46OkJhc2U2NCIsCiAgICAgICJGbjo6Q2lkciIsCiAgICAgICJGbjo6RmluZEluTWFwIiwKICAgICAgIkZuOjpHZXRBdHQiLAogICAgICAiRm46OkdldEFacyIsCiAgICAgICJGbjo6SW1wb3J0VmFsdWUiLAogICAgICAiRm46OkpvaW4iLAogICAgICAiRm46OlNlbGVjdCIsCiAgICAgICJGbjo6U3BsaXQiLAogICAgICAiRm46OlN1YiIsCiAgICAgICJGbjo6VHJhbnNmb3JtIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieWFtbCIsCiAgICAgICJ5bWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXSwKICAgICJrZXl3b3JkcyI6IFsKICAgICAgIlJlc291cmNlczoiLAogICAgICAiQVdTVGVtcGxhdGVGb3JtYXRWZXJzaW9uOiIsCiAgICAgICJEZXNjcmlwdGlvbjoiLAogICAgICAiQVdTOjoiLAogICAgICAiUHJvcGVydGllczoiLAogICAgICAiTmFtZToiLAogICAgICAiVHlwZToiLAogICAgICAiIUdldEF0dCIsCiAgICAgICIhU3ViIiwKICAgICAgIlN0YXRlbWVudDoiLAogICAgICAiUmVmOiIsCiAgICAgICJEZXBlbmRzT246IiwKICAgICAgIiFTZWxlY3QiLAogICAgICAiIUVxdWFscyIsCiAgICAgICIhSWYiLAogICAgICAiRm46OklmIiwKICAgICAgIkZuOjpBbmQiLAogICAgICAiRm46OkVxdWFscyIsCiAgICAgICJGbjo6Tm90IiwKICAgICAgIkZuOjpPciIsCiAgICAgICJGbjo6QmFzZTY0IiwKICAgICAgIkZuOjpDaWRyIiwKICAgICAgIkZuOjpGaW5kSW5NYXAiLAogICAgICAiRm46OkdldEF0dCIsCiAgICAgICJGbjo6R2V0QVpzIiwKICAgICAgIkZuOjpJbXBvcnRWYWx1ZSIsCiAgICAgICJGbjo6Sm9pbiIsCiAgICAgICJGbjo6U2VsZWN0IiwKICAgICAgIkZuOjpTcGxpdCIsCiAgICAgICJGbjo6U3ViIiwKICAgICAgIkZuOjpUcmFuc2Zvcm0iCiAgICBdCiAgfSwKICAiQ2xvdWRGb3JtYXRpb24gKEpTT04pIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICIhR2V0QXR0IiwKICAgICAgIiFTdWIiLAogICAgICAiIVNlbGVjdCIsCiAgICAgICIhRXF1YWxzIiwKICAgICAgIiFJZiIsCiAgICAgICJEZXBlbmRzT246IiwKICAgICAgIiFTZWxlY3QiLAogICAgICAiIUVxdWFscyIsCiAgICAgICIhSWYiLAogICAgICAiRm46OklmIiwKICAgICAgIkZuOjpBbmQiLAogICAgICAiRm46OkVxdWFscyIsCiAgICAgICJGbjo6Tm90IiwKICAgICAgIkZuOjpPciIsCiAgICAgICJGbjo6QmFzZTY0IiwKICAgICAgIkZuOjpDaWRyIiwKICAgICAgIkZuOjpGaW5kSW5NYXAiLAogICAgICAiRm46OkdldEF0dCIsCiAgICAgICJGbjo6R2V0QVpzIiwKICAgICAgIkZuOjpJbXBvcnRWYWx1ZSIsCiAgICAgICJGbjo6Sm9pbiIsCiAgICAgICJGbjo6U2VsZWN0IiwKICAgICAgIkZuOjpTcGxpdCIsCiAgICAgICJGbjo6U3ViIiwKICAgICAgIkZuOjpUcmFuc2Zvcm0iCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJqc29uIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10sCiAgICAia2V5d29yZHMiOiBbCiAgICAgICJcIkFXU1RlbXBsYXRlRm9ybWF0VmVyc2lvblwiIiwKICAgICAgIkFXUzo6IiwKICAgICAgIiFHZXRBdHQiLAogICAgICAiIVN1YiIsCiAgICAgICJcIkRlcGVuZHNPblwiIiwKICAgICAgIiFTZWxlY3QiLAogICAgICAiIUVxdWFscyIsCiAgICAgICIhSWYiLAogICAgICAiRm46OklmIiwKICAgICAgIkZuOjpBbmQiLAogICAgICAiRm46OkVxdWFscyIsCiAgICAgICJGbjo6Tm90IiwKICAgICAgIkZuOjpPciIsCiAgICAgICJGbjo6QmFzZTY0IiwKICAgICAgIkZuOjpDaWRyIiwKICAgICAgIkZuOjpGaW5kSW5NYXAiLAogICAgICAiRm46OkdldEF0dCIsCiAgICAgICJGbjo6R2V0QVpzIiwKICAgICAgIkZuOjpJbXBvcnRWYWx1ZSIsCiAgICAgICJGbjo6Sm9pbiIsCiAgICAgICJGbjo6U2VsZWN0IiwKICAgICAgIkZuOjpTcGxpdCIsCiAgICAgICJGbjo6U3ViIiwKICAgICAgIkZuOjpUcmFuc2Zvcm0iCiAgICBdCiAgfSwKICAiWmlnIjogewogICAgImJsb2NrX3N0eWxlIjogImJyYWNlcyIsCiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIndoaWxlICIsCiAgICAgICJmb3IgIiwKICAgICAgImlmICIsCiAgICAgICJlbHNlICIsCiAgICAgICJkZWZlciAiLAogICAgICAic3dpdGNoIiwKICAgICAgInx8IiwKICAgICAgIiYmIiwKICAgICAgIiE9IiwKICAgICAgIj09IgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiemlnIgogICAgXSwKICAgICJmdW5jdGlvbl9vcGVuZXJzIjogWwogICAgICAiZm4gIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcXFwiIiwKICAgICAgICAic3RhcnQiOiAiXFxcIiIKICAgICAgfSwKICAgICAgewogICAgICAgICJlbmQiOiAiXG4iLAogICAgICAgICJzdGFydCI6ICJcXFxcIgogICAgICB9CiAgICBdCiAgfSwKICAiWnNoIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ6c2giLAogICAgICAienNoZW52IiwKICAgICAgInpsb2dpbiIsCiAgICAgICJ6bG9nb3V0IiwKICAgICAgInpwcm9maWxlIiwKICAgICAgInpzaHJjIgogICAgXSwKICAgICJmaWxlbmFtZXMiOiBbCiAgICAgICIuenNoZW52IiwKICAgICAgIi56bG9naW4iLAogICAgICAiLnpsb2dvdXQiLAogICAgICAiLnpwcm9maWxlIiwKICAgICAgIi56c2hyYyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgewogICAgICAgICJlbmQiOiAiXFxcIiIsCiAgICAgICAgInN0YXJ0IjogIlxcXCIiCiAgICAgIH0sCiAgICAgIHsKICAgICAgICAiZW5kIjogIiciLAogICAgICAgICJzdGFydCI6ICInIgogICAgICB9CiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAienNoIgogICAgXQogIH0sCiAgImdpdGlnbm9yZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFtdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgIi5naXRpZ25vcmUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgImlnbm9yZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFtdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgIi5pZ25vcmUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIm00IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibTQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIm51c3BlYyI6IHsKICAgICJleHRlbnNpb25zIjogWwogICAgICAibnVzcGVjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAic2VkIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJhbmQgIiwKICAgICAgIm9yICIsCiAgICAgICJub3QgIiwKICAgICAgImluICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNlZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdLAogICAgInNoZWJhbmdzIjogWwogICAgICAic2VkIgogICAgXQogIH0sCiAgIlNpZXZlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZiIsCiAgICAgICJpZiAiLAogICAgICAiZWxzaWYiLAogICAgICAiZWxzaWYgIiwKICAgICAgImFsbG9mIiwKICAgICAgImFsbG9mICIsCiAgICAgICJhbnlvZiIsCiAgICAgICJhbnlvZiAiLAogICAgICAiYWxsb2YoIiwKICAgICAgImFueW9mKCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNpZXZlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfSwKICAiUmVhc29uTUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJlIiwKICAgICAgInJlaSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIHsKICAgICAgICAiZW5kIjogIlwiIiwKICAgICAgICAic3RhcnQiOiAiXCIiCiAgICAgIH0KICAgIF0KICB9LAogICJPZGluIjogewogICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgIndoZW4gIiwKICAgICAgInN3aXRjaCAiLAogICAgICAiZGVmZXIgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm9kaW4iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICB7CiAgICAgICAgImVuZCI6ICJcIiIsCiAgICAgICAgInN0YXJ0IjogIlwiIgogICAgICB9CiAgICBdCiAgfQp9Cg==`
+classifierSamples = `eyJDbG91ZEZvcm1hdGlvbiAoSlNPTikiOlsie1xuICBcIkFXU1RlbXBsYXRlRm9ybWF0VmVyc2lvblwiOiBcIjIwMTAtMDktMDlcIixcbiAgXCJEZXNjcmlwdGlvblwiOiBcIlNpbmdsZSBFQzIgaW5zdGFuY2Ugd2l0aCBhIHNlY3VyaXR5IGdyb3VwXCIsXG4gIFwiUGFyYW1ldGVyc1wiOiB7XG4gICAgXCJLZXlOYW1lXCI6IHtcbiAgICAgIFwiVHlwZVwiOiBcIkFXUzo6RUMyOjpLZXlQYWlyOjpLZXlOYW1lXCJcbiAgICB9LFxuICAgIFwiSW5zdGFuY2VUeXBlXCI6IHtcbiAgICAgIFwiVHlwZVwiOiBcIlN0cmluZ1wiLFxuICAgICAgXCJEZWZhdWx0XCI6IFwidDMubWljcm9cIixcbiAgICAgIFwiQWxsb3dlZFZhbHVlc1wiOiBbXCJ0My5taWNyb1wiLCBcInQzLnNtYWxsXCJdXG4gICAgfVxuICB9LFxuICBcIk1hcHBpbmdzXCI6IHtcbiAgICBcIlJlZ2lvbk1hcFwiOiB7XG4gICAgICBcInVzLWVhc3QtMVwiOiB7IFwiQU1JXCI6IFwiYW1pLTBhYmNkZWYxMjM0NTY3ODkwXCIgfSxcbiAgICAgIFwiZXUtd2VzdC0xXCI6IHsgXCJBTUlcIjogXCJhbWktMGZlZGNiYTk4NzY1NDMyMTBcIiB9XG4gICAgfVxuICB9LFxuICBcIlJlc291cmNlc1wiOiB7XG4gICAgXCJJbnN0YW5jZVNlY3VyaXR5R3JvdXBcIjoge1xuICAgICAgXCJUeXBlXCI6IFwiQVdTOjpFQzI6OlNlY3VyaXR5R3JvdXBcIixcbiAgICAgIFwiUHJvcGVydGllc1wiOiB7XG4gICAgICAgIFwiR3JvdXBEZXNjcmlwdGlvblwiOiBcIkFsbG93IFNTSFwiLFxuICAgICAgICBcIlNlY3VyaXR5R3JvdXBJbmdyZXNzXCI6IFtcbiAgICAgICAgICB7IFwiSXBQcm90b2NvbFwiOiBcInRjcFwiLCBcIkZyb21Qb3J0XCI6IDIyLCBcIlRvUG9ydFwiOiAyMiwgXCJDaWRySXBcIjogXCIwLjAuMC4wLzBcIiB9XG4gICAgICAgIF1cbiAgICAgIH1cbiAgICB9LFxuICAgIFwiSW5zdGFuY2VcIjoge1xuICAgICAgXCJUeXBlXCI6IFwiQVdTOjpFQzI6Okluc3RhbmNlXCIsXG4gICAgICBcIlByb3BlcnRpZXNcIjoge1xuICAgICAgICBcIkluc3RhbmNlVHlwZVwiOiB7IFwiUmVmXCI6IFwiSW5zdGFuY2VUeXBlXCIgfSxcbiAgICAgICAgXCJLZXlOYW1lXCI6IHsgXCJSZWZcIjogXCJLZXlOYW1lXCIgfSxcbiAgICAgICAgXCJJbWFnZUlkXCI6IHsgXCJGbjo6RmluZEluTWFwXCI6IFtcIlJlZ2lvbk1hcFwiLCB7IFwiUmVmXCI6IFwiQVdTOjpSZWdpb25cIiB9LCBcIkFNSVwiXSB9LFxuICAgICAgICBcIlNlY3VyaXR5R3JvdXBzXCI6IFt7IFwiUmVmXCI6IFwiSW5zdGFuY2VTZWN1cml0eUdyb3VwXCIgfV1cbiAgICAgIH1cbiAgICB9XG4gIH0sXG4gIFwiT3V0cHV0c1wiOiB7XG4gICAgXCJQdWJsaWNJcFwiOiB7XG4gICAgICBcIlZhbHVlXCI6IHsgXCJGbjo6R2V0QXR0XCI6IFtcIkluc3RhbmNlXCIsIFwiUHVibGljSXBcIl0gfVxuICAgIH1cbiAgfVxufVxuIiwie1xuICBcIkFXU1RlbXBsYXRlRm9ybWF0VmVyc2lvblwiOiBcIjIwMTAtMDktMDlcIixcbiAgXCJEZXNjcmlwdGlvblwiOiBcIlF1ZXVlIHdpdGggYSBkZWFkIGxldHRlciBxdWV1ZSBhbmQgYW4gYWxhcm0gb24gaXRzIGRlcHRoXCIsXG4gIFwiUGFyYW1ldGVyc1wiOiB7XG4gICAgXCJRdWV1ZU5hbWVcIjoge1xuICAgICAgXCJUeXBlXCI6IFwiU3RyaW5nXCIsXG4gICAgICBcIkRlc2NyaXB0aW9uXCI6IFwiTmFtZSBvZiB0aGUgbWFpbiBxdWV1ZVwiXG4gICAgfSxcbiAgICBcIkFsYXJtRW1haWxcIjoge1xuICAgICAgXCJUeXBlXCI6IFwiU3RyaW5nXCJcbiAgICB9XG4gIH0sXG4gIFwiUmVzb3VyY2VzXCI6IHtcbiAgICBcIkRlYWRMZXR0ZXJRdWV1ZVwiOiB7XG4gICAgICBcIlR5cGVcIjogXCJBV1M6OlNRUzo6UXVldWVcIixcbiAgICAgIFwiUHJvcGVydGllc1wiOiB7XG4gICAgICAgIFwiTWVzc2FnZVJldGVudGlvblBlcmlvZFwiOiAxMjA5NjAwXG4gICAgICB9XG4gICAgfSxcbiAgICBcIk1haW5RdWV1ZVwiOiB7XG4gICAgICBcIlR5cGVcIjogXCJBV1M6OlNRUzo6UXVldWVcIixcbiAgICAgIFwiUHJvcGVydGllc1wiOiB7XG4gICAgICAgIFwiUXVldWVOYW1lXCI6IHsgXCJSZWZcIjogXCJRdWV1ZU5hbWVcIiB9LFxuICAgICAgICBcIlJlZHJpdmVQb2xpY3lcIjoge1xuICAgICAgICAgIFwiZGVhZExldHRlclRhcmdldEFyblwiOiB7IFwiRm46OkdldEF0dFwiOiBbXCJEZWFkTGV0dGVyUXVldWVcIiwgXCJBcm5cIl0gfSxcbiAgICAgICAgICBcIm1heFJlY2VpdmVDb3VudFwiOiA1XG4gICAgICAgIH1cbiAgICAgIH1cbiAgICB9LFxuICAgIFwiQWxhcm1Ub3BpY1wiOiB7XG4gICAgICBcIlR5cGVcIjogXCJBV1M6OlNOUzo6VG9waWNcIixcbiAgICAgIFwiUHJvcGVydGllc1wiOiB7XG4gICAgICAgIFwiU3Vic2NyaXB0aW9uXCI6IFtcbiAgICAgICAgICB7IFwiRW5kcG9pbnRcIjogeyBcIlJlZlwiOiBcIkFsYXJtRW1haWxcIiB9LCBcIlByb3RvY29sXCI6IFwiZW1haWxcIiB9XG4gICAgICAgIF1cbiAgICAgIH1cbiAgICB9LFxuICAgIFwiRGVwdGhBbGFybVwiOiB7XG4gICAgICBcIlR5cGVcIjogXCJBV1M6OkNsb3VkV2F0Y2g6OkFsYXJtXCIsXG4gICAgICBcIlByb3BlcnRpZXNcIjoge1xuICAgICAgICBcIk5hbWVzcGFjZVwiOiBcIkFXUy9TUVNcIixcbiAgICAgICAgXCJNZXRyaWNOYW1lXCI6IFwiQXBwcm94aW1hdGVOdW1iZXJPZk1lc3NhZ2VzVmlzaWJsZVwiLFxuICAgICAgICBcIkRpbWVuc2lvbnNcIjogW1xuICAgICAgICAgIHsgXCJOYW1lXCI6IFwiUXVldWVOYW1lXCIsIFwiVmFsdWVcIjogeyBcIkZuOjpHZXRBdHRcIjogW1wiTWFpblF1ZXVlXCIsIFwiUXVldWVOYW1lXCJdIH0gfVxuICAgICAgICBdLFxuICAgICAgICBcIlN0YXRpc3RpY1wiOiBcIlN1bVwiLFxuICAgICAgICBcIlBlcmlvZFwiOiAzMDAsXG4gICAgICAgIFwiRXZhbHVhdGlvblBlcmlvZHNcIjogMSxcbiAgICAgICAgXCJUaHJlc2hvbGRcIjogMTAwLFxuICAgICAgICBcIkNvbXBhcmlzb25PcGVyYXRvclwiOiBcIkdyZWF0ZXJUaGFuVGhyZXNob2xkXCIsXG4gICAgICAgIFwiQWxhcm1BY3Rpb25zXCI6IFt7IFwiUmVmXCI6IFwiQWxhcm1Ub3BpY1wiIH1dXG4gICAgICB9XG4gICAgfVxuICB9LFxuICBcIk91dHB1dHNcIjoge1xuICAgIFwiUXVldWVVcmxcIjoge1xuICAgICAgXCJWYWx1ZVwiOiB7IFwiUmVmXCI6IFwiTWFpblF1ZXVlXCIgfSxcbiAgICAgIFwiRXhwb3J0XCI6IHsgXCJOYW1lXCI6IHsgXCJGbjo6U3ViXCI6IFwiJHtBV1M6OlN0YWNrTmFtZX0tUXVldWVVcmxcIiB9IH1cbiAgICB9XG4gIH1cbn1cbiJdLCJDbG91ZEZvcm1hdGlvbiAoWUFNTCkiOlsiQVdTVGVtcGxhdGVGb3JtYXRWZXJzaW9uOiBcIjIwMTAtMDktMDlcIlxuRGVzY3JpcHRpb246IFN0YXRpYyB3ZWJzaXRlIGJ1Y2tldCB3aXRoIGEgQ2xvdWRGcm9udCBkaXN0cmlidXRpb25cblxuUGFyYW1ldGVyczpcbiAgRG9tYWluTmFtZTpcbiAgICBUeXBlOiBTdHJpbmdcbiAgICBEZXNjcmlwdGlvbjogRG9tYWluIHRoZSBzaXRlIGlzIHNlcnZlZCBmcm9tXG4gIEVudmlyb25tZW50OlxuICAgIFR5cGU6IFN0cmluZ1xuICAgIEFsbG93ZWRWYWx1ZXM6IFtkZXYsIHByb2RdXG4gICAgRGVmYXVsdDogZGV2XG5cbkNvbmRpdGlvbnM6XG4gIElzUHJvZDogIUVxdWFscyBbIVJlZiBFbnZpcm9ubWVudCwgcHJvZF1cblxuUmVzb3VyY2VzOlxuICBTaXRlQnVja2V0OlxuICAgIFR5cGU6IEFXUzo6UzM6OkJ1Y2tldFxuICAgIFByb3BlcnRpZXM6XG4gICAgICBCdWNrZXROYW1lOiAhU3ViIFwiJHtEb21haW5OYW1lfS0ke0Vudmlyb25tZW50fVwiXG4gICAgICBWZXJzaW9uaW5nQ29uZmlndXJhdGlvbjpcbiAgICAgICAgU3RhdHVzOiAhSWYgW0lzUHJvZCwgRW5hYmxlZCwgU3VzcGVuZGVkXVxuICAgICAgVGFnczpcbiAgICAgICAgLSBLZXk6IEVudmlyb25tZW50XG4gICAgICAgICAgVmFsdWU6ICFSZWYgRW52aXJvbm1lbnRcblxuICBCdWNrZXRQb2xpY3k6XG4gICAgVHlwZTogQVdTOjpTMzo6QnVja2V0UG9saWN5XG4gICAgUHJvcGVydGllczpcbiAgICAgIEJ1Y2tldDogIVJlZiBTaXRlQnVja2V0XG4gICAgICBQb2xpY3lEb2N1bWVudDpcbiAgICAgICAgU3RhdGVtZW50OlxuICAgICAgICAgIC0gRWZmZWN0OiBBbGxvd1xuICAgICAgICAgICAgUHJpbmNpcGFsOiBcIipcIlxuICAgICAgICAgICAgQWN0aW9uOiBzMzpHZXRPYmplY3RcbiAgICAgICAgICAgIFJlc291cmNlOiAhU3ViIFwiJHtTaXRlQnVja2V0LkFybn0vKlwiXG5cbiAgRGlzdHJpYnV0aW9uOlxuICAgIFR5cGU6IEFXUzo6Q2xvdWRGcm9udDo6RGlzdHJpYnV0aW9uXG4gICAgUHJvcGVydGllczpcbiAgICAgIERpc3RyaWJ1dGlvbkNvbmZpZzpcbiAgICAgICAgRW5hYmxlZDogdHJ1ZVxuICAgICAgICBBbGlhc2VzOlxuICAgICAgICAgIC0gIVJlZiBEb21haW5OYW1lXG4gICAgICAgIE9yaWdpbnM6XG4gICAgICAgICAgLSBJZDogc2l0ZVxuICAgICAgICAgICAgRG9tYWluTmFtZTogIUdldEF0dCBTaXRlQnVja2V0LlJlZ2lvbmFsRG9tYWluTmFtZVxuICAgICAgICAgICAgUzNPcmlnaW5Db25maWc6IHt9XG5cbk91dHB1dHM6XG4gIEJ1Y2tldEFybjpcbiAgICBWYWx1ZTogIUdldEF0dCBTaXRlQnVja2V0LkFyblxuICAgIEV4cG9ydDpcbiAgICAgIE5hbWU6ICFTdWIgXCIke0FXUzo6U3RhY2tOYW1lfS1CdWNrZXRBcm5cIlxuIiwiQVdTVGVtcGxhdGVGb3JtYXRWZXJzaW9uOiAnMjAxMC0wOS0wOSdcblRyYW5zZm9ybTogQVdTOjpTZXJ2ZXJsZXNzLTIwMTYtMTAtMzFcbkRlc2NyaXB0aW9uOiBTY2hlZHVsZWQgZnVuY3Rpb24gd2hpY2ggY291bnRzIHRoZSByZXBvc2l0b3J5XG5cblJlc291cmNlczpcbiAgQ291bnRlclJvbGU6XG4gICAgVHlwZTogQVdTOjpJQU06OlJvbGVcbiAgICBQcm9wZXJ0aWVzOlxuICAgICAgQXNzdW1lUm9sZVBvbGljeURvY3VtZW50OlxuICAgICAgICBWZXJzaW9uOiAnMjAxMi0xMC0xNydcbiAgICAgICAgU3RhdGVtZW50OlxuICAgICAgICAgIC0gRWZmZWN0OiBBbGxvd1xuICAgICAgICAgICAgUHJpbmNpcGFsOlxuICAgICAgICAgICAgICBTZXJ2aWNlOiBsYW1iZGEuYW1hem9uYXdzLmNvbVxuICAgICAgICAgICAgQWN0aW9uOiBzdHM6QXNzdW1lUm9sZVxuICAgICAgTWFuYWdlZFBvbGljeUFybnM6XG4gICAgICAgIC0gYXJuOmF3czppYW06OmF3czpwb2xpY3kvc2VydmljZS1yb2xlL0FXU0xhbWJkYUJhc2ljRXhlY3V0aW9uUm9sZVxuXG4gIENvdW50ZXJGdW5jdGlvbjpcbiAgICBUeXBlOiBBV1M6OkxhbWJkYTo6RnVuY3Rpb25cbiAgICBQcm9wZXJ0aWVzOlxuICAgICAgUnVudGltZTogZ28xLnhcbiAgICAgIEhhbmRsZXI6IG1haW5cbiAgICAgIFRpbWVvdXQ6IDYwXG4gICAgICBSb2xlOiAhR2V0QXR0IENvdW50ZXJSb2xlLkFyblxuICAgICAgRW52aXJvbm1lbnQ6XG4gICAgICAgIFZhcmlhYmxlczpcbiAgICAgICAgICBUQUJMRTogIVJlZiBSZXN1bHRzVGFibGVcblxuICBSZXN1bHRzVGFibGU6XG4gICAgVHlwZTogQVdTOjpEeW5hbW9EQjo6VGFibGVcbiAgICBQcm9wZXJ0aWVzOlxuICAgICAgQmlsbGluZ01vZGU6IFBBWV9QRVJfUkVRVUVTVFxuICAgICAgQXR0cmlidXRlRGVmaW5pdGlvbnM6XG4gICAgICAgIC0gQXR0cmlidXRlTmFtZTogaWRcbiAgICAgICAgICBBdHRyaWJ1dGVUeXBlOiBTXG4gICAgICBLZXlTY2hlbWE6XG4gICAgICAgIC0gQXR0cmlidXRlTmFtZTogaWRcbiAgICAgICAgICBLZXlUeXBlOiBIQVNIXG5cbiAgU2NoZWR1bGU6XG4gICAgVHlwZTogQVdTOjpFdmVudHM6OlJ1bGVcbiAgICBQcm9wZXJ0aWVzOlxuICAgICAgU2NoZWR1bGVFeHByZXNzaW9uOiByYXRlKDEgZGF5KVxuICAgICAgVGFyZ2V0czpcbiAgICAgICAgLSBBcm46ICFHZXRBdHQgQ291bnRlckZ1bmN0aW9uLkFyblxuICAgICAgICAgIElkOiBjb3VudGVyXG5cbk91dHB1dHM6XG4gIEZ1bmN0aW9uTmFtZTpcbiAgICBWYWx1ZTogIVJlZiBDb3VudGVyRnVuY3Rpb25cbiJdLCJDb3EiOlsiKCogQmFzaWMgZmFjdHMgYWJvdXQgbGlzdHMgdXNlZCBieSB0aGUgcmVzdCBvZiB0aGUgZGV2ZWxvcG1lbnQgKilcblJlcXVpcmUgSW1wb3J0IENvcS5MaXN0cy5MaXN0LlxuUmVxdWlyZSBJbXBvcnQgQ29xLkFyaXRoLlBlYW5vTmF0LlxuSW1wb3J0IExpc3ROb3RhdGlvbnMuXG5cblNldCBJbXBsaWNpdCBBcmd1bWVudHMuXG5cblNlY3Rpb24gTGlzdHMuXG5cblZhcmlhYmxlIEEgOiBUeXBlLlxuXG5GaXhwb2ludCByZXZfYXBwZW5kIChsIGFjYyA6IGxpc3QgQSkgOiBsaXN0IEEgOj1cbiAgbWF0Y2ggbCB3aXRoXG4gIHwgW10gPVx1MDAzZSBhY2NcbiAgfCB4IDo6IHhzID1cdTAwM2UgcmV2X2FwcGVuZCB4cyAoeCA6OiBhY2MpXG4gIGVuZC5cblxuRGVmaW5pdGlvbiBmYXN0X3JldiAobCA6IGxpc3QgQSkgOiBsaXN0IEEgOj0gcmV2X2FwcGVuZCBsIFtdLlxuXG5MZW1tYSByZXZfYXBwZW5kX3NwZWMgOiBmb3JhbGwgbCBhY2MsXG4gIHJldl9hcHBlbmQgbCBhY2MgPSByZXYgbCArKyBhY2MuXG5Qcm9vZi5cbiAgaW5kdWN0aW9uIGwgYXMgW3wgeCB4cyBJSF07IGludHJvcyBhY2M7IHNpbXBsLlxuICAtIHJlZmxleGl2aXR5LlxuICAtIHJld3JpdGUgSUguIHJld3JpdGUgXHUwMDNjLSBhcHBfYXNzb2MuIHJlZmxleGl2aXR5LlxuUWVkLlxuXG5UaGVvcmVtIGZhc3RfcmV2X2NvcnJlY3QgOiBmb3JhbGwgbCwgZmFzdF9yZXYgbCA9IHJldiBsLlxuUHJvb2YuXG4gIGludHJvcyBsLiB1bmZvbGQgZmFzdF9yZXYuIHJld3JpdGUgcmV2X2FwcGVuZF9zcGVjLlxuICBhcHBseSBhcHBfbmlsX3IuXG5RZWQuXG5cbkxlbW1hIGxlbmd0aF9hcHAgOiBmb3JhbGwgbDEgbDIgOiBsaXN0IEEsXG4gIGxlbmd0aCAobDEgKysgbDIpID0gbGVuZ3RoIGwxICsgbGVuZ3RoIGwyLlxuUHJvb2YuXG4gIGludHJvcyBsMSBsMi4gaW5kdWN0aW9uIGwxIGFzIFt8IHggeHMgSUhdLlxuICAtIHJlZmxleGl2aXR5LlxuICAtIHNpbXBsLiByZXdyaXRlIElILiByZWZsZXhpdml0eS5cblFlZC5cblxuRW5kIExpc3RzLlxuXG5IaW50IFJld3JpdGUgcmV2X2FwcGVuZF9zcGVjIDogbGlzdHMuXG4iLCJJbmR1Y3RpdmUgZGF5IDogVHlwZSA6PVxuICB8IG1vbmRheVxuICB8IHR1ZXNkYXlcbiAgfCB3ZWRuZXNkYXlcbiAgfCB0aHVyc2RheVxuICB8IGZyaWRheVxuICB8IHNhdHVyZGF5XG4gIHwgc3VuZGF5LlxuXG5EZWZpbml0aW9uIG5leHRfd2Vla2RheSAoZCA6IGRheSkgOiBkYXkgOj1cbiAgbWF0Y2ggZCB3aXRoXG4gIHwgbW9uZGF5ID1cdTAwM2UgdHVlc2RheVxuICB8IHR1ZXNkYXkgPVx1MDAzZSB3ZWRuZXNkYXlcbiAgfCB3ZWRuZXNkYXkgPVx1MDAzZSB0aHVyc2RheVxuICB8IHRodXJzZGF5ID1cdTAwM2UgZnJpZGF5XG4gIHwgXyA9XHUwMDNlIG1vbmRheVxuICBlbmQuXG5cbkV4YW1wbGUgdGVzdF9uZXh0X3dlZWtkYXkgOlxuICBuZXh0X3dlZWtkYXkgKG5leHRfd2Vla2RheSBzYXR1cmRheSkgPSB0dWVzZGF5LlxuUHJvb2YuIHNpbXBsLiByZWZsZXhpdml0eS4gUWVkLlxuXG5GaXhwb2ludCBkb3VibGUgKG4gOiBuYXQpIDogbmF0IDo9XG4gIG1hdGNoIG4gd2l0aFxuICB8IE8gPVx1MDAzZSBPXG4gIHwgUyBuJyA9XHUwMDNlIFMgKFMgKGRvdWJsZSBuJykpXG4gIGVuZC5cblxuTGVtbWEgZG91YmxlX3BsdXMgOiBmb3JhbGwgbiwgZG91YmxlIG4gPSBuICsgbi5cblByb29mLlxuICBpbnRyb3Mgbi4gaW5kdWN0aW9uIG4gYXMgW3wgbicgSUhuJ10uXG4gIC0gcmVmbGV4aXZpdHkuXG4gIC0gc2ltcGwuIHJld3JpdGUgSUhuJy4gcmV3cml0ZSBcdTAwM2MtIHBsdXNfbl9TbS4gcmVmbGV4aXZpdHkuXG5RZWQuXG5cblRoZW9yZW0gcGx1c19jb21tJyA6IGZvcmFsbCBuIG0gOiBuYXQsIG4gKyBtID0gbSArIG4uXG5Qcm9vZi5cbiAgaW50cm9zIG4gbS4gaW5kdWN0aW9uIG4gYXMgW3wgbicgSUhuJ10uXG4gIC0gc2ltcGwuIHJld3JpdGUgXHUwMDNjLSBwbHVzX25fTy4gcmVmbGV4aXZpdHkuXG4gIC0gc2ltcGwuIHJld3JpdGUgSUhuJy4gcmV3cml0ZSBwbHVzX25fU20uIHJlZmxleGl2aXR5LlxuUWVkLlxuXG5Nb2R1bGUgUGxheWdyb3VuZC5cbiAgRGVmaW5pdGlvbiBiIDogYm9vbCA6PSB0cnVlLlxuICBDaGVjayBiLlxuRW5kIFBsYXlncm91bmQuXG4iXSwiSlNPTiI6WyJ7XG4gIFwibmFtZVwiOiBcImxpbmUtY291bnRlci11aVwiLFxuICBcInZlcnNpb25cIjogXCIyLjMuMVwiLFxuICBcImRlc2NyaXB0aW9uXCI6IFwiV2ViIGludGVyZmFjZSBmb3IgYnJvd3NpbmcgbGluZSBjb3VudHNcIixcbiAgXCJtYWluXCI6IFwiZGlzdC9pbmRleC5qc1wiLFxuICBcInNjcmlwdHNcIjoge1xuICAgIFwiYnVpbGRcIjogXCJ0c2MgLXAgLlwiLFxuICAgIFwidGVzdFwiOiBcImplc3QgLS1jb3ZlcmFnZVwiLFxuICAgIFwibGludFwiOiBcImVzbGludCBzcmMgLS1leHQgLnRzLC50c3hcIixcbiAgICBcInN0YXJ0XCI6IFwibm9kZSBkaXN0L3NlcnZlci5qc1wiXG4gIH0sXG4gIFwicmVwb3NpdG9yeVwiOiB7XG4gICAgXCJ0eXBlXCI6IFwiZ2l0XCIsXG4gICAgXCJ1cmxcIjogXCJnaXQraHR0cHM6Ly9leGFtcGxlLmNvbS9saW5lLWNvdW50ZXItdWkuZ2l0XCJcbiAgfSxcbiAgXCJrZXl3b3Jkc1wiOiBbXCJsb2NcIiwgXCJtZXRyaWNzXCIsIFwiZGFzaGJvYXJkXCJdLFxuICBcImF1dGhvclwiOiBcIkV4YW1wbGVcIixcbiAgXCJsaWNlbnNlXCI6IFwiTUlUXCIsXG4gIFwiZGVwZW5kZW5jaWVzXCI6IHtcbiAgICBcImV4cHJlc3NcIjogXCJeNC4xOC4yXCIsXG4gICAgXCJyZWFjdFwiOiBcIl4xOC4yLjBcIixcbiAgICBcInJlYWN0LWRvbVwiOiBcIl4xOC4yLjBcIlxuICB9LFxuICBcImRldkRlcGVuZGVuY2llc1wiOiB7XG4gICAgXCJAdHlwZXMvamVzdFwiOiBcIl4yOS41LjBcIixcbiAgICBcImVzbGludFwiOiBcIl44LjQwLjBcIixcbiAgICBcImplc3RcIjogXCJeMjkuNS4wXCIsXG4gICAgXCJ0eXBlc2NyaXB0XCI6IFwiXjUuMC40XCJcbiAgfSxcbiAgXCJlbmdpbmVzXCI6IHtcbiAgICBcIm5vZGVcIjogXCJcdTAwM2U9MThcIlxuICB9XG59XG4iLCJ7XG4gIFwiZWRpdG9yLnRhYlNpemVcIjogNCxcbiAgXCJlZGl0b3IuZm9ybWF0T25TYXZlXCI6IHRydWUsXG4gIFwiZmlsZXMuZXhjbHVkZVwiOiB7XG4gICAgXCIqKi8uZ2l0XCI6IHRydWUsXG4gICAgXCIqKi9ub2RlX21vZHVsZXNcIjogdHJ1ZSxcbiAgICBcIioqL2Rpc3RcIjogdHJ1ZVxuICB9LFxuICBcInNlYXJjaC5leGNsdWRlXCI6IHtcbiAgICBcInZlbmRvclwiOiB0cnVlXG4gIH0sXG4gIFwibGFuZ3VhZ2VzXCI6IFtcbiAgICB7XCJpZFwiOiBcImdvXCIsIFwiZXh0ZW5zaW9uc1wiOiBbXCIuZ29cIl0sIFwiY29tbWVudHNcIjoge1wibGluZVwiOiBcIi8vXCJ9fSxcbiAgICB7XCJpZFwiOiBcInB5dGhvblwiLCBcImV4dGVuc2lvbnNcIjogW1wiLnB5XCJdLCBcImNvbW1lbnRzXCI6IHtcImxpbmVcIjogXCIjXCJ9fVxuICBdLFxuICBcInRocmVzaG9sZHNcIjoge1xuICAgIFwibGluZXNcIjogMTAwMCxcbiAgICBcImNvbXBsZXhpdHlcIjogNTAsXG4gICAgXCJlbmFibGVkXCI6IGZhbHNlLFxuICAgIFwicmF0aW9cIjogMC4yNSxcbiAgICBcImlnbm9yZWRcIjogbnVsbFxuICB9LFxuICBcInVzZXJzXCI6IFtcbiAgICB7XCJuYW1lXCI6IFwiYWxpY2VcIiwgXCJlbWFpbFwiOiBcImFsaWNlQGV4YW1wbGUuY29tXCIsIFwiYWRtaW5cIjogdHJ1ZX0sXG4gICAge1wibmFtZVwiOiBcImJvYlwiLCBcImVtYWlsXCI6IFwiYm9iQGV4YW1wbGUuY29tXCIsIFwiYWRtaW5cIjogZmFsc2V9XG4gIF1cbn1cbiJdLCJMYVRlWCI6WyJcXGRvY3VtZW50Y2xhc3NbMTFwdCxhNHBhcGVyXXthcnRpY2xlfVxuXG5cXHVzZXBhY2thZ2VbdXRmOF17aW5wdXRlbmN9XG5cXHVzZXBhY2thZ2V7YW1zbWF0aCxhbXNzeW1ifVxuXFx1c2VwYWNrYWdle2dyYXBoaWN4fVxuXFx1c2VwYWNrYWdle2h5cGVycmVmfVxuXG5cXHRpdGxle0NvdW50aW5nIExpbmVzIG9mIENvZGUgUXVpY2tseX1cblxcYXV0aG9ye0EuIEF1dGhvciBcXGFuZCBCLiBBdXRob3J9XG5cXGRhdGV7XFx0b2RheX1cblxuXFxiZWdpbntkb2N1bWVudH1cblxuXFxtYWtldGl0bGVcblxuXFxiZWdpbnthYnN0cmFjdH1cbldlIGRlc2NyaWJlIGEgc3RhdGUgbWFjaGluZSBmb3IgY291bnRpbmcgY29kZSwgY29tbWVudHMgYW5kIGJsYW5rIGxpbmVzXG53aGljaCBwcm9jZXNzZXMgZWFjaCBieXRlIG9uY2UuXG5cXGVuZHthYnN0cmFjdH1cblxuXFxzZWN0aW9ue0ludHJvZHVjdGlvbn1cblxcbGFiZWx7c2VjOmludHJvfVxuXG5Db3VudGluZyBsaW5lcyBpcyBzaW1wbGUgdW50aWwgc3RyaW5ncyBhbmQgbmVzdGVkIGNvbW1lbnRzIGFyZSBpbnZvbHZlZCxcbnNlZSBTZWN0aW9uflxccmVme3NlYzptZXRob2R9IGFuZCB0aGUgcmVzdWx0cyBpbiBUYWJsZX5cXHJlZnt0YWI6cmVzdWx0c30uXG5cblxcc2VjdGlvbntNZXRob2R9XG5cXGxhYmVse3NlYzptZXRob2R9XG5cblRoZSBjb3N0IG9mIGVhY2ggZmlsZSBpc1xuXFxiZWdpbntlcXVhdGlvbn1cbiAgVChuKSA9IFxcc3VtX3tpPTF9XntufSBjX2kgXFxsZXEgayBcXGNkb3QgblxuXFxlbmR7ZXF1YXRpb259XG53aGVyZSAkY19pJCBpcyB0aGUgd29yayBkb25lIGZvciBieXRlICRpJC5cblxuXFxiZWdpbntmaWd1cmV9W2h0YnBdXG4gIFxcY2VudGVyaW5nXG4gIFxcaW5jbHVkZWdyYXBoaWNzW3dpZHRoPTAuOFxcdGV4dHdpZHRoXXtzdGF0ZS1tYWNoaW5lLnBkZn1cbiAgXFxjYXB0aW9ue1RoZSBzdGF0ZXMgYW5kIHRyYW5zaXRpb25zLn1cbiAgXFxsYWJlbHtmaWc6c3RhdGVzfVxuXFxlbmR7ZmlndXJlfVxuXG5cXGJlZ2lue3RhYmxlfVtoXVxuICBcXGNlbnRlcmluZ1xuICBcXGJlZ2lue3RhYnVsYXJ9e2xycn1cbiAgICBcXGhsaW5lXG4gICAgTGFuZ3VhZ2UgXHUwMDI2IEZpbGVzIFx1MDAyNiBTZWNvbmRzIFxcXFxcbiAgICBcXGhsaW5lXG4gICAgQyBcdTAwMjYgMTAwIFx1MDAyNiAwLjUgXFxcXFxuICAgIEdvIFx1MDAyNiAyMDAgXHUwMDI2IDAuNyBcXFxcXG4gICAgXFxobGluZVxuICBcXGVuZHt0YWJ1bGFyfVxuICBcXGNhcHRpb257UmVzdWx0c31cbiAgXFxsYWJlbHt0YWI6cmVzdWx0c31cblxcZW5ke3RhYmxlfVxuXG5cXGJpYmxpb2dyYXBoeXN0eWxle3BsYWlufVxuXFxiaWJsaW9ncmFwaHl7cmVmZXJlbmNlc31cblxuXFxlbmR7ZG9jdW1lbnR9XG4iLCJcXGRvY3VtZW50Y2xhc3N7YmVhbWVyfVxuXFx1c2V0aGVtZXtNYWRyaWR9XG5cXHVzZXBhY2thZ2V7bGlzdGluZ3N9XG5cblxcdGl0bGV7U3RhdGljIEFuYWx5c2lzIGluIENJfVxuXFxzdWJ0aXRsZXtLZWVwaW5nIGFuIGV5ZSBvbiBjb21wbGV4aXR5fVxuXFxhdXRob3J7VGVhbX1cblxcaW5zdGl0dXRle0VuZ2luZWVyaW5nfVxuXG5cXGJlZ2lue2RvY3VtZW50fVxuXG5cXGJlZ2lue2ZyYW1lfVxuICBcXHRpdGxlcGFnZVxuXFxlbmR7ZnJhbWV9XG5cblxcYmVnaW57ZnJhbWV9e091dGxpbmV9XG4gIFxcdGFibGVvZmNvbnRlbnRzXG5cXGVuZHtmcmFtZX1cblxuXFxzZWN0aW9ue01vdGl2YXRpb259XG5cblxcYmVnaW57ZnJhbWV9e1doeSBtZWFzdXJlP31cbiAgXFxiZWdpbntpdGVtaXplfVxuICAgIFxcaXRlbSBMYXJnZSBmaWxlcyBhcmUgaGFyZCB0byByZXZpZXdcbiAgICBcXGl0ZW0gQ29tcGxleGl0eSBncm93cyBcXGVtcGh7c2xvd2x5fSB0aGVuIGFsbCBhdCBvbmNlXG4gICAgXFxpdGVtIE51bWJlcnMgbWFrZSB0cmVuZHMgdmlzaWJsZVxuICBcXGVuZHtpdGVtaXplfVxuXFxlbmR7ZnJhbWV9XG5cblxcYmVnaW57ZnJhbWV9W2ZyYWdpbGVde1J1bm5pbmcgaXR9XG4gIFxcYmVnaW57bHN0bGlzdGluZ31bbGFuZ3VhZ2U9YmFzaF1cbnNjYyAtLWZvcm1hdCBqc29uIC0tYnktZmlsZSAuXG4gIFxcZW5ke2xzdGxpc3Rpbmd9XG5cXGVuZHtmcmFtZX1cblxuXFxiZWdpbntmcmFtZX17U3VtbWFyeX1cbiAgXFxiZWdpbntlbnVtZXJhdGV9XG4gICAgXFxpdGVtIE1lYXN1cmUgb24gZXZlcnkgYnVpbGRcbiAgICBcXGl0ZW0gRmFpbCBvbiBcXHRleHRiZntyZWdyZXNzaW9uc31cbiAgXFxlbmR7ZW51bWVyYXRlfVxuXFxlbmR7ZnJhbWV9XG5cblxcZW5ke2RvY3VtZW50fVxuIl0sIk1BVExBQiI6WyIlIExvYWQgdGhlIG1lYXN1cmVtZW50cyBhbmQgcGxvdCB0aGUgc3BlY3RydW0gb2YgZWFjaCBjaGFubmVsXG5jbGVhcjsgY2xvc2UgYWxsOyBjbGM7XG5cbmRhdGEgPSBsb2FkKCdtZWFzdXJlbWVudHMubWF0Jyk7XG5mcyA9IDEwMDA7ICAgICAgICAgICAgICAlIHNhbXBsaW5nIGZyZXF1ZW5jeSBpbiBIelxudCA9ICgwOmxlbmd0aChkYXRhLnNpZ25hbCkgLSAxKSAvIGZzO1xuXG5maWd1cmUoJ05hbWUnLCAnU2lnbmFsJyk7XG5zdWJwbG90KDIsIDEsIDEpO1xucGxvdCh0LCBkYXRhLnNpZ25hbCwgJ2ItJywgJ0xpbmVXaWR0aCcsIDEuNSk7XG54bGFiZWwoJ1RpbWUgKHMpJyk7XG55bGFiZWwoJ0FtcGxpdHVkZScpO1xudGl0bGUoJ1JhdyBzaWduYWwnKTtcbmdyaWQgb247XG5cbm5mZnQgPSAyXm5leHRwb3cyKGxlbmd0aChkYXRhLnNpZ25hbCkpO1xuc3BlY3RydW0gPSBhYnMoZmZ0KGRhdGEuc2lnbmFsLCBuZmZ0KSkgLyBuZmZ0O1xuZiA9IGZzIC8gMiAqIGxpbnNwYWNlKDAsIDEsIG5mZnQgLyAyICsgMSk7XG5cbnN1YnBsb3QoMiwgMSwgMik7XG5wbG90KGYsIDIgKiBzcGVjdHJ1bSgxOm5mZnQgLyAyICsgMSksICdyJyk7XG54bGFiZWwoJ0ZyZXF1ZW5jeSAoSHopJyk7XG55bGFiZWwoJ3xZKGYpfCcpO1xudGl0bGUoJ1NpbmdsZSBzaWRlZCBhbXBsaXR1ZGUgc3BlY3RydW0nKTtcblxuW3BlYWssIGlkeF0gPSBtYXgoc3BlY3RydW0oMTpuZmZ0IC8gMiArIDEpKTtcbmZwcmludGYoJ1BlYWsgb2YgJS4zZiBhdCAlLjFmIEh6XFxuJywgcGVhaywgZihpZHgpKTtcblxucmVzdWx0cyA9IHN0cnVjdCgncGVhaycsIHBlYWssICdmcmVxdWVuY3knLCBmKGlkeCkpO1xuc2F2ZSgncmVzdWx0cy5tYXQnLCAnLXN0cnVjdCcsICdyZXN1bHRzJyk7XG5cbmZvciBjaGFubmVsID0gMTpzaXplKGRhdGEubWF0cml4LCAyKVxuICAgIGlmIGFueShpc25hbihkYXRhLm1hdHJpeCg6LCBjaGFubmVsKSkpXG4gICAgICAgIGRpc3AoWydDaGFubmVsICcsIG51bTJzdHIoY2hhbm5lbCksICcgaGFzIG1pc3NpbmcgdmFsdWVzJ10pO1xuICAgIGVsc2VcbiAgICAgICAgZnByaW50ZignQ2hhbm5lbCAlZCBtZWFuICUuMmZcXG4nLCBjaGFubmVsLCBtZWFuKGRhdGEubWF0cml4KDosIGNoYW5uZWwpKSk7XG4gICAgZW5kXG5lbmRcbiIsImZ1bmN0aW9uIHkgPSBzbW9vdGhfc2lnbmFsKHgsIHdpbmRvdylcbiVTTU9PVEhfU0lHTkFMIE1vdmluZyBhdmVyYWdlIG9mIGEgc2lnbmFsLlxuJSAgIFkgPSBTTU9PVEhfU0lHTkFMKFgsIFdJTkRPVykgcmV0dXJucyB0aGUgbW92aW5nIGF2ZXJhZ2Ugb2YgWCB1c2luZ1xuJSAgIGEgd2luZG93IG9mIFdJTkRPVyBzYW1wbGVzLiBUaGUgZW5kcyBhcmUgcGFkZGVkIHdpdGggdGhlIGZpcnN0IGFuZFxuJSAgIGxhc3QgdmFsdWVzLlxuXG5pZiBuYXJnaW4gXHUwMDNjIDJcbiAgICB3aW5kb3cgPSA1O1xuZW5kXG5cbmlmIH5pc3ZlY3Rvcih4KVxuICAgIGVycm9yKCdzbW9vdGhfc2lnbmFsOmlucHV0JywgJ1ggbXVzdCBiZSBhIHZlY3RvcicpO1xuZW5kXG5cbm4gPSBudW1lbCh4KTtcbmhhbGYgPSBmbG9vcih3aW5kb3cgLyAyKTtcbnBhZGRlZCA9IFtyZXBtYXQoeCgxKSwgMSwgaGFsZiksIHgoOiknLCByZXBtYXQoeChlbmQpLCAxLCBoYWxmKV07XG55ID0gemVyb3Moc2l6ZSh4KSk7XG5cbmZvciBrID0gMTpuXG4gICAgeShrKSA9IG1lYW4ocGFkZGVkKGs6ayArIHdpbmRvdyAtIDEpKTtcbmVuZFxuXG4lIEtlZXAgdGhlIG9yaWVudGF0aW9uIG9mIHRoZSBpbnB1dFxuaWYgaXNjb2x1bW4oeClcbiAgICB5ID0geSg6KTtcbmVuZFxuZW5kXG4iXSwiT2JqZWN0aXZlIEMiOlsiI2ltcG9ydCBcIkFwcERlbGVnYXRlLmhcIlxuI2ltcG9ydCBcIlJvb3RWaWV3Q29udHJvbGxlci5oXCJcbiNpbXBvcnQgXHUwMDNjVUlLaXQvVUlLaXQuaFx1MDAzZVxuXG5AaW50ZXJmYWNlIEFwcERlbGVnYXRlICgpXG5AcHJvcGVydHkgKG5vbmF0b21pYywgc3Ryb25nKSBSb290Vmlld0NvbnRyb2xsZXIgKnJvb3RWaWV3Q29udHJvbGxlcjtcbkBlbmRcblxuQGltcGxlbWVudGF0aW9uIEFwcERlbGVnYXRlXG5cbi0gKEJPT0wpYXBwbGljYXRpb246KFVJQXBwbGljYXRpb24gKilhcHBsaWNhdGlvbiBkaWRGaW5pc2hMYXVuY2hpbmdXaXRoT3B0aW9uczooTlNEaWN0aW9uYXJ5ICopbGF1bmNoT3B0aW9ucyB7XG4gICAgc2VsZi53aW5kb3cgPSBbW1VJV2luZG93IGFsbG9jXSBpbml0V2l0aEZyYW1lOltbVUlTY3JlZW4gbWFpblNjcmVlbl0gYm91bmRzXV07XG4gICAgc2VsZi5yb290Vmlld0NvbnRyb2xsZXIgPSBbW1Jvb3RWaWV3Q29udHJvbGxlciBhbGxvY10gaW5pdFdpdGhOaWJOYW1lOm5pbCBidW5kbGU6bmlsXTtcblxuICAgIFVJTmF2aWdhdGlvbkNvbnRyb2xsZXIgKm5hdmlnYXRpb24gPSBbW1VJTmF2aWdhdGlvbkNvbnRyb2xsZXIgYWxsb2NdIGluaXRXaXRoUm9vdFZpZXdDb250cm9sbGVyOnNlbGYucm9vdFZpZXdDb250cm9sbGVyXTtcbiAgICBzZWxmLndpbmRvdy5yb290Vmlld0NvbnRyb2xsZXIgPSBuYXZpZ2F0aW9uO1xuICAgIFtzZWxmLndpbmRvdyBtYWtlS2V5QW5kVmlzaWJsZV07XG5cbiAgICBOU0xvZyhAXCJMYXVuY2hlZCB3aXRoIG9wdGlvbnMgJUBcIiwgbGF1bmNoT3B0aW9ucyk7XG4gICAgcmV0dXJuIFlFUztcbn1cblxuLSAodm9pZClhcHBsaWNhdGlvbkRpZEVudGVyQmFja2dyb3VuZDooVUlBcHBsaWNhdGlvbiAqKWFwcGxpY2F0aW9uIHtcbiAgICBbW05TVXNlckRlZmF1bHRzIHN0YW5kYXJkVXNlckRlZmF1bHRzXSBzeW5jaHJvbml6ZV07XG59XG5cbi0gKHZvaWQpYXBwbGljYXRpb25XaWxsVGVybWluYXRlOihVSUFwcGxpY2F0aW9uICopYXBwbGljYXRpb24ge1xuICAgIGlmIChzZWxmLnJvb3RWaWV3Q29udHJvbGxlciAhPSBuaWwpIHtcbiAgICAgICAgW3NlbGYucm9vdFZpZXdDb250cm9sbGVyIHNhdmVTdGF0ZV07XG4gICAgfVxufVxuXG5AZW5kXG4iLCIjaW1wb3J0IFx1MDAzY0ZvdW5kYXRpb24vRm91bmRhdGlvbi5oXHUwMDNlXG5cbkBpbnRlcmZhY2UgUGVyc29uIDogTlNPYmplY3QgXHUwMDNjTlNDb3B5aW5nXHUwMDNlXG5cbkBwcm9wZXJ0eSAobm9uYXRvbWljLCBjb3B5KSBOU1N0cmluZyAqbmFtZTtcbkBwcm9wZXJ0eSAobm9uYXRvbWljLCBhc3NpZ24pIE5TSW50ZWdlciBhZ2U7XG5cbi0gKGluc3RhbmNldHlwZSlpbml0V2l0aE5hbWU6KE5TU3RyaW5nICopbmFtZSBhZ2U6KE5TSW50ZWdlcilhZ2U7XG4tIChOU1N0cmluZyAqKWdyZWV0aW5nO1xuXG5AZW5kXG5cbkBpbXBsZW1lbnRhdGlvbiBQZXJzb25cblxuLSAoaW5zdGFuY2V0eXBlKWluaXRXaXRoTmFtZTooTlNTdHJpbmcgKiluYW1lIGFnZTooTlNJbnRlZ2VyKWFnZSB7XG4gICAgc2VsZiA9IFtzdXBlciBpbml0XTtcbiAgICBpZiAoc2VsZikge1xuICAgICAgICBfbmFtZSA9IFtuYW1lIGNvcHldO1xuICAgICAgICBfYWdlID0gYWdlO1xuICAgIH1cbiAgICByZXR1cm4gc2VsZjtcbn1cblxuLSAoTlNTdHJpbmcgKilncmVldGluZyB7XG4gICAgcmV0dXJuIFtOU1N0cmluZyBzdHJpbmdXaXRoRm9ybWF0OkBcIkhlbGxvLCBteSBuYW1lIGlzICVAIGFuZCBJIGFtICVsZFwiLCBzZWxmLm5hbWUsIChsb25nKXNlbGYuYWdlXTtcbn1cblxuLSAoaWQpY29weVdpdGhab25lOihOU1pvbmUgKil6b25lIHtcbiAgICByZXR1cm4gW1tQZXJzb24gYWxsb2NXaXRoWm9uZTp6b25lXSBpbml0V2l0aE5hbWU6c2VsZi5uYW1lIGFnZTpzZWxmLmFnZV07XG59XG5cbkBlbmRcblxuaW50IG1haW4oaW50IGFyZ2MsIGNvbnN0IGNoYXIgKiBhcmd2W10pIHtcbiAgICBAYXV0b3JlbGVhc2Vwb29sIHtcbiAgICAgICAgTlNNdXRhYmxlQXJyYXlcdTAwM2NQZXJzb24gKlx1MDAzZSAqcGVvcGxlID0gW05TTXV0YWJsZUFycmF5IGFycmF5XTtcbiAgICAgICAgW3Blb3BsZSBhZGRPYmplY3Q6W1tQZXJzb24gYWxsb2NdIGluaXRXaXRoTmFtZTpAXCJBZGFcIiBhZ2U6MzZdXTtcbiAgICAgICAgW3Blb3BsZSBhZGRPYmplY3Q6W1tQZXJzb24gYWxsb2NdIGluaXRXaXRoTmFtZTpAXCJBbGFuXCIgYWdlOjQxXV07XG5cbiAgICAgICAgZm9yIChQZXJzb24gKnBlcnNvbiBpbiBwZW9wbGUpIHtcbiAgICAgICAgICAgIE5TTG9nKEBcIiVAXCIsIFtwZXJzb24gZ3JlZXRpbmddKTtcbiAgICAgICAgfVxuICAgIH1cbiAgICByZXR1cm4gMDtcbn1cbiJdLCJUZVgiOlsiXFxpbnB1dCBtYWNyb3NcblxuXFxmb250XFxiaWdybT1jbXIxMiBhdCAxNHB0XG5cXGZvbnRcXHNtYWxsaXQ9Y210aTEwIGF0IDlwdFxuXG5cXHBhcmluZGVudD0wcHRcblxccGFyc2tpcD02cHQgcGx1cyAycHRcblxcaHNpemU9NmluXG5cXHZzaXplPTlpblxuXG5cXGFkZHJlc3N7MSBFeGFtcGxlIFN0cmVldFxccGFyIFNwcmluZ2ZpZWxkfVxuXFxzaWduYXR1cmV7Si4gU21pdGh9XG5cblxcY2VudGVybGluZXtcXGJpZ3JtIEFubnVhbCBSZXBvcnR9XG5cXHZza2lwIDEycHRcblxuXFxsZXR0ZXJ7UmVhZGVyfVxuVGhpcyB5ZWFyIHRoZSBjb3VudCBvZiBsaW5lcyBncmV3IGJ5IGEgdGhpcmQuIFxcc21hbGxpdCBNb3N0IG9mIGl0IHdhc1xudGVzdHMuXFxybVxcIFRoZSBkZXRhaWxzIGZvbGxvdy5cblxccGFyYVxuJCRcXHN1bV97az0xfV57bn0gayA9IHtuKG4rMSlcXG92ZXIgMn0kJFxuXFxjbG9zaW5ne1lvdXJzIHNpbmNlcmVseX1cblxuXFxoYWxpZ257I1xcaGZpbFxccXVhZFx1MDAyNlxcaGZpbCNcXGNyXG5GaWxlc1x1MDAyNjEyMFxcY3JcbkxpbmVzXHUwMDI2NDAwMDBcXGNyXG59XG5cblxcYnllXG4iLCIlIFBsYWluIFRlWCBtYWNyb3MgZm9yIHR5cGVzZXR0aW5nIGxldHRlcnNcblxcY2F0Y29kZWBcXEA9MTFcblxuXFxuZXdkaW1lblxcbGV0dGVyd2lkdGggXFxsZXR0ZXJ3aWR0aD01LjVpblxuXFxuZXdjb3VudFxcbGV0dGVyY291bnQgXFxsZXR0ZXJjb3VudD0wXG5cXG5ld2lmXFxpZnNpZ25lZCBcXHNpZ25lZGZhbHNlXG5cblxcZGVmXFxhZGRyZXNzIzF7XFxkZWZcXEBhZGRyZXNzeyMxfX1cblxcZGVmXFxzaWduYXR1cmUjMXtcXGRlZlxcQHNpZ25hdHVyZXsjMX1cXHNpZ25lZHRydWV9XG5cblxcZGVmXFxsZXR0ZXIjMXslXG4gIFxcZ2xvYmFsXFxhZHZhbmNlXFxsZXR0ZXJjb3VudCBieSAxXG4gIFxcdmJveHtcXGhzaXplPVxcbGV0dGVyd2lkdGhcbiAgICBcXG5vaW5kZW50XFxAYWRkcmVzc1xccGFyXG4gICAgXFx2c2tpcCAyXFxiYXNlbGluZXNraXBcbiAgICBcXG5vaW5kZW50IERlYXIgIzEsXFxwYXJcbiAgICBcXHZza2lwXFxiYXNlbGluZXNraXB9fVxuXG5cXGRlZlxcY2xvc2luZyMxeyVcbiAgXFx2c2tpcCAyXFxiYXNlbGluZXNraXBcbiAgXFxoYm94IHRvIFxcbGV0dGVyd2lkdGh7XFxoZmlsICMxfVxuICBcXGlmc2lnbmVkXG4gICAgXFx2c2tpcCAzXFxiYXNlbGluZXNraXBcbiAgICBcXGhib3ggdG8gXFxsZXR0ZXJ3aWR0aHtcXGhmaWxcXEBzaWduYXR1cmV9XG4gIFxcZmlcbiAgXFx2ZmlsbFxcZWplY3R9XG5cblxcbGV0XFxvbGRwYXI9XFxwYXJcblxcZGVmXFxwYXJhe1xcb2xkcGFyXFxub2luZGVudFxcaWdub3Jlc3BhY2VzfVxuXG5cXGV4cGFuZGFmdGVyXFxkZWZcXGNzbmFtZSBsZXR0ZXJAY291bnRcXGVuZGNzbmFtZXtcXHRoZVxcbGV0dGVyY291bnR9XG5cblxcY2F0Y29kZWBcXEA9MTJcbiJdLCJWIjpbIm1vZHVsZSBnZW9tZXRyeVxuXG5pbXBvcnQgbWF0aFxuXG5wdWIgc3RydWN0IFBvaW50IHtcbnB1Yjpcblx0eCBmNjRcblx0eSBmNjRcbn1cblxucHViIGZuIChwIFBvaW50KSBkaXN0YW5jZShxIFBvaW50KSBmNjQge1xuXHRkeCA6PSBwLnggLSBxLnhcblx0ZHkgOj0gcC55IC0gcS55XG5cdHJldHVybiBtYXRoLnNxcnQoZHggKiBkeCArIGR5ICogZHkpXG59XG5cbnB1YiBmbiBjZW50cm9pZChwb2ludHMgW11Qb2ludCkgP1BvaW50IHtcblx0aWYgcG9pbnRzLmxlbiA9PSAwIHtcblx0XHRyZXR1cm4gZXJyb3IoJ25vIHBvaW50cycpXG5cdH1cblx0bXV0IHN4IDo9IDAuMFxuXHRtdXQgc3kgOj0gMC4wXG5cdGZvciBwIGluIHBvaW50cyB7XG5cdFx0c3ggKz0gcC54XG5cdFx0c3kgKz0gcC55XG5cdH1cblx0cmV0dXJuIFBvaW50e3N4IC8gcG9pbnRzLmxlbiwgc3kgLyBwb2ludHMubGVufVxufVxuXG5wdWIgZW51bSBTaGFwZSB7XG5cdGNpcmNsZVxuXHRzcXVhcmVcbn1cblxucHViIGZuIGFyZWEoc2hhcGUgU2hhcGUsIHNpemUgZjY0KSBmNjQge1xuXHRyZXR1cm4gbWF0Y2ggc2hhcGUge1xuXHRcdC5jaXJjbGUgeyBtYXRoLnBpICogc2l6ZSAqIHNpemUgfVxuXHRcdC5zcXVhcmUgeyBzaXplICogc2l6ZSB9XG5cdH1cbn1cblxuZm4gdGVzdF9kaXN0YW5jZSgpIHtcblx0YSA6PSBQb2ludHswLCAwfVxuXHRiIDo9IFBvaW50ezMsIDR9XG5cdGFzc2VydCBhLmRpc3RhbmNlKGIpID09IDUuMFxufVxuIiwibW9kdWxlIG1haW5cblxuaW1wb3J0IG5ldC5odHRwXG5pbXBvcnQganNvblxuaW1wb3J0IHRpbWVcblxuc3RydWN0IFVzZXIge1xuXHRpZCAgIGludFxuXHRuYW1lIHN0cmluZ1xubXV0OlxuXHR2aXNpdHMgaW50XG59XG5cbnN0cnVjdCBBcHAge1xubXV0OlxuXHR1c2VycyBtYXBbc3RyaW5nXVVzZXJcblx0c3RhcnRlZCB0aW1lLlRpbWVcbn1cblxuZm4gKG11dCBhcHAgQXBwKSBhZGRfdXNlcihuYW1lIHN0cmluZykgVXNlciB7XG5cdHVzZXIgOj0gVXNlcntcblx0XHRpZDogYXBwLnVzZXJzLmxlbiArIDFcblx0XHRuYW1lOiBuYW1lXG5cdH1cblx0YXBwLnVzZXJzW25hbWVdID0gdXNlclxuXHRyZXR1cm4gdXNlclxufVxuXG5mbiAoYXBwIEFwcCkgdXB0aW1lKCkgc3RyaW5nIHtcblx0cmV0dXJuICcke3RpbWUuc2luY2UoYXBwLnN0YXJ0ZWQpfSdcbn1cblxuZm4gaGFuZGxlKG11dCBhcHAgQXBwLCByZXEgaHR0cC5SZXF1ZXN0KSBzdHJpbmcge1xuXHRtYXRjaCByZXEudXJsIHtcblx0XHQnL3VzZXJzJyB7XG5cdFx0XHRyZXR1cm4ganNvbi5lbmNvZGUoYXBwLnVzZXJzLnZhbHVlcygpKVxuXHRcdH1cblx0XHQnL3VwdGltZScge1xuXHRcdFx0cmV0dXJuIGFwcC51cHRpbWUoKVxuXHRcdH1cblx0XHRlbHNlIHtcblx0XHRcdHJldHVybiAnbm90IGZvdW5kJ1xuXHRcdH1cblx0fVxufVxuXG5mbiBtYWluKCkge1xuXHRtdXQgYXBwIDo9IEFwcHtcblx0XHRzdGFydGVkOiB0aW1lLm5vdygpXG5cdH1cblx0YXBwLmFkZF91c2VyKCdhbGljZScpXG5cdGFwcC5hZGRfdXNlcignYm9iJylcblx0cHJpbnRsbignc2VydmluZyAke2FwcC51c2Vycy5sZW59IHVzZXJzJylcblx0Zm9yIG5hbWUsIHVzZXIgaW4gYXBwLnVzZXJzIHtcblx0XHRwcmludGxuKCcke25hbWV9OiAke3VzZXIuaWR9Jylcblx0fVxufVxuIl0sIlZlcmlsb2ciOlsiLy8gU2ltcGxlIHN5bmNocm9ub3VzIGNvdW50ZXIgd2l0aCBlbmFibGUgYW5kIHRlcm1pbmFsIGNvdW50IG91dHB1dFxuYHRpbWVzY2FsZSAxbnMgLyAxcHNcblxubW9kdWxlIGNvdW50ZXIgIyhcbiAgICBwYXJhbWV0ZXIgV0lEVEggPSA4XG4pIChcbiAgICBpbnB1dCAgd2lyZSAgICAgICAgICAgICBjbGssXG4gICAgaW5wdXQgIHdpcmUgICAgICAgICAgICAgcnN0X24sXG4gICAgaW5wdXQgIHdpcmUgICAgICAgICAgICAgZW5hYmxlLFxuICAgIG91dHB1dCByZWcgIFtXSURUSC0xOjBdIGNvdW50LFxuICAgIG91dHB1dCB3aXJlICAgICAgICAgICAgIGRvbmVcbik7XG5cbiAgICBhc3NpZ24gZG9uZSA9IChjb3VudCA9PSB7V0lEVEh7MSdiMX19KTtcblxuICAgIGFsd2F5cyBAKHBvc2VkZ2UgY2xrIG9yIG5lZ2VkZ2UgcnN0X24pIGJlZ2luXG4gICAgICAgIGlmICghcnN0X24pIGJlZ2luXG4gICAgICAgICAgICBjb3VudCBcdTAwM2M9IHtXSURUSHsxJ2IwfX07XG4gICAgICAgIGVuZCBlbHNlIGlmIChlbmFibGUpIGJlZ2luXG4gICAgICAgICAgICBjb3VudCBcdTAwM2M9IGNvdW50ICsgMSdiMTtcbiAgICAgICAgZW5kXG4gICAgZW5kXG5cbmVuZG1vZHVsZVxuXG5tb2R1bGUgY291bnRlcl90YjtcbiAgICByZWcgY2xrID0gMDtcbiAgICByZWcgcnN0X24gPSAwO1xuICAgIHJlZyBlbmFibGUgPSAwO1xuICAgIHdpcmUgWzc6MF0gY291bnQ7XG4gICAgd2lyZSBkb25lO1xuXG4gICAgY291bnRlciAjKC5XSURUSCg4KSkgZHV0IChcbiAgICAgICAgLmNsayhjbGspLFxuICAgICAgICAucnN0X24ocnN0X24pLFxuICAgICAgICAuZW5hYmxlKGVuYWJsZSksXG4gICAgICAgIC5jb3VudChjb3VudCksXG4gICAgICAgIC5kb25lKGRvbmUpXG4gICAgKTtcblxuICAgIGFsd2F5cyAjNSBjbGsgPSB+Y2xrO1xuXG4gICAgaW5pdGlhbCBiZWdpblxuICAgICAgICAkZHVtcGZpbGUoXCJjb3VudGVyLnZjZFwiKTtcbiAgICAgICAgJGR1bXB2YXJzKDAsIGNvdW50ZXJfdGIpO1xuICAgICAgICAjMjAgcnN0X24gPSAxO1xuICAgICAgICAjMTAgZW5hYmxlID0gMTtcbiAgICAgICAgIzMwMDAgJGZpbmlzaDtcbiAgICBlbmRcbmVuZG1vZHVsZVxuIiwibW9kdWxlIHVhcnRfdHggKFxuICAgIGlucHV0ICAgICAgICAgICAgY2xrLFxuICAgIGlucHV0ICAgICAgICAgICAgcmVzZXQsXG4gICAgaW5wdXQgICAgICAgICAgICBzdGFydCxcbiAgICBpbnB1dCAgICAgIFs3OjBdIGRhdGEsXG4gICAgb3V0cHV0IHJlZyAgICAgICB0eCxcbiAgICBvdXRwdXQgcmVnICAgICAgIGJ1c3lcbik7XG4gICAgbG9jYWxwYXJhbSBJRExFICA9IDInZDA7XG4gICAgbG9jYWxwYXJhbSBTVEFSVCA9IDInZDE7XG4gICAgbG9jYWxwYXJhbSBEQVRBICA9IDInZDI7XG4gICAgbG9jYWxwYXJhbSBTVE9QICA9IDInZDM7XG5cbiAgICByZWcgWzE6MF0gc3RhdGU7XG4gICAgcmVnIFsyOjBdIGJpdF9pbmRleDtcbiAgICByZWcgWzc6MF0gc2hpZnQ7XG5cbiAgICBhbHdheXMgQChwb3NlZGdlIGNsaykgYmVnaW5cbiAgICAgICAgaWYgKHJlc2V0KSBiZWdpblxuICAgICAgICAgICAgc3RhdGUgXHUwMDNjPSBJRExFO1xuICAgICAgICAgICAgdHggICAgXHUwMDNjPSAxJ2IxO1xuICAgICAgICAgICAgYnVzeSAgXHUwMDNjPSAxJ2IwO1xuICAgICAgICBlbmQgZWxzZSBiZWdpblxuICAgICAgICAgICAgY2FzZSAoc3RhdGUpXG4gICAgICAgICAgICAgICAgSURMRTogYmVnaW5cbiAgICAgICAgICAgICAgICAgICAgdHggXHUwMDNjPSAxJ2IxO1xuICAgICAgICAgICAgICAgICAgICBpZiAoc3RhcnQpIGJlZ2luXG4gICAgICAgICAgICAgICAgICAgICAgICBzaGlmdCBcdTAwM2M9IGRhdGE7XG4gICAgICAgICAgICAgICAgICAgICAgICBidXN5ICBcdTAwM2M9IDEnYjE7XG4gICAgICAgICAgICAgICAgICAgICAgICBzdGF0ZSBcdTAwM2M9IFNUQVJUO1xuICAgICAgICAgICAgICAgICAgICBlbmRcbiAgICAgICAgICAgICAgICBlbmRcbiAgICAgICAgICAgICAgICBTVEFSVDogYmVnaW5cbiAgICAgICAgICAgICAgICAgICAgdHggICAgICAgIFx1MDAzYz0gMSdiMDtcbiAgICAgICAgICAgICAgICAgICAgYml0X2luZGV4IFx1MDAzYz0gMydkMDtcbiAgICAgICAgICAgICAgICAgICAgc3RhdGUgICAgIFx1MDAzYz0gREFUQTtcbiAgICAgICAgICAgICAgICBlbmRcbiAgICAgICAgICAgICAgICBEQVRBOiBiZWdpblxuICAgICAgICAgICAgICAgICAgICB0eCAgICBcdTAwM2M9IHNoaWZ0W2JpdF9pbmRleF07XG4gICAgICAgICAgICAgICAgICAgIGlmIChiaXRfaW5kZXggPT0gMydkNylcbiAgICAgICAgICAgICAgICAgICAgICAgIHN0YXRlIFx1MDAzYz0gU1RPUDtcbiAgICAgICAgICAgICAgICAgICAgZWxzZVxuICAgICAgICAgICAgICAgICAgICAgICAgYml0X2luZGV4IFx1MDAzYz0gYml0X2luZGV4ICsgMydkMTtcbiAgICAgICAgICAgICAgICBlbmRcbiAgICAgICAgICAgICAgICBTVE9QOiBiZWdpblxuICAgICAgICAgICAgICAgICAgICB0eCAgICBcdTAwM2M9IDEnYjE7XG4gICAgICAgICAgICAgICAgICAgIGJ1c3kgIFx1MDAzYz0gMSdiMDtcbiAgICAgICAgICAgICAgICAgICAgc3RhdGUgXHUwMDNjPSBJRExFO1xuICAgICAgICAgICAgICAgIGVuZFxuICAgICAgICAgICAgICAgIGRlZmF1bHQ6IHN0YXRlIFx1MDAzYz0gSURMRTtcbiAgICAgICAgICAgIGVuZGNhc2VcbiAgICAgICAgZW5kXG4gICAgZW5kXG5lbmRtb2R1bGVcbiJdLCJZQU1MIjpbImFwaVZlcnNpb246IGFwcHMvdjFcbmtpbmQ6IERlcGxveW1lbnRcbm1ldGFkYXRhOlxuICBuYW1lOiBjb3VudGVyXG4gIGxhYmVsczpcbiAgICBhcHA6IGNvdW50ZXJcbnNwZWM6XG4gIHJlcGxpY2FzOiAzXG4gIHNlbGVjdG9yOlxuICAgIG1hdGNoTGFiZWxzOlxuICAgICAgYXBwOiBjb3VudGVyXG4gIHRlbXBsYXRlOlxuICAgIG1ldGFkYXRhOlxuICAgICAgbGFiZWxzOlxuICAgICAgICBhcHA6IGNvdW50ZXJcbiAgICBzcGVjOlxuICAgICAgY29udGFpbmVyczpcbiAgICAgICAgLSBuYW1lOiBjb3VudGVyXG4gICAgICAgICAgaW1hZ2U6IGV4YW1wbGUvY291bnRlcjoxLjIuMFxuICAgICAgICAgIGFyZ3M6IFtcIi0tbGlzdGVuXCIsIFwiOjgwODBcIl1cbiAgICAgICAgICBwb3J0czpcbiAgICAgICAgICAgIC0gY29udGFpbmVyUG9ydDogODA4MFxuICAgICAgICAgIHJlc291cmNlczpcbiAgICAgICAgICAgIGxpbWl0czpcbiAgICAgICAgICAgICAgY3B1OiA1MDBtXG4gICAgICAgICAgICAgIG1lbW9yeTogMjU2TWlcbiAgICAgICAgICByZWFkaW5lc3NQcm9iZTpcbiAgICAgICAgICAgIGh0dHBHZXQ6XG4gICAgICAgICAgICAgIHBhdGg6IC9oZWFsdGh6XG4gICAgICAgICAgICAgIHBvcnQ6IDgwODBcbiMgU2V0dGluZ3MgZm9yIHRoZSBhcHBsaWNhdGlvbiBpdHNlbGZcbmNvbmZpZzpcbiAgZGVidWc6IGZhbHNlXG4gIGxhbmd1YWdlczpcbiAgICAtIGdvXG4gICAgLSBweXRob25cbiAgdGhyZXNob2xkczoge2xpbmVzOiAxMDAwLCBjb21wbGV4aXR5OiA1MH1cbiIsInZlcnNpb246IFwiMy44XCJcblxuc2VydmljZXM6XG4gIHdlYjpcbiAgICBidWlsZDpcbiAgICAgIGNvbnRleHQ6IC5cbiAgICAgIGRvY2tlcmZpbGU6IERvY2tlcmZpbGVcbiAgICBwb3J0czpcbiAgICAgIC0gXCI4MDgwOjgwODBcIlxuICAgIGVudmlyb25tZW50OlxuICAgICAgLSBEQVRBQkFTRV9VUkw9cG9zdGdyZXM6Ly9hcHA6c2VjcmV0QGRiOjU0MzIvYXBwXG4gICAgICAtIExPR19MRVZFTD1kZWJ1Z1xuICAgIGRlcGVuZHNfb246XG4gICAgICAtIGRiXG4gICAgICAtIGNhY2hlXG4gICAgcmVzdGFydDogdW5sZXNzLXN0b3BwZWRcblxuICBkYjpcbiAgICBpbWFnZTogcG9zdGdyZXM6MTVcbiAgICB2b2x1bWVzOlxuICAgICAgLSBkYi1kYXRhOi92YXIvbGliL3Bvc3RncmVzcWwvZGF0YVxuICAgIGVudmlyb25tZW50OlxuICAgICAgUE9TVEdSRVNfVVNFUjogYXBwXG4gICAgICBQT1NUR1JFU19QQVNTV09SRDogc2VjcmV0XG4gICAgICBQT1NUR1JFU19EQjogYXBwXG4gICAgaGVhbHRoY2hlY2s6XG4gICAgICB0ZXN0OiBbXCJDTURcIiwgXCJwZ19pc3JlYWR5XCIsIFwiLVVcIiwgXCJhcHBcIl1cbiAgICAgIGludGVydmFsOiAxMHNcbiAgICAgIHJldHJpZXM6IDVcblxuICBjYWNoZTpcbiAgICBpbWFnZTogcmVkaXM6Ny1hbHBpbmVcblxudm9sdW1lczpcbiAgZGItZGF0YTpcbiIsIm5hbWU6IGJ1aWxkXG5cbm9uOlxuICBwdXNoOlxuICAgIGJyYW5jaGVzOiBbIG1haW4gXVxuICBwdWxsX3JlcXVlc3Q6XG4gICAgYnJhbmNoZXM6IFsgbWFpbiBdXG5cbmpvYnM6XG4gIHRlc3Q6XG4gICAgcnVucy1vbjogJHt7IG1hdHJpeC5vcyB9fVxuICAgIHN0cmF0ZWd5OlxuICAgICAgbWF0cml4OlxuICAgICAgICBvczogW3VidW50dS1sYXRlc3QsIG1hY29zLWxhdGVzdCwgd2luZG93cy1sYXRlc3RdXG4gICAgICAgIGdvOiBbXCIxLjIwXCIsIFwiMS4yMVwiXVxuICAgIHN0ZXBzOlxuICAgICAgLSB1c2VzOiBhY3Rpb25zL2NoZWNrb3V0QHY0XG4gICAgICAtIG5hbWU6IFNldCB1cCBHb1xuICAgICAgICB1c2VzOiBhY3Rpb25zL3NldHVwLWdvQHY1XG4gICAgICAgIHdpdGg6XG4gICAgICAgICAgZ28tdmVyc2lvbjogJHt7IG1hdHJpeC5nbyB9fVxuICAgICAgLSBuYW1lOiBUZXN0XG4gICAgICAgIHJ1bjogZ28gdGVzdCAtcmFjZSAuLy4uLlxuICAgICAgLSBuYW1lOiBMaW50XG4gICAgICAgIGlmOiBtYXRyaXgub3MgPT0gJ3VidW50dS1sYXRlc3QnXG4gICAgICAgIHJ1bjogfFxuICAgICAgICAgIGdvIHZldCAuLy4uLlxuICAgICAgICAgIGdvZm10IC1sIC5cbiJdfQ==`
+vendorRules = `WwogICIoXnwvKXZlbmRvcnM/LyIsCiAgIihefC8pW1Z2XWVuZG9yLyIsCiAgIihefC8pdGhpcmRbLV9dP3BhcnR5LyIsCiAgIihefC8pM3JkWy1fXT9wYXJ0eS8iLAogICIoXnwvKWV4dGVybihhbCk/LyIsCiAgIihefC8pbm9kZV9tb2R1bGVzLyIsCiAgIihefC8pYm93ZXJfY29tcG9uZW50cy8iLAogICIoXnwvKWpzcG1fcGFja2FnZXMvIiwKICAiKF58LylQb2RzLyIsCiAgIihefC8pQ2FydGhhZ2UvIiwKICAiKF58LylHb2RlcHMvX3dvcmtzcGFjZS8iLAogICIoXnwvKVtQcF1hY2thZ2VzLy4rXFwuXFxkKy8iLAogICIoXnwvKWRlcHMvIiwKICAiKF58LylbRGRdZXBlbmRlbmNpZXMvIiwKICAiKF58LylcXC5idW5kbGUvIiwKICAiKF58LylcXC55YXJuLyIsCiAgIihefC8pY2FjaGUvIiwKICAiKF58LylkaXN0LyIsCiAgIl5kZWJpYW4vIiwKICAiKF58LylncmFkbGV3KFxcLmJhdCk/JCIsCiAgIihefC8pZ3JhZGxlL3dyYXBwZXIvIiwKICAiKF58Lyltdm53KFxcLmNtZCk/JCIsCiAgIihefC8pXFwubXZuL3dyYXBwZXIvIiwKICAiKF58Lyljb25maWd1cmUkIiwKICAiKF58Lyljb25maWdcXC4oZ3Vlc3N8c3ViKSQiLAogICIoXnwvKShhY2xvY2FsfGxpYnRvb2x8bHRvcHRpb25zfGx0c3VnYXJ8bHR2ZXJzaW9ufGx0fm9ic29sZXRlKVxcLm00JCIsCiAgIihefC8pd2FmJCIsCiAgIihcXC58LSltaW5cXC4oanN8Y3NzKSQiLAogICIoXnwvKWpxdWVyeShbXi5dKilcXC5qcyQiLAogICIoXnwvKWpxdWVyeS1cXGRcXC5cXGQrKFxcLlxcZCspP1xcLmpzJCIsCiAgIihefC8panF1ZXJ5LXVpKC1cXGRcXC5cXGQrKFxcLlxcZCspPyk/KFxcLlxcdyspP1xcLihqc3xjc3MpJCIsCiAgIihefC8panF1ZXJ5XFwuKHVpfGVmZmVjdHMpXFwuKFteLl0qKVxcLihqc3xjc3MpJCIsCiAgIihefC8pYm9vdHN0cmFwKFteLy5dKikoXFwuLiopP1xcLihqc3xjc3N8bGVzc3xzY3NzfHN0eWwpJCIsCiAgIihefC8pZm9udC0/YXdlc29tZVxcLihjc3N8bGVzc3xzY3NzfHN0eWwpJCIsCiAgIihefC8pZm9udC0/YXdlc29tZS8uKlxcLihjc3N8bGVzc3xzY3NzfHN0eWwpJCIsCiAgIihefC8pbm9ybWFsaXplXFwuKGNzc3xsZXNzfHNjc3N8c3R5bCkkIiwKICAiKF58LylhbmltYXRlXFwuKGNzc3xsZXNzfHNjc3N8c3R5bCkkIiwKICAiKF58LylzZWxlY3QyLy4qXFwuKGNzc3xzY3NzfGpzKSQiLAogICIoXnwvKWFuZ3VsYXIoW14uXSopXFwuanMkIiwKICAiKF58LylkMyhcXC52XFxkKyk/KFteLl0qKVxcLmpzJCIsCiAgIihefC8pcmVhY3QoLVteLl0qKT9cXC5qcyQiLAogICIoXnwvKW1vZGVybml6ci1cXGRcXC5cXGQrKFxcLlxcZCspP1xcLmpzJCIsCiAgIihefC8pbW9kZXJuaXpyXFwuY3VzdG9tXFwuXFxkK1xcLmpzJCIsCiAgIihefC8pcHJvdG90eXBlKC4qKVxcLmpzJCIsCiAgIihefC8pbW9vdG9vbHMoW14uXSopXFxkK1xcLlxcZCtcXC5cXGQrKFteLl0qKVxcLmpzJCIsCiAgIihefC8pZG9qb1xcLmpzJCIsCiAgIihefC8peXVpKFteLl0qKVxcLmpzJCIsCiAgIihefC8pY2tlZGl0b3JcXC5qcyQiLAogICIoXnwvKXRpbnlfbWNlKFteLl0qKVxcLmpzJCIsCiAgIihefC8pYWNlLWJ1aWxkcy8iLAogICIoXnwvKU1hdGhKYXgvIiwKICAiKF58LylDaGFydFxcLmpzJCIsCiAgIihefC8pW0NjXW9kZVtNbV1pcnJvci8oXFxkK1xcLlxcZCsvKT8obGlifG1vZGV8dGhlbWV8YWRkb258a2V5bWFwfGRlbW8pIiwKICAiKF58LylmbG93LXR5cGVkLy4qXFwuanMkIiwKICAiKF58LylwZGZcXC53b3JrZXJcXC5qcyQiLAogICIoXnwvKWRvY3M/L18/KGJ1aWxkfHRoZW1lcz98dGVtcGxhdGVzP3xzdGF0aWMpLyIsCiAgIihefC8pXFwudnNjb2RlLyIsCiAgIlxcLnN1YmxpbWUtKHByb2plY3R8d29ya3NwYWNlKSQiLAogICJcXC54Y3RlbXBsYXRlLyIsCiAgIlxcLmltYWdlc2V0LyIsCiAgIihefC8pW0EtWmEtel0rXFwuZnJhbWV3b3JrLyIsCiAgIihefC8pLXZzZG9jXFwuanMkIiwKICAiKF58LylcXC5pbnRlbGxpc2Vuc2VcXC5qcyQiLAogICIoXnwvKVtNbV1pY3Jvc29mdChbTW1ddmMpPyhbQWFdamF4fFtWdl1hbGlkYXRpb24pKFxcLmRlYnVnKT9cXC5qcyQiCl0K`
 )
@@ -0,0 +1,385 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Config holds every option which controls how files are found and counted. It exists so scc can be
+// embedded as a library without touching the package level flags which the command line uses.
+// Logging is still controlled by the package level Verbose, Debug and Trace flags.
+type Config struct {
+	// Files keeps the result for every file in LanguageSummary.Files
+	Files bool
+	// Functions finds the functions in each file recording them in FileJob.Functions
+	Functions bool
+	// SortBy sets the order of the returned summaries [files, name, lines, blanks, code, comments, complexity]
+	SortBy string
+
+	// NoComplexity skips the calculation of code complexity
+	NoComplexity bool
+	// NoDuplicates removes duplicate files from the results
+	NoDuplicates bool
+	// DisableCheckBinary counts files which would otherwise be identified as binary
+	DisableCheckBinary bool
+	// GuessEncoding transcodes UTF-16 and UTF-32 files without a byte order mark found by their NUL bytes
+	GuessEncoding bool
+
+	// Minified enables minified file detection
+	Minified bool
+	// Generated enables generated file detection
+	Generated bool
+	// IgnoreMinified removes minified files from the results and implies Minified
+	IgnoreMinified bool
+	// IgnoreGenerated removes generated files from the results and implies Generated
+	IgnoreGenerated bool
+	// Vendored enables vendored file detection
+	Vendored bool
+	// IgnoreVendored removes vendored files from the results and implies Vendored
+	IgnoreVendored bool
+	// GeneratedMarkers are the strings searched for in the head of files to identify them as generated
+	GeneratedMarkers []string
+	// MinifiedGeneratedLineByteLength is the number of bytes per average line for a file to be considered minified
+	MinifiedGeneratedLineByteLength int
+
+	// NoGitIgnore disables .gitignore file logic
+	NoGitIgnore bool
+	// NoIgnore disables .ignore file logic
+	NoIgnore bool
+	// NoSccrc disables .sccrc file logic
+	NoSccrc bool
+	// NoGitAttributes disables the linguist attributes in .gitattributes files
+	NoGitAttributes bool
+	// Exclude are regular expressions which exclude matching files and directories
+	Exclude []string
+	// PathDenyList are directories which should be skipped
+	PathDenyList []string
+	// AllowListExtensions limits counting to files with these extensions
+	AllowListExtensions []string
+	// ExcludeListExtensions skips files with these extensions
+	ExcludeListExtensions []string
+	// ExcludeFilename skips files with names containing any of these
+	ExcludeFilename []string
+	// IncludeSymLinks counts the target of symlinked files
+	IncludeSymLinks bool
+
+	// CountAs maps extensions to languages e.g. jsp:htm,chead:"C Header"
+	CountAs string
+	// RemapUnknown remaps files of unknown type containing a string e.g. "-*- C++ -*-":"C Header"
+	RemapUnknown string
+	// RemapAll remaps any file containing a string e.g. "-*- C++ -*-":"C Header"
+	RemapAll string
+
+	// NoLarge skips files over LargeLineCount lines or LargeByteCount bytes
+	NoLarge bool
+	// LargeLineCount is the number of lines a file can contain before being skipped when NoLarge is set
+	LargeLineCount int64
+	// LargeByteCount is the number of bytes a file can contain before being skipped when NoLarge is set
+	LargeByteCount int64
+
+	// DirectoryWalkerJobWorkers is the number of workers which will walk the directory tree
+	DirectoryWalkerJobWorkers int
+	// FileListQueueSize is the queue of files found and ready to be read into memory
+	FileListQueueSize int
+	// FileProcessJobWorkers is the number of workers that process the file collecting stats
+	FileProcessJobWorkers int
+	// FileSummaryJobQueueSize is the queue used to hold processed file statistics before summarising
+	FileSummaryJobQueueSize int
+
+	// LanguagesFile is a JSON file in the same format as languages.json whose languages add to or replace the built in ones
+	LanguagesFile string
+
+	// CacheDir is the directory to keep counts in between runs so unchanged files are not read again, empty disables it
+	CacheDir string
+
+	// GitTracked counts only the files in the git index rather than walking the directories
+	GitTracked bool
+	// GitStaged counts the staged content of tracked files rather than the working tree and implies GitTracked
+	GitStaged bool
+	// GitHead counts the files and content committed at HEAD rather than the working tree
+	GitHead bool
+
+	// GcFileCount turns the garbage collector off until this many files have been read which makes small
+	// runs faster, 0 leaves it alone. Setting it changes the GC for the whole process not just this Counter.
+	GcFileCount int
+}
+
+// NewConfig returns a Config with the same defaults as the command line
+func NewConfig() Config {
+	return Config{
+		SortBy:                          "files",
+		GeneratedMarkers:                []string{"do not edit", "<auto-generated />"},
+		MinifiedGeneratedLineByteLength: 255,
+		PathDenyList:                    []string{".git", ".hg", ".svn"},
+		LargeLineCount:                  40000,
+		LargeByteCount:                  1000000,
+		DirectoryWalkerJobWorkers:       runtime.NumCPU(),
+		FileListQueueSize:               runtime.NumCPU(),
+		FileProcessJobWorkers:           runtime.NumCPU() * 4,
+		FileSummaryJobQueueSize:         runtime.NumCPU(),
+	}
+}
+
+// FlagConfig builds a Config from the package level flags the command line sets including anything
+// implied by other flags such as --no-min-gen turning on minified and generated detection
+func FlagConfig() Config {
+	processFlags()
+
+	config := configFromGlobals()
+	config.GcFileCount = GcFileCount
+	return config
+}
+
+// configFromGlobals builds a Config from the package level flags the command line sets
+func configFromGlobals() Config {
+	return Config{
+		Files:                           Files,
+		Functions:                       ByFunction,
+		SortBy:                          SortBy,
+		NoComplexity:                    Complexity,
+		NoDuplicates:                    Duplicates,
+		DisableCheckBinary:              DisableCheckBinary,
+		GuessEncoding:                   GuessEncoding,
+		Minified:                        Minified,
+		Generated:                       Generated,
+		IgnoreMinified:                  IgnoreMinified,
+		IgnoreGenerated:                 IgnoreGenerated,
+		Vendored:                        Vendored,
+		IgnoreVendored:                  IgnoreVendored,
+		GeneratedMarkers:                GeneratedMarkers,
+		MinifiedGeneratedLineByteLength: MinifiedGeneratedLineByteLength,
+		NoGitIgnore:                     GitIgnore,
+		NoIgnore:                        Ignore,
+		NoSccrc:                         Sccrc,
+		NoGitAttributes:                 GitAttributes,
+		Exclude:                         Exclude,
+		PathDenyList:                    PathDenyList,
+		AllowListExtensions:             AllowListExtensions,
+		ExcludeListExtensions:           ExcludeListExtensions,
+		ExcludeFilename:                 ExcludeFilename,
+		IncludeSymLinks:                 IncludeSymLinks,
+		CountAs:                         CountAs,
+		RemapUnknown:                    RemapUnknown,
+		RemapAll:                        RemapAll,
+		NoLarge:                         NoLarge,
+		LargeLineCount:                  LargeLineCount,
+		LargeByteCount:                  LargeByteCount,
+		DirectoryWalkerJobWorkers:       DirectoryWalkerJobWorkers,
+		FileListQueueSize:               FileListQueueSize,
+		FileProcessJobWorkers:           FileProcessJobWorkers,
+		FileSummaryJobQueueSize:         FileSummaryJobQueueSize,
+		LanguagesFile:                   LanguagesFile,
+		CacheDir:                        CacheDir,
+		GitTracked:                      GitTracked,
+		GitStaged:                       GitStaged,
+		GitHead:                         GitHead,
+	}
+}
+
+// Counter counts code according to its Config. Every Counter has its own copy of the language
+// database and lookups so many of them with different configuration can run at the same time.
+type Counter struct {
+	Config Config
+
+	languageDatabase      map[string]Language
+	extensionToLanguage   map[string][]string
+	filenameToLanguage    map[string]string
+	shebangLookup         map[string][]string
+	languageFeatures      map[string]LanguageFeature
+	languageFeaturesMutex *sync.Mutex
+	duplicates            *CheckDuplicates
+	lazy                  bool
+}
+
+// NewCounter creates a Counter loading the language database and applying the supplied Config.
+// An error is returned if the Config has a LanguagesFile which cannot be loaded.
+func NewCounter(config Config) (*Counter, error) {
+	if config.IgnoreMinified {
+		config.Minified = true
+	}
+	if config.IgnoreGenerated {
+		config.Generated = true
+	}
+	if config.IgnoreVendored {
+		config.Vendored = true
+	}
+
+	// Fix for https://github.com/boyter/scc/issues/250
+	fixedPath := []string{}
+	for _, path := range config.PathDenyList {
+		fixedPath = append(fixedPath, strings.TrimRight(path, "/"))
+	}
+	config.PathDenyList = fixedPath
+	config.SortBy = strings.ToLower(config.SortBy)
+
+	if config.GitStaged && config.GitHead {
+		return nil, errGitModes
+	}
+
+	database, err := loadDatabaseWithFile(config.LanguagesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Counter{
+		Config:                config,
+		languageDatabase:      database,
+		extensionToLanguage:   map[string][]string{},
+		filenameToLanguage:    map[string]string{},
+		shebangLookup:         map[string][]string{},
+		languageFeatures:      map[string]LanguageFeature{},
+		languageFeaturesMutex: &sync.Mutex{},
+		duplicates:            &CheckDuplicates{hashes: make(map[int64][][]byte)},
+		lazy:                  true,
+	}
+
+	buildLanguageLookups(c.languageDatabase, c.extensionToLanguage, c.filenameToLanguage, c.shebangLookup)
+	if len(config.CountAs) != 0 {
+		applyCountAs(config.CountAs, c.languageDatabase, c.extensionToLanguage)
+	}
+
+	return c, nil
+}
+
+// globalCounter returns a Counter which reads the package level flags and shares the package level
+// language lookups. It is what keeps the package level functions working as before.
+func globalCounter() *Counter {
+	return &Counter{
+		Config:                configFromGlobals(),
+		languageDatabase:      languageDatabase,
+		extensionToLanguage:   ExtensionToLanguage,
+		filenameToLanguage:    FilenameToLanguage,
+		shebangLookup:         ShebangLookup,
+		languageFeatures:      LanguageFeatures,
+		languageFeaturesMutex: &LanguageFeaturesMutex,
+		duplicates:            &duplicates,
+		lazy:                  isLazy,
+	}
+}
+
+// ErrIncomplete is returned along with the partial results of a run which was cancelled or timed out
+var ErrIncomplete = errors.New("results are incomplete")
+
+// Run counts everything in the supplied files and directories returning the summary for each language.
+// The current directory is counted if no paths are supplied. If the context is cancelled or its deadline
+// passes the files counted so far are returned with an error wrapping both ErrIncomplete and the context error.
+func (c *Counter) Run(ctx context.Context, paths []string) ([]LanguageSummary, error) {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	for _, f := range paths {
+		if _, err := os.Stat(filepath.Clean(f)); err != nil {
+			return nil, err
+		}
+	}
+
+	c.duplicates.reset()
+
+	fileSummaryJobQueue, wait := c.start(ctx, paths)
+	language := aggregateLanguageSummary(fileSummaryJobQueue, c.Config.Files)
+
+	if err := wait(); err != nil {
+		return nil, err
+	}
+
+	language = sortLanguageSummaryBy(language, c.Config.SortBy)
+	if err := ctx.Err(); err != nil {
+		return language, fmt.Errorf("%w: %w", ErrIncomplete, err)
+	}
+
+	return language, nil
+}
+
+// start walks the paths in the background sending everything found through the file processors.
+// It returns the queue that counted files are sent to and a function which returns the first
+// error encountered starting the walk once the queue has been drained.
+func (c *Counter) start(ctx context.Context, paths []string) (chan *FileJob, func() error) {
+	fileListQueue := make(chan *FileJob, c.Config.FileListQueueSize)             // Files ready to be read from disk
+	fileSummaryJobQueue := make(chan *FileJob, c.Config.FileSummaryJobQueueSize) // Files ready to be summarised
+
+	var cache *fileCache
+	if c.Config.CacheDir != "" {
+		cache = loadFileCache(c.Config.CacheDir, c.cacheFingerprint(paths))
+	}
+
+	var walkErr error
+	walked := make(chan struct{})
+
+	go func() {
+		defer close(walked)
+
+		if c.gitMode() {
+			walkErr = c.walkGit(ctx, paths, fileListQueue)
+			return
+		}
+
+		directoryWalker := c.newDirectoryWalker(ctx, fileListQueue)
+
+		for _, f := range paths {
+			if err := directoryWalker.Start(f); err != nil {
+				walkErr = fmt.Errorf("failed to walk %s: %v", f, err)
+				break
+			}
+		}
+
+		directoryWalker.Run()
+	}()
+	go c.fileProcessorWorker(ctx, cache, fileListQueue, fileSummaryJobQueue)
+
+	return untilDone(ctx, fileSummaryJobQueue), func() error {
+		select {
+		case <-walked:
+			return walkErr
+		case <-ctx.Done():
+			// The walk may be stuck reading a directory which ignores the context so is left behind
+			return nil
+		}
+	}
+}
+
+// untilDone passes on the counted files closing the returned queue once the context is done even if the
+// workers have not finished. Opening or reading a file on a hung network mount ignores the context so
+// rather than wait on a read which may never return, whatever has arrived is summarised and the rest of
+// the queue is drained in the background so the workers are not blocked should they ever finish.
+func untilDone(ctx context.Context, queue chan *FileJob) chan *FileJob {
+	if ctx.Done() == nil {
+		return queue
+	}
+
+	output := make(chan *FileJob, cap(queue))
+	go func() {
+		defer close(output)
+
+		for {
+			select {
+			case res, ok := <-queue:
+				if !ok {
+					return
+				}
+				select {
+				case output <- res:
+					continue
+				case <-ctx.Done():
+				}
+			case <-ctx.Done():
+			}
+
+			go func() {
+				for range queue {
+				}
+			}()
+			return
+		}
+	}()
+
+	return output
+}
@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+	"sync"
+	"testing"
+	"time"
+)
+
+func mustNewCounter(t *testing.T, config Config) *Counter {
+	counter, err := NewCounter(config)
+	if err != nil {
+		t.Fatalf("unexpected error creating counter %v", err)
+	}
+
+	return counter
+}
+
+func findLanguageSummary(summary []LanguageSummary, name string) (LanguageSummary, bool) {
+	for _, l := range summary {
+		if l.Name == name {
+			return l, true
+		}
+	}
+
+	return LanguageSummary{}, false
+}
+
+func TestCounterRun(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+
+	summary, err := counter.Run(context.Background(), []string{"../examples/diff/new/"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	goSummary, ok := findLanguageSummary(summary, "Go")
+	if !ok {
+		t.Fatal("expected Go in summary")
+	}
+
+	if goSummary.Count != 1 || goSummary.Code == 0 || goSummary.Complexity == 0 {
+		t.Errorf("unexpected Go summary %+v", goSummary)
+	}
+
+	if len(goSummary.Files) != 0 {
+		t.Error("expected no files without Files set")
+	}
+}
+
+func TestCounterRunMissingPath(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+
+	_, err := counter.Run(context.Background(), []string{"../examples/doesnotexist/"})
+	if err == nil {
+		t.Error("expected error for missing path")
+	}
+}
+
+func TestCounterRunCancelled(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	summary, err := counter.Run(ctx, []string{"../examples/diff/new/"})
+	if !errors.Is(err, ErrIncomplete) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected incomplete and context.Canceled got %v", err)
+	}
+
+	if len(summary) != 0 {
+		t.Errorf("expected nothing counted got %+v", summary)
+	}
+}
+
+func TestCounterRunTimeout(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	// Depending on the machine this may or may not finish in time but either way
+	// any error must be the partial result one
+	summary, err := counter.Run(ctx, []string{"../"})
+	if err != nil {
+		if !errors.Is(err, ErrIncomplete) || !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected incomplete and context.DeadlineExceeded got %v", err)
+		}
+	} else if len(summary) == 0 {
+		t.Error("expected a summary from a complete run")
+	}
+}
+
+func TestUntilDoneStuckWorker(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// The queue is never closed as if a worker were stuck on a read which ignores the context
+	queue := make(chan *FileJob, 1)
+	queue <- &FileJob{Language: "Go"}
+
+	done := make(chan int)
+	go func() {
+		count := 0
+		for range untilDone(ctx, queue) {
+			count++
+		}
+		done <- count
+	}()
+
+	select {
+	case count := <-done:
+		if count > 1 {
+			t.Errorf("expected at most the one file got %d", count)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the queue to close once the context was done")
+	}
+}
+
+func TestUntilDoneNoContext(t *testing.T) {
+	queue := make(chan *FileJob)
+	if untilDone(context.Background(), queue) != queue {
+		t.Error("expected the queue to be used as is when it can never be cancelled")
+	}
+}
+
+func TestCounterCountAs(t *testing.T) {
+	config := NewConfig()
+	config.CountAs = "go:python"
+	counter := mustNewCounter(t, config)
+
+	summary, err := counter.Run(context.Background(), []string{"../examples/diff/new/main.go"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if _, ok := findLanguageSummary(summary, "Python"); !ok {
+		t.Errorf("expected go file counted as Python got %+v", summary)
+	}
+
+	// The count as must not leak into other counters or the package level lookups
+	for _, lang := range ExtensionToLanguage["go"] {
+		if lang == "Python" {
+			t.Error("expected package level lookup to be untouched")
+		}
+	}
+}
+
+func TestCounterConcurrentConfigs(t *testing.T) {
+	complexityConfig := NewConfig()
+	complexityConfig.Files = true
+
+	noComplexityConfig := NewConfig()
+	noComplexityConfig.NoComplexity = true
+
+	excludeConfig := NewConfig()
+	excludeConfig.ExcludeListExtensions = []string{"go"}
+
+	var wg sync.WaitGroup
+	results := make([][]LanguageSummary, 3)
+	errs := make([]error, 3)
+
+	for i, config := range []Config{complexityConfig, noComplexityConfig, excludeConfig} {
+		counter := mustNewCounter(t, config)
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = counter.Run(context.Background(), []string{"../examples/diff/new/"})
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("counter %d unexpected error %v", i, err)
+		}
+	}
+
+	withComplexity, _ := findLanguageSummary(results[0], "Go")
+	if withComplexity.Complexity == 0 || len(withComplexity.Files) != 1 {
+		t.Errorf("expected complexity and files got %+v", withComplexity)
+	}
+
+	withoutComplexity, _ := findLanguageSummary(results[1], "Go")
+	if withoutComplexity.Complexity != 0 || withoutComplexity.Code != withComplexity.Code {
+		t.Errorf("expected no complexity with same code got %+v", withoutComplexity)
+	}
+
+	if _, ok := findLanguageSummary(results[2], "Go"); ok {
+		t.Error("expected Go to be excluded")
+	}
+}
+
+func TestCounterGcFileCountConcurrent(t *testing.T) {
+	percent := debug.SetGCPercent(100)
+	defer debug.SetGCPercent(percent)
+
+	pauseGc()
+	pauseGc()
+	resumeGc()
+	if got := debug.SetGCPercent(-1); got != -1 {
+		t.Errorf("expected GC to stay off while a run has it paused got %d", got)
+	}
+	resumeGc()
+	if got := debug.SetGCPercent(100); got != 100 {
+		t.Errorf("expected GC to be turned back on got %d", got)
+	}
+
+	var wg sync.WaitGroup
+	for _, count := range []int{1, 1000} {
+		config := NewConfig()
+		config.GcFileCount = count
+		counter := mustNewCounter(t, config)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := counter.Run(context.Background(), []string{"../examples/diff/"}); err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := debug.SetGCPercent(100); got != 100 {
+		t.Errorf("expected GC to be turned back on once both runs finished got %d", got)
+	}
+}
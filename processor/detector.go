@@ -11,36 +11,40 @@ import (
 
 // DetectLanguage detects a language based on the filename returns the language extension and error
 func DetectLanguage(name string) ([]string, string) {
+	return globalCounter().detectLanguage(name)
+}
+
+func (c *Counter) detectLanguage(name string) ([]string, string) {
 	extension := ""
 
 	t := strings.Count(name, ".")
 
 	// If there is no . in the filename or it starts with one then check if #! or other
-	if (t == 0 || (name[0] == '.' && t == 1)) && len(AllowListExtensions) == 0 {
-		return checkFullName(name)
+	if (t == 0 || (name[0] == '.' && t == 1)) && len(c.Config.AllowListExtensions) == 0 {
+		return c.checkFullName(name)
 	}
 
 	// Lookup in case the full name matches
-	language, ok := ExtensionToLanguage[strings.ToLower(name)]
+	language, ok := c.extensionToLanguage[strings.ToLower(name)]
 
 	// If no match check if we have a matching extension
 	if !ok {
 		extension = getExtension(name)
-		language, ok = ExtensionToLanguage[extension]
+		language, ok = c.extensionToLanguage[extension]
 	}
 
 	// Convert from d.ts to ts and check that in case of multiple extensions
 	if !ok {
 		extension = getExtension(extension)
-		language, _ = ExtensionToLanguage[extension]
+		language = c.extensionToLanguage[extension]
 	}
 
 	return language, extension
 }
 
-func checkFullName(name string) ([]string, string) {
+func (c *Counter) checkFullName(name string) ([]string, string) {
 	// Need to check if special type
-	language, ok := FilenameToLanguage[strings.ToLower(name)]
+	language, ok := c.filenameToLanguage[strings.ToLower(name)]
 	if ok {
 		return []string{language}, name
 	}
@@ -55,6 +59,10 @@ func checkFullName(name string) ([]string, string) {
 
 // DetectSheBang given some content attempt to determine if it has a #! that maps to a known language and return the language
 func DetectSheBang(content string) (string, error) {
+	return globalCounter().detectSheBang(content)
+}
+
+func (c *Counter) detectSheBang(content string) (string, error) {
 	if !strings.HasPrefix(content, "#!") {
 		return "", errors.New("Missing #!")
 	}
@@ -71,7 +79,7 @@ func DetectSheBang(content string) (string, error) {
 		return "", err
 	}
 
-	for k, v := range ShebangLookup {
+	for k, v := range c.shebangLookup {
 		for _, x := range v {
 			// detects both full path and env usage
 			if x == cmd {
@@ -149,23 +157,65 @@ type languageGuess struct {
 }
 
 // DetermineLanguage given a filename, fallback language, possible languages and content make a guess to the type.
-// If multiple possible it will guess based on keywords similar to how https://github.com/vmchale/polyglot does
+// If multiple possible it will guess using a classifier trained on the samples in examples/classifier, falling back
+// to keywords similar to how https://github.com/vmchale/polyglot does when the classifier is not confident
 func DetermineLanguage(filename string, fallbackLanguage string, possibleLanguages []string, content []byte) string {
+	return globalCounter().determineLanguage(filename, fallbackLanguage, possibleLanguages, content)
+}
+
+func (c *Counter) determineLanguage(filename string, fallbackLanguage string, possibleLanguages []string, content []byte) string {
+	language, _ := c.determineLanguageConfidence(filename, fallbackLanguage, possibleLanguages, content)
+	return language
+}
+
+// determineLanguageConfidence works out the language as determineLanguage does along with the probability
+// the classifier gave it, which is 0 when there was only one possibility or the classifier had nothing to go on
+func (c *Counter) determineLanguageConfidence(filename string, fallbackLanguage string, possibleLanguages []string, content []byte) (string, float64) {
 	// If being called through an API its possible nothing is set here and as
 	// such should just return as the Language value should have already been set
 	if len(possibleLanguages) == 0 {
-		return fallbackLanguage
+		return fallbackLanguage, 0
 	}
 
 	// There should only be two possibilities now, either we have a single fallbackLanguage
 	// in which case we set it and return
-	// or we have multiple in which case we try to determine it heuristically
+	// or we have multiple in which case we try to determine it from the content
 	if len(possibleLanguages) == 1 {
-		return possibleLanguages[0]
+		return possibleLanguages[0], 0
 	}
 
 	startTime := makeTimestampNano()
 
+	// The classifier is trusted when it is confident otherwise the keywords decide
+	guess, probabilities, ok := getClassifier().classify(possibleLanguages, content)
+	confident := ok && probabilities[guess] >= classifierMinimumConfidence
+
+	language := guess
+	if !confident {
+		language = c.keywordLanguage(fallbackLanguage, possibleLanguages, content)
+	}
+
+	if Verbose {
+		switch {
+		case confident:
+			printWarn(fmt.Sprintf("guessing language %s for file %s with confidence %.2f", language, filename, probabilities[language]))
+		case ok:
+			printWarn(fmt.Sprintf("guessing language %s for file %s using keywords with confidence %.2f as the classifier was only %.2f sure of %s", language, filename, probabilities[language], probabilities[guess], guess))
+		default:
+			printWarn(fmt.Sprintf("guessing language %s for file %s using keywords as the classifier has nothing to compare", language, filename))
+		}
+	}
+
+	if Trace {
+		printTrace(fmt.Sprintf("nanoseconds to guess language: %s: %d", filename, makeTimestampNano()-startTime))
+	}
+
+	return language, probabilities[language]
+}
+
+// keywordLanguage picks the language with the most keywords in the content. A language without keywords
+// is the primary which is only beaten by 3 or more matches for another.
+func (c *Counter) keywordLanguage(fallbackLanguage string, possibleLanguages []string, content []byte) string {
 	var toCheck string
 	if len(content) > 20_000 {
 		toCheck = string(content)[:20_000]
@@ -177,9 +227,7 @@ func DetermineLanguage(filename string, fallbackLanguage string, possibleLanguag
 
 	toSort := []languageGuess{}
 	for _, lan := range possibleLanguages {
-		LanguageFeaturesMutex.Lock()
-		langFeatures := LanguageFeatures[lan]
-		LanguageFeaturesMutex.Unlock()
+		langFeatures := c.getLanguageFeature(lan)
 
 		count := 0
 		for _, key := range langFeatures.Keywords {
@@ -210,10 +258,6 @@ func DetermineLanguage(filename string, fallbackLanguage string, possibleLanguag
 		return toSort[i].Count > toSort[j].Count
 	})
 
-	//fmt.Println(toSort)
-	//fmt.Println(possibleLanguages)
-	//fmt.Println(primary, toSort[0].Name, toSort[0].Count)
-
 	if primary != "" && len(toSort) != 0 {
 		// OK at this point we have a primary, which means we want 3 or more matches to count as something else
 		if toSort[0].Count < 3 {
@@ -222,14 +266,6 @@ func DetermineLanguage(filename string, fallbackLanguage string, possibleLanguag
 		}
 	}
 
-	if Verbose {
-		printWarn(fmt.Sprintf("guessing language %s for file %s", toSort[0].Name, filename))
-	}
-
-	if Trace {
-		printTrace(fmt.Sprintf("nanoseconds to guess language: %s: %d", filename, makeTimestampNano()-startTime))
-	}
-
 	if len(toSort) != 0 {
 		return toSort[0].Name
 	}
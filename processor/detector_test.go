@@ -313,7 +313,7 @@ func TestScanSheBangFuzz(t *testing.T) {
 func TestCheckFullNameSheBang(t *testing.T) {
 	ProcessConstants()
 
-	r, n := checkFullName("name")
+	r, n := globalCounter().checkFullName("name")
 
 	if n != "name" {
 		t.Error("Expected name to return")
@@ -327,7 +327,7 @@ func TestCheckFullNameSheBang(t *testing.T) {
 func TestCheckFullNameLicense(t *testing.T) {
 	ProcessConstants()
 
-	r, n := checkFullName("license")
+	r, n := globalCounter().checkFullName("license")
 
 	if n != "license" {
 		t.Error("Expected name to return")
@@ -0,0 +1,647 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var tabularDiffBreak = "─────────────────────────────────────────────────────────────────────────────────────────────\n"
+var tabularDiffBreakCi = "---------------------------------------------------------------------------------------------\n"
+var tabularDiffFormatHead = "%-20s %6s %8s %8s %9s %9s %7s %7s %11s\n"
+var tabularDiffFormatBody = "%-20s %6d %8d %8d %9d %9d %7d %7d %+11d\n"
+var tabularDiffFormatFile = "%s %8d %8d %9d %9d %7d %7d %+11d\n"
+var diffFormatFileTruncate = 26
+
+// Status values used in FileDiff to indicate how a file changed between both sides of a diff
+const (
+	DiffAdded    = "added"
+	DiffRemoved  = "removed"
+	DiffModified = "modified"
+)
+
+// FileDiff holds the lines added and removed between two versions of the same file
+// where every line is classified using the same state machine as CountStats
+type FileDiff struct {
+	Language         string
+	Location         string
+	Status           string
+	CodeAdded        int64
+	CodeRemoved      int64
+	CommentAdded     int64
+	CommentRemoved   int64
+	BlankAdded       int64
+	BlankRemoved     int64
+	ComplexityChange int64
+}
+
+// LanguageDiff is used to hold the summarised diff results for a single language
+type LanguageDiff struct {
+	Name             string
+	Count            int64
+	CodeAdded        int64
+	CodeRemoved      int64
+	CommentAdded     int64
+	CommentRemoved   int64
+	BlankAdded       int64
+	BlankRemoved     int64
+	ComplexityChange int64
+	Files            []*FileDiff
+}
+
+// diffLineRecorder is a FileJobCallback which keeps the content and type of every line
+// as it is counted so that two versions of a file can be compared afterwards
+type diffLineRecorder struct {
+	offset int
+	lines  []string
+	types  []LineType
+}
+
+// ProcessLine records the line that CountStats just finished along with its type
+func (r *diffLineRecorder) ProcessLine(job *FileJob, currentLine int64, lineType LineType) bool {
+	line := ""
+	if r.offset < len(job.Content) {
+		end := bytes.IndexByte(job.Content[r.offset:], '\n')
+		if end == -1 {
+			line = string(job.Content[r.offset:])
+			r.offset = len(job.Content)
+		} else {
+			line = string(job.Content[r.offset : r.offset+end])
+			r.offset += end + 1
+		}
+	}
+
+	r.lines = append(r.lines, line)
+	r.types = append(r.types, lineType)
+	return true
+}
+
+// diffSide is a single counted file from one side of the diff
+type diffSide struct {
+	job      *FileJob
+	recorder *diffLineRecorder
+}
+
+// DiffPaths counts both the old and new path, which should both be files or directories,
+// and returns the per language changes between them. Files are matched by their
+// location relative to the path they were found in. Half a diff would report files as added or
+// removed which were only never counted so if the context is done first an error wrapping
+// ErrIncomplete is returned rather than any results.
+func DiffPaths(ctx context.Context, oldPath string, newPath string) ([]LanguageDiff, error) {
+	return globalCounter().diffPaths(ctx, oldPath, newPath)
+}
+
+// diffPaths is DiffPaths counting both paths with the Counter
+func (c *Counter) diffPaths(ctx context.Context, oldPath string, newPath string) ([]LanguageDiff, error) {
+	oldFiles, err := c.countDiffSide(ctx, oldPath)
+	if err != nil {
+		return nil, err
+	}
+
+	newFiles, err := c.countDiffSide(ctx, newPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrIncomplete, err)
+	}
+
+	return diffLanguageSummary(diffFiles(oldFiles, newFiles)), nil
+}
+
+// countDiffSide walks and counts everything in root using the same pipeline Process uses
+// but records every line so they can be compared later
+func (c *Counter) countDiffSide(ctx context.Context, root string) (map[string]diffSide, error) {
+	root = filepath.Clean(root)
+	if _, err := os.Stat(root); err != nil {
+		return nil, err
+	}
+
+	// Each side should only ever be checked against itself for duplicates
+	c.duplicates.reset()
+
+	fileListQueue := make(chan *FileJob, c.Config.FileListQueueSize)
+	recordQueue := make(chan *FileJob, c.Config.FileListQueueSize)
+	fileSummaryJobQueue := make(chan *FileJob, c.Config.FileSummaryJobQueueSize)
+
+	var walkErr error
+	go func() {
+		directoryWalker := c.newDirectoryWalker(ctx, fileListQueue)
+		walkErr = directoryWalker.Start(root)
+		directoryWalker.Run()
+	}()
+
+	go func() {
+		for job := range fileListQueue {
+			job.Callback = &diffLineRecorder{}
+			recordQueue <- job
+		}
+		close(recordQueue)
+	}()
+
+	go c.fileProcessorWorker(ctx, nil, recordQueue, fileSummaryJobQueue)
+
+	files := map[string]diffSide{}
+	for job := range untilDone(ctx, fileSummaryJobQueue) {
+		rel, err := filepath.Rel(root, job.Location)
+		if err != nil || rel == "." {
+			rel = job.Filename
+		}
+
+		files[filepath.ToSlash(rel)] = diffSide{
+			job:      job,
+			recorder: job.Callback.(*diffLineRecorder),
+		}
+	}
+
+	// The walk may still be stuck on a directory which ignores the context so its error cannot be read
+	if ctx.Err() != nil {
+		return files, nil
+	}
+
+	return files, walkErr
+}
+
+// diffFiles compares every file found on either side returning those that changed
+func diffFiles(oldFiles map[string]diffSide, newFiles map[string]diffSide) []*FileDiff {
+	var results []*FileDiff
+
+	for location, newSide := range newFiles {
+		oldSide, ok := oldFiles[location]
+
+		diff := &FileDiff{
+			Language: newSide.job.Language,
+			Location: location,
+			Status:   DiffModified,
+		}
+
+		if !ok {
+			diff.Status = DiffAdded
+			diffLines(diff, &diffLineRecorder{}, newSide.recorder)
+			diff.ComplexityChange = newSide.job.Complexity
+		} else {
+			diffLines(diff, oldSide.recorder, newSide.recorder)
+			diff.ComplexityChange = newSide.job.Complexity - oldSide.job.Complexity
+		}
+
+		if diff.hasChanges() {
+			results = append(results, diff)
+		}
+	}
+
+	for location, oldSide := range oldFiles {
+		if _, ok := newFiles[location]; ok {
+			continue
+		}
+
+		diff := &FileDiff{
+			Language:         oldSide.job.Language,
+			Location:         location,
+			Status:           DiffRemoved,
+			ComplexityChange: -oldSide.job.Complexity,
+		}
+		diffLines(diff, oldSide.recorder, &diffLineRecorder{})
+
+		if diff.hasChanges() {
+			results = append(results, diff)
+		}
+	}
+
+	return results
+}
+
+func (d *FileDiff) hasChanges() bool {
+	return d.CodeAdded != 0 || d.CodeRemoved != 0 ||
+		d.CommentAdded != 0 || d.CommentRemoved != 0 ||
+		d.BlankAdded != 0 || d.BlankRemoved != 0 ||
+		d.ComplexityChange != 0
+}
+
+// diffLines works out which lines were removed from old and added to new and
+// counts them according to the type they were given when counted
+func diffLines(diff *FileDiff, old *diffLineRecorder, new *diffLineRecorder) {
+	removed, added := myersDiff(old.lines, new.lines)
+
+	for _, i := range removed {
+		switch old.types[i] {
+		case LINE_CODE:
+			diff.CodeRemoved++
+		case LINE_COMMENT:
+			diff.CommentRemoved++
+		case LINE_BLANK:
+			diff.BlankRemoved++
+		}
+	}
+
+	for _, i := range added {
+		switch new.types[i] {
+		case LINE_CODE:
+			diff.CodeAdded++
+		case LINE_COMMENT:
+			diff.CommentAdded++
+		case LINE_BLANK:
+			diff.BlankAdded++
+		}
+	}
+}
+
+// myersDiff returns the indexes of lines removed from a and added to b using the linear space
+// variant of the algorithm described in "An O(ND) Difference Algorithm and Its Variations" by Eugene Myers
+func myersDiff(a []string, b []string) ([]int, []int) {
+	d := &lineDiffer{}
+	d.diff(a, b, 0, 0)
+	return d.removed, d.added
+}
+
+type lineDiffer struct {
+	removed []int
+	added   []int
+}
+
+func (d *lineDiffer) diff(a []string, b []string, aOffset int, bOffset int) {
+	// Trim the common prefix and suffix first as most changes are small
+	// which keeps the search space for the actual diff down
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	a, b = a[prefix:], b[prefix:]
+	aOffset += prefix
+	bOffset += prefix
+
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	if len(a) == 0 || len(b) == 0 {
+		for i := range a {
+			d.removed = append(d.removed, aOffset+i)
+		}
+		for i := range b {
+			d.added = append(d.added, bOffset+i)
+		}
+		return
+	}
+
+	d.bisect(a, b, aOffset, bOffset)
+}
+
+// bisect finds the middle snake of the edit graph and splits the problem in two there
+func (d *lineDiffer) bisect(a []string, b []string, aOffset int, bOffset int) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	vOffset := maxD
+	vLength := 2*maxD + 2
+
+	v1 := make([]int, vLength)
+	v2 := make([]int, vLength)
+	for i := range v1 {
+		v1[i] = -1
+		v2[i] = -1
+	}
+	v1[vOffset+1] = 0
+	v2[vOffset+1] = 0
+
+	delta := n - m
+	// If the total number of lines is odd the front path will collide with the reverse path
+	front := delta%2 != 0
+
+	// Offsets for start and end of k loop which prevents mapping of space beyond the grid
+	k1start, k1end, k2start, k2end := 0, 0, 0, 0
+
+	for step := 0; step < maxD; step++ {
+		// Walk the front path one step
+		for k1 := -step + k1start; k1 < step+1-k1end; k1 += 2 {
+			k1Offset := vOffset + k1
+
+			var x1 int
+			if k1 == -step || (k1 != step && v1[k1Offset-1] < v1[k1Offset+1]) {
+				x1 = v1[k1Offset+1]
+			} else {
+				x1 = v1[k1Offset-1] + 1
+			}
+
+			y1 := x1 - k1
+			for x1 < n && y1 < m && a[x1] == b[y1] {
+				x1++
+				y1++
+			}
+			v1[k1Offset] = x1
+
+			if x1 > n {
+				k1end += 2
+			} else if y1 > m {
+				k1start += 2
+			} else if front {
+				k2Offset := vOffset + delta - k1
+				if k2Offset >= 0 && k2Offset < vLength && v2[k2Offset] != -1 {
+					// Mirror x2 onto top-left coordinate system
+					x2 := n - v2[k2Offset]
+					if x1 >= x2 {
+						d.diff(a[:x1], b[:y1], aOffset, bOffset)
+						d.diff(a[x1:], b[y1:], aOffset+x1, bOffset+y1)
+						return
+					}
+				}
+			}
+		}
+
+		// Walk the reverse path one step
+		for k2 := -step + k2start; k2 < step+1-k2end; k2 += 2 {
+			k2Offset := vOffset + k2
+
+			var x2 int
+			if k2 == -step || (k2 != step && v2[k2Offset-1] < v2[k2Offset+1]) {
+				x2 = v2[k2Offset+1]
+			} else {
+				x2 = v2[k2Offset-1] + 1
+			}
+
+			y2 := x2 - k2
+			for x2 < n && y2 < m && a[n-x2-1] == b[m-y2-1] {
+				x2++
+				y2++
+			}
+			v2[k2Offset] = x2
+
+			if x2 > n {
+				k2end += 2
+			} else if y2 > m {
+				k2start += 2
+			} else if !front {
+				k1Offset := vOffset + delta - k2
+				if k1Offset >= 0 && k1Offset < vLength && v1[k1Offset] != -1 {
+					x1 := v1[k1Offset]
+					y1 := vOffset + x1 - k1Offset
+					// Mirror x2 onto top-left coordinate system
+					x2 = n - x2
+					if x1 >= x2 {
+						d.diff(a[:x1], b[:y1], aOffset, bOffset)
+						d.diff(a[x1:], b[y1:], aOffset+x1, bOffset+y1)
+						return
+					}
+				}
+			}
+		}
+	}
+
+	// No overlap found which means nothing in common so everything changed
+	for i := range a {
+		d.removed = append(d.removed, aOffset+i)
+	}
+	for i := range b {
+		d.added = append(d.added, bOffset+i)
+	}
+}
+
+func diffLanguageSummary(files []*FileDiff) []LanguageDiff {
+	languages := map[string]*LanguageDiff{}
+
+	for _, f := range files {
+		summary, ok := languages[f.Language]
+		if !ok {
+			summary = &LanguageDiff{Name: f.Language}
+			languages[f.Language] = summary
+		}
+
+		summary.Count++
+		summary.CodeAdded += f.CodeAdded
+		summary.CodeRemoved += f.CodeRemoved
+		summary.CommentAdded += f.CommentAdded
+		summary.CommentRemoved += f.CommentRemoved
+		summary.BlankAdded += f.BlankAdded
+		summary.BlankRemoved += f.BlankRemoved
+		summary.ComplexityChange += f.ComplexityChange
+		summary.Files = append(summary.Files, f)
+	}
+
+	language := []LanguageDiff{}
+	for _, summary := range languages {
+		sort.Slice(summary.Files, func(i, j int) bool {
+			return strings.Compare(summary.Files[i].Location, summary.Files[j].Location) < 0
+		})
+		language = append(language, *summary)
+	}
+
+	sort.Slice(language, func(i, j int) bool {
+		ci := language[i].CodeAdded + language[i].CodeRemoved
+		cj := language[j].CodeAdded + language[j].CodeRemoved
+		if ci == cj {
+			return strings.Compare(language[i].Name, language[j].Name) < 0
+		}
+
+		return ci > cj
+	})
+
+	return language
+}
+
+// resolveDiffPath returns a path on disk for the supplied argument. If it does not exist
+// on disk it is treated as a git revision which is exported into a temporary directory
+// that the returned cleanup function removes
+func resolveDiffPath(ctx context.Context, path string) (string, func(), error) {
+	if _, err := os.Stat(path); err == nil {
+		return path, func() {}, nil
+	}
+
+	dir, err := os.MkdirTemp("", "scc-diff-")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() { _ = os.RemoveAll(dir) }
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", "archive", "--format=tar", path)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("%s is not a file, directory or git revision: %s", path, strings.TrimSpace(stderr.String()))
+	}
+
+	if err := extractTar(bytes.NewReader(out), dir); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to extract git revision %s: %v", path, err)
+	}
+
+	return dir, cleanup, nil
+}
+
+func extractTar(r io.Reader, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return errors.New("invalid path in archive: " + header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			_ = f.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func getTabularDiffBreak() string {
+	if Ci {
+		return tabularDiffBreakCi
+	}
+
+	return tabularDiffBreak
+}
+
+func diffToJSON(language []LanguageDiff) string {
+	if !Files {
+		for i := range language {
+			language[i].Files = nil
+		}
+	}
+
+	jsonString, _ := json.Marshal(language)
+	return string(jsonString)
+}
+
+// validateDiffFormat checks --format is one --diff can write
+func validateDiffFormat() error {
+	switch strings.ToLower(Format) {
+	case "", "tabular", "json":
+		return nil
+	}
+
+	return fmt.Errorf("unknown format %q for --diff expected one of [tabular, json]", Format)
+}
+
+func diffSummarize(language []LanguageDiff) string {
+	if strings.ToLower(Format) == "json" {
+		return diffToJSON(language)
+	}
+
+	var str strings.Builder
+
+	str.WriteString(getTabularDiffBreak())
+	str.WriteString(fmt.Sprintf(tabularDiffFormatHead, "Language", "Files", "Code +", "Code -", "Comment +", "Comment -", "Blank +", "Blank -", "Complexity"))
+
+	if !Files {
+		str.WriteString(getTabularDiffBreak())
+	}
+
+	var sum LanguageDiff
+	for _, summary := range language {
+		sum.Count += summary.Count
+		sum.CodeAdded += summary.CodeAdded
+		sum.CodeRemoved += summary.CodeRemoved
+		sum.CommentAdded += summary.CommentAdded
+		sum.CommentRemoved += summary.CommentRemoved
+		sum.BlankAdded += summary.BlankAdded
+		sum.BlankRemoved += summary.BlankRemoved
+		sum.ComplexityChange += summary.ComplexityChange
+
+		if Files {
+			str.WriteString(getTabularDiffBreak())
+		}
+
+		trimmedName := summary.Name
+		if len(summary.Name) > shortNameTruncate {
+			trimmedName = summary.Name[:shortNameTruncate-1] + "…"
+		}
+
+		str.WriteString(fmt.Sprintf(tabularDiffFormatBody, trimmedName, summary.Count, summary.CodeAdded, summary.CodeRemoved, summary.CommentAdded, summary.CommentRemoved, summary.BlankAdded, summary.BlankRemoved, summary.ComplexityChange))
+
+		if Files {
+			str.WriteString(getTabularDiffBreak())
+
+			for _, res := range summary.Files {
+				tmp := unicodeAwareTrim(res.Location, diffFormatFileTruncate)
+				tmp = unicodeAwareRightPad(tmp, 27)
+
+				str.WriteString(fmt.Sprintf(tabularDiffFormatFile, tmp, res.CodeAdded, res.CodeRemoved, res.CommentAdded, res.CommentRemoved, res.BlankAdded, res.BlankRemoved, res.ComplexityChange))
+			}
+		}
+	}
+
+	str.WriteString(getTabularDiffBreak())
+	str.WriteString(fmt.Sprintf(tabularDiffFormatBody, "Total", sum.Count, sum.CodeAdded, sum.CodeRemoved, sum.CommentAdded, sum.CommentRemoved, sum.BlankAdded, sum.BlankRemoved, sum.ComplexityChange))
+	str.WriteString(getTabularDiffBreak())
+
+	return str.String()
+}
+
+// processDiff is the entry point for --diff which compares exactly two paths or git revisions
+func processDiff(ctx context.Context, counter *Counter) {
+	if len(DirFilePaths) != 2 {
+		fmt.Println("diff requires exactly two files, directories or git revisions")
+		os.Exit(1)
+	}
+
+	var paths []string
+	var cleanups []func()
+	cleanup := func() {
+		for _, c := range cleanups {
+			c()
+		}
+	}
+
+	for _, f := range DirFilePaths {
+		path, c, err := resolveDiffPath(ctx, f)
+		if err != nil {
+			cleanup()
+			fmt.Println(err.Error())
+			os.Exit(1)
+		}
+		cleanups = append(cleanups, c)
+		paths = append(paths, path)
+	}
+
+	language, err := counter.diffPaths(ctx, paths[0], paths[1])
+	cleanup()
+	if err != nil {
+		fmt.Printf("failed to diff %s: %v\n", strings.Join(DirFilePaths, " "), err)
+		os.Exit(1)
+	}
+
+	result := diffSummarize(language)
+
+	if FileOutput == "" {
+		fmt.Println(result)
+	} else {
+		_ = os.WriteFile(FileOutput, []byte(result), 0644)
+		fmt.Println("results written to " + FileOutput)
+	}
+}
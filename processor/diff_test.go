@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMyersDiffIdentical(t *testing.T) {
+	removed, added := myersDiff([]string{"a", "b", "c"}, []string{"a", "b", "c"})
+
+	if len(removed) != 0 || len(added) != 0 {
+		t.Errorf("expected no changes got removed %v added %v", removed, added)
+	}
+}
+
+func TestMyersDiffEmpty(t *testing.T) {
+	removed, added := myersDiff([]string{}, []string{"a", "b"})
+
+	if len(removed) != 0 || len(added) != 2 {
+		t.Errorf("expected 2 added got removed %v added %v", removed, added)
+	}
+
+	removed, added = myersDiff([]string{"a", "b"}, []string{})
+
+	if len(removed) != 2 || len(added) != 0 {
+		t.Errorf("expected 2 removed got removed %v added %v", removed, added)
+	}
+}
+
+func TestMyersDiffChanges(t *testing.T) {
+	a := []string{"a", "b", "c", "a", "b", "b", "a"}
+	b := []string{"c", "b", "a", "b", "a", "c"}
+
+	removed, added := myersDiff(a, b)
+
+	// The shortest edit script for the example in the Myers paper is 5
+	if len(removed)+len(added) != 5 {
+		t.Errorf("expected 5 edits got removed %v added %v", removed, added)
+	}
+
+	// Applying the edits must turn a into b
+	isRemoved := map[int]bool{}
+	for _, i := range removed {
+		isRemoved[i] = true
+	}
+	isAdded := map[int]bool{}
+	for _, i := range added {
+		isAdded[i] = true
+	}
+
+	var keptA, keptB []string
+	for i, v := range a {
+		if !isRemoved[i] {
+			keptA = append(keptA, v)
+		}
+	}
+	for i, v := range b {
+		if !isAdded[i] {
+			keptB = append(keptB, v)
+		}
+	}
+
+	if strings.Join(keptA, "") != strings.Join(keptB, "") {
+		t.Errorf("expected common lines to match got %v and %v", keptA, keptB)
+	}
+}
+
+func TestDiffLineRecorder(t *testing.T) {
+	ProcessConstants()
+
+	recorder := &diffLineRecorder{}
+	fileJob := FileJob{
+		Language: "Go",
+		Callback: recorder,
+	}
+	fileJob.SetContent("package main\n\n// comment\nfunc main() {}")
+
+	CountStats(&fileJob)
+
+	expectedLines := []string{"package main", "", "// comment", "func main() {}"}
+	expectedTypes := []LineType{LINE_CODE, LINE_BLANK, LINE_COMMENT, LINE_CODE}
+
+	if len(recorder.lines) != len(expectedLines) {
+		t.Fatalf("expected %d lines got %d", len(expectedLines), len(recorder.lines))
+	}
+
+	for i := range expectedLines {
+		if recorder.lines[i] != expectedLines[i] {
+			t.Errorf("line %d expected %q got %q", i, expectedLines[i], recorder.lines[i])
+		}
+		if recorder.types[i] != expectedTypes[i] {
+			t.Errorf("line %d expected type %d got %d", i, expectedTypes[i], recorder.types[i])
+		}
+	}
+}
+
+func TestDiffPaths(t *testing.T) {
+	ProcessConstants()
+	Duplicates = false
+
+	language, err := DiffPaths(context.Background(), "../examples/diff/old/", "../examples/diff/new/")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(language) != 2 {
+		t.Fatalf("expected 2 languages got %d", len(language))
+	}
+
+	for _, l := range language {
+		switch l.Name {
+		case "Go":
+			if l.Count != 1 || l.CodeAdded != 4 || l.CodeRemoved != 1 || l.CommentAdded != 1 || l.BlankAdded != 1 || l.BlankRemoved != 0 {
+				t.Errorf("unexpected Go diff %+v", l)
+			}
+			if l.ComplexityChange <= 0 {
+				t.Errorf("expected Go complexity to increase got %d", l.ComplexityChange)
+			}
+		case "Python":
+			if l.Count != 2 || l.CodeAdded != 2 || l.CodeRemoved != 1 || l.CommentAdded != 1 || l.CommentRemoved != 1 || l.BlankAdded != 1 {
+				t.Errorf("unexpected Python diff %+v", l)
+			}
+
+			for _, f := range l.Files {
+				switch f.Location {
+				case "added.py":
+					if f.Status != DiffAdded {
+						t.Errorf("expected added.py to be added got %s", f.Status)
+					}
+				case "removed.py":
+					if f.Status != DiffRemoved {
+						t.Errorf("expected removed.py to be removed got %s", f.Status)
+					}
+				default:
+					t.Errorf("unexpected file in diff %s", f.Location)
+				}
+			}
+		default:
+			t.Errorf("unexpected language %s", l.Name)
+		}
+	}
+}
+
+func TestDiffPathsMissing(t *testing.T) {
+	ProcessConstants()
+
+	_, err := DiffPaths(context.Background(), "../examples/diff/doesnotexist/", "../examples/diff/new/")
+	if err == nil {
+		t.Error("expected error for missing path")
+	}
+}
+
+func TestDiffPathsCancelled(t *testing.T) {
+	ProcessConstants()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	language, err := DiffPaths(ctx, "../examples/diff/old/", "../examples/diff/new/")
+	if !errors.Is(err, ErrIncomplete) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected incomplete cancelled error got %v", err)
+	}
+	if language != nil {
+		t.Errorf("expected no partial diff got %v", language)
+	}
+}
+
+func TestDiffSummarize(t *testing.T) {
+	ProcessConstants()
+	Files = true
+	Format = ""
+
+	language, _ := DiffPaths(context.Background(), "../examples/diff/old/", "../examples/diff/new/")
+	res := diffSummarize(language)
+
+	if !strings.Contains(res, "main.go") || !strings.Contains(res, "Total") {
+		t.Errorf("expected file and total rows got %s", res)
+	}
+
+	Format = "json"
+	res = diffSummarize(language)
+	if !strings.HasPrefix(res, "[{") || !strings.Contains(res, `"Status":"added"`) {
+		t.Errorf("expected json output got %s", res)
+	}
+
+	Files = false
+	Format = ""
+}
+
+func TestValidateDiffFormat(t *testing.T) {
+	defer func() {
+		Format = ""
+	}()
+
+	for _, f := range []string{"", "tabular", "JSON"} {
+		Format = f
+		if err := validateDiffFormat(); err != nil {
+			t.Errorf("expected %s to be valid got %v", f, err)
+		}
+	}
+
+	for _, f := range []string{"csv", "markdown", "wide"} {
+		Format = f
+		if err := validateDiffFormat(); err == nil || !strings.Contains(err.Error(), "[tabular, json]") {
+			t.Errorf("expected %s to be invalid listing the supported formats got %v", f, err)
+		}
+	}
+}
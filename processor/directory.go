@@ -0,0 +1,556 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"path/filepath"
+	"strings"
+)
+
+var tabularDirectoryFormatBody = "%s %9d %9d %8d %9d %8d %10d\n"
+var tabularDirectoryFormatBodyNoComplexity = "%s %11d %11d %10d %11d %9d\n"
+var tabularDirectoryWideFormatBody = "%s %9d %9d %8d %9d %8d %10d %9d %16.2f\n"
+var openMetricsDirectoryRecordFormat = "scc_%s{directory=\"%s\",language=\"%s\"} %d\n"
+
+var directoryFormatterRegistry = map[string]Formatter{}
+
+// registerDirectoryFormatter makes a formatter available to --format and --format-multi in place of the
+// one with the same name when --by-dir is set
+func registerDirectoryFormatter(name string, formatter Formatter) {
+	directoryFormatterRegistry[name] = formatter
+}
+
+func init() {
+	registerDirectoryFormatter("tabular", FormatterFunc(toDirectoryTabular))
+	registerDirectoryFormatter("wide", FormatterFunc(toDirectoryWide))
+	registerDirectoryFormatter("json", FormatterFunc(toDirectoryJSON))
+	registerDirectoryFormatter("json2", FormatterFunc(toDirectoryJSONReport))
+	registerDirectoryFormatter("csv", FormatterFunc(toDirectoryCSV))
+	registerDirectoryFormatter("markdown", FormatterFunc(toDirectoryMarkdown))
+	registerDirectoryFormatter("cloc-yaml", FormatterFunc(toDirectoryClocYAML))
+	registerDirectoryFormatter("html", FormatterFunc(toDirectoryHtml))
+	registerDirectoryFormatter("html-table", FormatterFunc(toDirectoryHtmlTable))
+	registerDirectoryFormatter("sql", FormatterFunc(toDirectorySql))
+	registerDirectoryFormatter("sql-insert", FormatterFunc(toDirectorySqlInsert))
+	registerDirectoryFormatter("openmetrics", FormatterFunc(toDirectoryOpenMetrics))
+}
+
+// DirectorySummary holds the counts of every file in a directory, including those in directories below it
+// past --dir-depth, along with the counts for each language in it where Count is the number of files
+type DirectorySummary struct {
+	Name                string
+	Bytes               int64
+	Lines               int64
+	Code                int64
+	Comment             int64
+	Blank               int64
+	Complexity          int64
+	Count               int64
+	WeightedComplexity  float64
+	CognitiveComplexity int64
+	Languages           []LanguageSummary
+}
+
+// directoryAggregator rolls files up to the directory --dir-depth below the path they were found under
+// keeping the totals for each language in every directory as well as over all of them
+type directoryAggregator struct {
+	depth       int
+	roots       []string
+	directories map[string]*languageAggregator
+	languages   *languageAggregator
+}
+
+func newDirectoryAggregator(depth int, roots []string) *directoryAggregator {
+	return &directoryAggregator{
+		depth:       depth,
+		roots:       roots,
+		directories: map[string]*languageAggregator{},
+		languages:   newLanguageAggregator(false, false),
+	}
+}
+
+// directory is the path of the directory the file is rolled up to which is the path it was found under
+// followed by at most depth of the directories between that and the file
+func (a *directoryAggregator) directory(location string) string {
+	root, rel := a.root(filepath.Clean(filepath.Dir(location)))
+
+	parts := []string{root}
+	for _, part := range strings.Split(filepath.ToSlash(rel), "/") {
+		if len(parts) > a.depth {
+			break
+		}
+		if part != "" && part != "." {
+			parts = append(parts, part)
+		}
+	}
+
+	if name := filepath.ToSlash(filepath.Join(parts...)); name != "" {
+		return name
+	}
+	return "."
+}
+
+// root splits the directory into the path it was found under and the rest of it. The longest matching
+// path wins as the paths can be nested inside each other.
+func (a *directoryAggregator) root(dir string) (string, string) {
+	root, rel := "", dir
+	for _, r := range a.roots {
+		r = filepath.Clean(r)
+
+		prefix := r
+		if !strings.HasSuffix(prefix, string(filepath.Separator)) {
+			prefix += string(filepath.Separator)
+		}
+
+		rest, matched := "", false
+		switch {
+		case r == ".":
+			rest, matched = dir, !filepath.IsAbs(dir) && dir != ".." && !strings.HasPrefix(dir, ".."+string(filepath.Separator))
+		case dir == r:
+			matched = true
+		case strings.HasPrefix(dir, prefix):
+			rest, matched = dir[len(prefix):], true
+		}
+
+		if matched && len(r) > len(root) {
+			root, rel = r, rest
+		}
+	}
+
+	return root, rel
+}
+
+func (a *directoryAggregator) add(res *FileJob) {
+	name := a.directory(res.Location)
+
+	directory, ok := a.directories[name]
+	if !ok {
+		directory = newLanguageAggregator(false, false)
+		a.directories[name] = directory
+	}
+
+	directory.add(res)
+	a.languages.add(res)
+}
+
+// consume adds every file from the input returning the aggregator once the input is closed
+func (a *directoryAggregator) consume(input chan *FileJob) *directoryAggregator {
+	for res := range input {
+		a.add(res)
+	}
+
+	return a
+}
+
+// summaries returns every directory and the languages in each sorted by --sort
+func (a *directoryAggregator) summaries() []DirectorySummary {
+	var totals []LanguageSummary
+	for name, directory := range a.directories {
+		total := directory.total
+		total.Name = name
+		totals = append(totals, total)
+	}
+
+	directories := []DirectorySummary{}
+	for _, total := range sortLanguageSummary(totals) {
+		directories = append(directories, DirectorySummary{
+			Name:                total.Name,
+			Bytes:               total.Bytes,
+			Lines:               total.Lines,
+			Code:                total.Code,
+			Comment:             total.Comment,
+			Blank:               total.Blank,
+			Complexity:          total.Complexity,
+			Count:               total.Count,
+			WeightedComplexity:  total.WeightedComplexity,
+			CognitiveComplexity: total.CognitiveComplexity,
+			Languages:           sortLanguageSummary(a.directories[total.Name].summaries()),
+		})
+	}
+
+	return directories
+}
+
+// summary returns the counts of the directory as a LanguageSummary so they can be written the same way
+func (d DirectorySummary) summary() LanguageSummary {
+	return LanguageSummary{
+		Name:                d.Name,
+		Bytes:               d.Bytes,
+		Lines:               d.Lines,
+		Code:                d.Code,
+		Comment:             d.Comment,
+		Blank:               d.Blank,
+		Complexity:          d.Complexity,
+		Count:               d.Count,
+		WeightedComplexity:  d.WeightedComplexity,
+		CognitiveComplexity: d.CognitiveComplexity,
+	}
+}
+
+func aggregateDirectorySummary(input chan *FileJob) *directoryAggregator {
+	return newDirectoryAggregator(DirDepth, DirFilePaths).consume(input)
+}
+
+func toDirectoryTabular(input chan *FileJob) string {
+	return directorySummarizeTabular(input, false)
+}
+
+func toDirectoryWide(input chan *FileJob) string {
+	return directorySummarizeTabular(input, true)
+}
+
+// directorySummarizeTabular lists each directory followed by the languages in it in the same columns as
+// tabular or wide
+func directorySummarizeTabular(input chan *FileJob, wide bool) string {
+	startTime := makeTimestampMilli()
+	aggregate := aggregateDirectorySummary(input)
+	directories := aggregate.summaries()
+
+	lineBreak := getTabularShortBreak()
+	if wide {
+		lineBreak = getTabularWideBreak()
+	}
+
+	width := shortNameTruncate
+	switch {
+	case wide:
+		width = 33
+	case Complexity:
+		width = longNameTruncate
+	}
+
+	// Languages are indented by two so are checked against the width less that
+	extra := 0
+	for _, directory := range directories {
+		extra = max(extra, nameColumnOverflow(directory.Languages, width-2))
+	}
+	width += extra
+	lineBreak = widenBreak(lineBreak, extra)
+
+	var str strings.Builder
+	row := func(name string, summary LanguageSummary) {
+		name = unicodeAwareRightPad(name, width)
+		switch {
+		case wide:
+			str.WriteString(fmt.Sprintf(tabularDirectoryWideFormatBody, name, summary.Count, summary.Lines, summary.Blank, summary.Comment, summary.Code, summary.Complexity, summary.CognitiveComplexity, summary.WeightedComplexity))
+		case Complexity:
+			str.WriteString(fmt.Sprintf(tabularDirectoryFormatBodyNoComplexity, name, summary.Count, summary.Lines, summary.Blank, summary.Comment, summary.Code))
+		default:
+			str.WriteString(fmt.Sprintf(tabularDirectoryFormatBody, name, summary.Count, summary.Lines, summary.Blank, summary.Comment, summary.Code, summary.Complexity))
+		}
+	}
+
+	str.WriteString(lineBreak)
+	switch {
+	case wide:
+		str.WriteString(fmt.Sprintf(widenFormat(tabularWideFormatHead, extra), "Directory", "Files", "Lines", "Blanks", "Comments", "Code", "Complexity", "Cognitive", "Complexity/Lines"))
+	case Complexity:
+		str.WriteString(fmt.Sprintf(widenFormat(tabularShortFormatHeadNoComplexity, extra), "Directory", "Files", "Lines", "Blanks", "Comments", "Code"))
+	default:
+		str.WriteString(fmt.Sprintf(widenFormat(tabularShortFormatHead, extra), "Directory", "Files", "Lines", "Blanks", "Comments", "Code", "Complexity"))
+	}
+
+	for _, directory := range directories {
+		str.WriteString(lineBreak)
+		// The end of the path is kept as it is what tells the directories apart
+		row(unicodeAwareTrim(directory.Name, width), directory.summary())
+		for _, language := range directory.Languages {
+			// Languages are indented under the directory they are in
+			name := language.Name
+			if r := []rune(name); len(r) > width-2 && !hasLanguageSuffix(name) {
+				name = string(r[:width-3]) + "…"
+			}
+			row("  "+name, language)
+		}
+	}
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
+	}
+
+	total := aggregate.languages.total
+	str.WriteString(lineBreak)
+	row("Total", total)
+	str.WriteString(lineBreak)
+
+	if !Cocomo {
+		if SLOCCountFormat {
+			calculateCocomoSLOCCount(total.Code, &str)
+		} else {
+			calculateCocomo(total.Code, &str)
+		}
+		str.WriteString(lineBreak)
+	}
+	if !Size {
+		calculateSize(total.Bytes, &str)
+		str.WriteString(lineBreak)
+	}
+
+	return str.String()
+}
+
+func toDirectoryJSON(input chan *FileJob) string {
+	startTime := makeTimestampMilli()
+	jsonString, _ := json.Marshal(aggregateDirectorySummary(input).summaries())
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
+	}
+
+	return string(jsonString)
+}
+
+// toDirectoryCSV has a record for every language in each directory so the directories can be totalled by
+// grouping on the first column
+func toDirectoryCSV(input chan *FileJob) string {
+	records := [][]string{{"Directory", "Language", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity", "Bytes"}}
+
+	for _, directory := range aggregateDirectorySummary(input).summaries() {
+		for _, language := range directory.Languages {
+			records = append(records, []string{
+				directory.Name,
+				language.Name,
+				fmt.Sprint(language.Count),
+				fmt.Sprint(language.Lines),
+				fmt.Sprint(language.Code),
+				fmt.Sprint(language.Comment),
+				fmt.Sprint(language.Blank),
+				fmt.Sprint(language.Complexity),
+				fmt.Sprint(language.Bytes),
+			})
+		}
+	}
+
+	b := &strings.Builder{}
+	w := csv.NewWriter(b)
+	_ = w.WriteAll(records)
+	w.Flush()
+
+	return b.String()
+}
+
+func toDirectoryMarkdown(input chan *FileJob) string {
+	var str strings.Builder
+
+	columns := []string{"Directory", "Files", "Lines", "Blanks", "Comments", "Code"}
+	if !Complexity {
+		columns = append(columns, "Complexity")
+	}
+
+	row := func(name string, res LanguageSummary) {
+		cells := []string{name, fmt.Sprint(res.Count), fmt.Sprint(res.Lines), fmt.Sprint(res.Blank), fmt.Sprint(res.Comment), fmt.Sprint(res.Code)}
+		if !Complexity {
+			cells = append(cells, fmt.Sprint(res.Complexity))
+		}
+		markdownRow(&str, cells...)
+	}
+
+	aggregate := aggregateDirectorySummary(input)
+
+	markdownHeader(&str, 1, columns...)
+	for _, directory := range aggregate.summaries() {
+		// Directories stand out from the languages listed under them
+		row("**"+markdownEscape(directory.Name)+"**", directory.summary())
+		for _, language := range directory.Languages {
+			row(markdownEscape(language.Name), language)
+		}
+	}
+	row("**Total**", aggregate.languages.total)
+
+	markdownEstimates(&str, aggregate.languages.total)
+
+	return strings.TrimRight(str.String(), "\n")
+}
+
+// toDirectoryJSONReport is json2 with the directories added alongside the languages
+func toDirectoryJSONReport(input chan *FileJob) string {
+	aggregate := aggregateDirectorySummary(input)
+
+	report := buildJSONReport(aggregate.languages)
+	report.Directories = []jsonReportDirectory{}
+	for _, directory := range aggregate.summaries() {
+		d := jsonReportDirectory{
+			Name:             directory.Name,
+			jsonReportCounts: reportCounts(directory.summary()),
+			Languages:        []jsonReportLanguage{},
+		}
+		for _, language := range directory.Languages {
+			d.Languages = append(d.Languages, jsonReportLanguage{Name: language.Name, jsonReportCounts: reportCounts(language)})
+		}
+		report.Directories = append(report.Directories, d)
+	}
+
+	jsonString, _ := json.Marshal(report)
+	return string(jsonString)
+}
+
+// directorySummaryCloc is a directory in cloc-yaml with the languages in it
+type directorySummaryCloc struct {
+	Name      string                         `yaml:"name"`
+	Code      int64                          `yaml:"code"`
+	Comment   int64                          `yaml:"comment"`
+	Blank     int64                          `yaml:"blank"`
+	Count     int64                          `yaml:"nFiles"`
+	Languages map[string]languageSummaryCloc `yaml:"languages"`
+}
+
+// toDirectoryClocYAML is cloc-yaml keyed by directory in place of language
+func toDirectoryClocYAML(input chan *FileJob) string {
+	startTime := makeTimestampMilli()
+	aggregate := aggregateDirectorySummary(input)
+
+	directories := map[string]directorySummaryCloc{}
+	for _, directory := range aggregate.summaries() {
+		languages := map[string]languageSummaryCloc{}
+		for _, language := range directory.Languages {
+			languages[language.Name] = clocSummary(language)
+		}
+
+		directories[directory.Name] = directorySummaryCloc{
+			Name:      directory.Name,
+			Code:      directory.Code,
+			Comment:   directory.Comment,
+			Blank:     directory.Blank,
+			Count:     directory.Count,
+			Languages: languages,
+		}
+	}
+
+	yamlString := clocYAML(directories, aggregate.languages.total)
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
+	}
+
+	return yamlString
+}
+
+// toDirectoryHtmlTable is html-table with each directory followed by the languages in it
+func toDirectoryHtmlTable(input chan *FileJob) string {
+	aggregate := aggregateDirectorySummary(input)
+
+	var str strings.Builder
+
+	str.WriteString(`<table id="scc-table">
+	<thead><tr>
+		<th>Directory</th>
+		<th>Files</th>
+		<th>Lines</th>
+		<th>Blank</th>
+		<th>Comment</th>
+		<th>Code</th>
+		<th>Complexity</th>
+		<th>Bytes</th>
+	</tr></thead>
+	<tbody>`)
+
+	for _, directory := range aggregate.summaries() {
+		str.WriteString(fmt.Sprintf(`<tr>
+		<th>%s</th>
+		<th>%d</th>
+		<th>%d</th>
+		<th>%d</th>
+		<th>%d</th>
+		<th>%d</th>
+		<th>%d</th>
+		<th>%d</th>
+	</tr>`, html.EscapeString(directory.Name), directory.Count, directory.Lines, directory.Blank, directory.Comment, directory.Code, directory.Complexity, directory.Bytes))
+
+		for _, language := range directory.Languages {
+			str.WriteString(fmt.Sprintf(`<tr>
+		<td>%s</td>
+		<td>%d</td>
+		<td>%d</td>
+		<td>%d</td>
+		<td>%d</td>
+		<td>%d</td>
+		<td>%d</td>
+		<td>%d</td>
+	</tr>`, html.EscapeString(language.Name), language.Count, language.Lines, language.Blank, language.Comment, language.Code, language.Complexity, language.Bytes))
+		}
+	}
+
+	total := aggregate.languages.total
+	str.WriteString(fmt.Sprintf(`</tbody>
+	<tfoot><tr>
+		<th>Total</th>
+		<th>%d</th>
+		<th>%d</th>
+		<th>%d</th>
+		<th>%d</th>
+		<th>%d</th>
+		<th>%d</th>
+		<th>%d</th>
+	</tr></tfoot>
+	</table>`, total.Count, total.Lines, total.Blank, total.Comment, total.Code, total.Complexity, total.Bytes))
+
+	return str.String()
+}
+
+// toDirectorySql creates a table d with a row for every language in each directory in place of the
+// table t of files
+func toDirectorySql(input chan *FileJob) string {
+	var str strings.Builder
+
+	str.WriteString(`create table metadata (   -- github.com/boyter/scc v ` + Version + `
+             timestamp text,
+             Project   text,
+             elapsed_s real);
+create table d        (
+             Project       text   ,
+             Directory     text   ,
+             Language      text   ,
+             nFile         integer,
+             nByte         integer,
+             nBlank        integer,
+             nComment      integer,
+             nCode         integer,
+             nComplexity   integer,
+             nCognitive    integer   );`)
+
+	str.WriteString(toDirectorySqlInsert(input))
+	return str.String()
+}
+
+func toDirectorySqlInsert(input chan *FileJob) string {
+	var str strings.Builder
+	projectName := sqlProjectName()
+
+	str.WriteString("\nbegin transaction;")
+	for _, directory := range aggregateDirectorySummary(input).summaries() {
+		for _, language := range directory.Languages {
+			str.WriteString(fmt.Sprintf("\ninsert into d values('%s', '%s', '%s', %d, %d, %d, %d, %d, %d, %d);",
+				projectName, directory.Name, language.Name, language.Count, language.Bytes, language.Blank, language.Comment, language.Code, language.Complexity, language.CognitiveComplexity))
+		}
+	}
+	str.WriteString("\ncommit;")
+
+	writeSqlMetadata(&str, projectName)
+
+	return str.String()
+}
+
+// toDirectoryOpenMetrics labels the counts of every language with the directory it is in
+func toDirectoryOpenMetrics(input chan *FileJob) string {
+	var sb strings.Builder
+	sb.WriteString(openMetricsMetadata)
+	for _, directory := range aggregateDirectorySummary(input).summaries() {
+		name := strings.ReplaceAll(directory.Name, "\\", "\\\\")
+		for _, result := range directory.Languages {
+			sb.WriteString(fmt.Sprintf(openMetricsDirectoryRecordFormat, "files", name, result.Name, result.Count))
+			sb.WriteString(fmt.Sprintf(openMetricsDirectoryRecordFormat, "lines", name, result.Name, result.Lines))
+			sb.WriteString(fmt.Sprintf(openMetricsDirectoryRecordFormat, "code", name, result.Name, result.Code))
+			sb.WriteString(fmt.Sprintf(openMetricsDirectoryRecordFormat, "comments", name, result.Name, result.Comment))
+			sb.WriteString(fmt.Sprintf(openMetricsDirectoryRecordFormat, "blanks", name, result.Name, result.Blank))
+			sb.WriteString(fmt.Sprintf(openMetricsDirectoryRecordFormat, "complexity", name, result.Name, result.Complexity))
+			sb.WriteString(fmt.Sprintf(openMetricsDirectoryRecordFormat, "cognitive_complexity", name, result.Name, result.CognitiveComplexity))
+			sb.WriteString(fmt.Sprintf(openMetricsDirectoryRecordFormat, "bytes", name, result.Name, result.Bytes))
+		}
+	}
+	sb.WriteString("# EOF")
+	return sb.String()
+}
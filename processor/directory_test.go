@@ -0,0 +1,328 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"strings"
+	"testing"
+)
+
+func directoryInput() chan *FileJob {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", Filename: "main.go", Bytes: 100, Lines: 10, Code: 8, Comment: 1, Blank: 1, Complexity: 2}
+	inputChan <- &FileJob{Language: "Go", Location: "cmd/app/main.go", Filename: "main.go", Bytes: 50, Lines: 5, Code: 4, Blank: 1, Complexity: 1}
+	inputChan <- &FileJob{Language: "Go", Location: "cmd/app/deep/er/util.go", Filename: "util.go", Bytes: 30, Lines: 3, Code: 3}
+	inputChan <- &FileJob{Language: "Python", Location: "cmd/app/run.py", Filename: "run.py", Bytes: 20, Lines: 2, Code: 1, Comment: 1}
+	inputChan <- &FileJob{Language: "Python", Location: "cmd/tool.py", Filename: "tool.py", Bytes: 10, Lines: 1, Code: 1}
+	close(inputChan)
+	return inputChan
+}
+
+func resetDirectory() {
+	resetMarkdown()
+	ByDir = false
+	DirDepth = 1
+	DirFilePaths = []string{}
+}
+
+func findDirectory(directories []DirectorySummary, name string) DirectorySummary {
+	for _, d := range directories {
+		if d.Name == name {
+			return d
+		}
+	}
+	return DirectorySummary{}
+}
+
+func TestDirectoryAggregatorDirectory(t *testing.T) {
+	tests := []struct {
+		depth    int
+		roots    []string
+		location string
+		want     string
+	}{
+		{1, []string{"."}, "main.go", "."},
+		{1, []string{"."}, "./cmd/app/main.go", "cmd"},
+		{2, []string{"."}, "cmd/app/deep/main.go", "cmd/app"},
+		{2, []string{"."}, "cmd/main.go", "cmd"},
+		{1, []string{"src"}, "src/main.go", "src"},
+		{1, []string{"src"}, "src/cmd/app/main.go", "src/cmd"},
+		{1, []string{"/repo"}, "/repo/cmd/app/main.go", "/repo/cmd"},
+		{1, []string{"/repo/"}, "/repo/cmd/main.go", "/repo/cmd"},
+		{1, []string{".", "cmd/app"}, "cmd/app/deep/main.go", "cmd/app/deep"},
+		{1, []string{"main.go"}, "main.go", "."},
+	}
+
+	for _, test := range tests {
+		if got := newDirectoryAggregator(test.depth, test.roots).directory(test.location); got != test.want {
+			t.Errorf("depth %d roots %v location %s expected %s got %s", test.depth, test.roots, test.location, test.want, got)
+		}
+	}
+}
+
+func TestDirectoryAggregatorSummaries(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+
+	aggregate := newDirectoryAggregator(2, []string{"."}).consume(directoryInput())
+	directories := aggregate.summaries()
+
+	if len(directories) != 3 {
+		t.Fatalf("expected 3 directories got %v", directories)
+	}
+
+	// Everything deeper than the depth is rolled up into cmd/app
+	app := findDirectory(directories, "cmd/app")
+	if app.Count != 3 || app.Lines != 10 || app.Code != 8 || app.Comment != 1 || app.Blank != 1 || app.Complexity != 1 || app.Bytes != 100 {
+		t.Errorf("unexpected counts for cmd/app %v", app)
+	}
+	if len(app.Languages) != 2 || app.Languages[0].Name != "Go" || app.Languages[0].Count != 2 || app.Languages[1].Name != "Python" {
+		t.Errorf("unexpected languages for cmd/app %v", app.Languages)
+	}
+
+	if directories[0].Name != "cmd/app" {
+		t.Errorf("expected the directory with the most files first got %s", directories[0].Name)
+	}
+
+	if aggregate.languages.total.Count != 5 || aggregate.languages.total.Code != 17 {
+		t.Errorf("unexpected total %v", aggregate.languages.total)
+	}
+}
+
+func TestToDirectoryTabular(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	ByDir = true
+	Cocomo = true
+	Size = true
+	DirFilePaths = []string{"."}
+
+	res := toDirectoryTabular(directoryInput())
+
+	for _, s := range []string{
+		"Directory                Files     Lines   Blanks  Comments     Code Complexity\n",
+		"cmd                          4        11        1         1        9          1\n",
+		"  Python                     2         3        0         1        2          0\n",
+		".                            1        10        1         1        8          2\n",
+		"Total                        5        21        2         2       17          3\n",
+	} {
+		if !strings.Contains(res, s) {
+			t.Errorf("expected %q in %s", s, res)
+		}
+	}
+}
+
+func TestToDirectoryWide(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	ByDir = true
+	Cocomo = true
+	Size = true
+	DirFilePaths = []string{"."}
+
+	res := toDirectoryWide(directoryInput())
+	if !strings.Contains(res, "Complexity/Lines") || !strings.Contains(res, getTabularWideBreak()) {
+		t.Errorf("expected wide output got %s", res)
+	}
+}
+
+func TestToDirectoryCSV(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	SortBy = "name"
+	DirFilePaths = []string{"."}
+
+	expected := `Directory,Language,Files,Lines,Code,Comments,Blanks,Complexity,Bytes
+.,Go,1,10,8,1,1,2,100
+cmd,Go,2,8,7,0,1,1,80
+cmd,Python,2,3,2,1,0,0,30
+`
+	if res := toDirectoryCSV(directoryInput()); res != expected {
+		t.Errorf("expected %s got %s", expected, res)
+	}
+}
+
+func TestToDirectoryMarkdown(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	Cocomo = true
+	Size = true
+	SortBy = "name"
+	DirFilePaths = []string{"."}
+
+	expected := `| Directory | Files | Lines | Blanks | Comments | Code | Complexity |
+| :--- | ---: | ---: | ---: | ---: | ---: | ---: |
+| **.** | 1 | 10 | 1 | 1 | 8 | 2 |
+| Go | 1 | 10 | 1 | 1 | 8 | 2 |
+| **cmd** | 4 | 11 | 1 | 1 | 9 | 1 |
+| Go | 2 | 8 | 1 | 0 | 7 | 1 |
+| Python | 2 | 3 | 0 | 1 | 2 | 0 |
+| **Total** | 5 | 21 | 2 | 2 | 17 | 3 |`
+	if res := toDirectoryMarkdown(directoryInput()); res != expected {
+		t.Errorf("expected %s got %s", expected, res)
+	}
+}
+
+func TestToDirectoryJSON(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	DirFilePaths = []string{"."}
+
+	res := toDirectoryJSON(directoryInput())
+	if !strings.HasPrefix(res, `[{"Name":"cmd","Bytes":110,"Lines":11,"Code":9,`) || !strings.Contains(res, `"Languages":[{"Name":"Go",`) {
+		t.Errorf("unexpected json %s", res)
+	}
+}
+
+func TestToDirectoryJSONReportConforms(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	ByDir = true
+	DirDepth = 2
+	DirFilePaths = []string{"."}
+
+	report := assertReportConforms(t, toDirectoryJSONReport(directoryInput()))
+
+	directories := report["Directories"].([]interface{})
+	if len(directories) != 3 {
+		t.Fatalf("expected 3 directories got %v", directories)
+	}
+	if flags := report["Metadata"].(map[string]interface{})["Flags"].(map[string]interface{}); flags["ByDir"] != true || flags["DirDepth"] != float64(2) {
+		t.Errorf("expected the by dir flags got %v", flags)
+	}
+	if total := report["Total"].(map[string]interface{}); total["Files"] != float64(5) {
+		t.Errorf("unexpected total %v", total)
+	}
+}
+
+func TestToDirectoryClocYAML(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	DirFilePaths = []string{"."}
+
+	res := toDirectoryClocYAML(directoryInput())
+	for _, expected := range []string{"cmd:\n  name: cmd\n  code: 9\n", "  languages:\n    Go:\n      name: Go\n      code: 7\n", "SUM:\n  code: 17\n"} {
+		if !strings.Contains(res, expected) {
+			t.Errorf("expected %q in %s", expected, res)
+		}
+	}
+}
+
+func TestToDirectorySql(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	SortBy = "name"
+	DirFilePaths = []string{"."}
+
+	res := toDirectorySql(directoryInput())
+	for _, expected := range []string{"create table d ", "insert into d values('.', '.', 'Go', 1, 100, 1, 1, 8, 2, 0);", "insert into d values('.', 'cmd', 'Python', 2, 30, 0, 1, 2, 0, 0);", "insert into metadata values("} {
+		if !strings.Contains(res, expected) {
+			t.Errorf("expected %q in %s", expected, res)
+		}
+	}
+}
+
+func TestToDirectoryHtmlTable(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	SortBy = "name"
+	DirFilePaths = []string{"."}
+
+	res := toDirectoryHtmlTable(directoryInput())
+	if !strings.Contains(res, "<th>Directory</th>") || !strings.Contains(res, "<th>cmd</th>\n\t\t<th>4</th>") || !strings.Contains(res, "<td>Python</td>\n\t\t<td>2</td>") {
+		t.Errorf("unexpected html table %s", res)
+	}
+}
+
+func TestToDirectoryHtml(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	SortBy = "name"
+	DirFilePaths = []string{"."}
+
+	res := toDirectoryHtml(directoryInput())
+	if !strings.Contains(res, "<h2>Directories</h2>") || !strings.Contains(res, `<tr class="language"><td>cmd</td><td>4</td>`) || !strings.Contains(res, `<tr class="file"><td>Python</td><td>2</td>`) {
+		t.Errorf("unexpected html %s", res)
+	}
+}
+
+func TestToDirectoryOpenMetrics(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	DirFilePaths = []string{"."}
+
+	res := toDirectoryOpenMetrics(directoryInput())
+	if !strings.Contains(res, `scc_code{directory="cmd",language="Python"} 2`) || !strings.HasSuffix(res, "# EOF") {
+		t.Errorf("unexpected openmetrics %s", res)
+	}
+}
+
+func TestGetFormatterByDir(t *testing.T) {
+	defer resetDirectory()
+	ByDir = true
+
+	if _, ok := getFormatter("md"); !ok {
+		t.Error("expected markdown alias to work with --by-dir")
+	}
+	if _, ok := getFormatter("cloc-yml"); !ok {
+		t.Error("expected cloc-yaml alias to work with --by-dir")
+	}
+	if formatter, ok := getFormatter("json-stream"); !ok || !isStreamFormatter(formatter) {
+		t.Error("expected json-stream to fall back to the formatter without --by-dir")
+	}
+
+	Format = "sarif"
+	defer func() { Format = "" }()
+	if err := validateFormats(); err != nil {
+		t.Errorf("expected sarif to be available with --by-dir got %v", err)
+	}
+
+	Format = "unknown"
+	if err := validateFormats(); err == nil {
+		t.Error("expected error for unknown format with --by-dir")
+	}
+}
+
+func isStreamFormatter(formatter Formatter) bool {
+	_, ok := formatter.(StreamFormatter)
+	return ok
+}
+
+func TestRegisterFormatterByDir(t *testing.T) {
+	defer resetDirectory()
+	ByDir = true
+
+	json, directoryJSON := formatterRegistry["json"], directoryFormatterRegistry["json"]
+	defer func() {
+		formatterRegistry["json"] = json
+		directoryFormatterRegistry["json"] = directoryJSON
+		delete(formatterRegistry, "counting")
+		formatterNames = formatterNames[:len(formatterNames)-1]
+	}()
+
+	RegisterFormatter("counting", FormatterFunc(countingFormatter))
+	RegisterFormatter("json", FormatterFunc(countingFormatter))
+
+	Format = "counting"
+	defer func() { Format = "" }()
+	if res := fileSummarize(directoryInput()); !strings.HasPrefix(res, "counted ") {
+		t.Errorf("expected registered formatter to be used with --by-dir got %s", res)
+	}
+
+	Format = "json"
+	if res := fileSummarize(directoryInput()); !strings.HasPrefix(res, "counted ") {
+		t.Errorf("expected replaced json formatter to be used with --by-dir got %s", res)
+	}
+}
+
+func TestFileSummarizeByDir(t *testing.T) {
+	resetDirectory()
+	defer resetDirectory()
+	ByDir = true
+	Format = "csv"
+	defer func() { Format = "" }()
+
+	if res := fileSummarize(directoryInput()); !strings.HasPrefix(res, "Directory,Language,") {
+		t.Errorf("expected directory csv got %s", res)
+	}
+}
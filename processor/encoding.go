@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// encodingSampleBytes is how much of a file without a BOM is looked at to guess if it is UTF-16 or UTF-32
+const encodingSampleBytes = 1_000
+
+// wideEncoding is a UTF-16 or UTF-32 encoding which can be transcoded to UTF-8 before counting
+type wideEncoding struct {
+	name  string
+	bom   []byte
+	width int
+	order binary.ByteOrder
+}
+
+// wideEncodings is ordered so UTF-32 LE is checked before UTF-16 LE as its BOM starts with the same bytes
+var wideEncodings = []wideEncoding{
+	{"UTF-32 LE", []byte{255, 254, 0, 0}, 4, binary.LittleEndian},
+	{"UTF-32 BE", []byte{0, 0, 254, 255}, 4, binary.BigEndian},
+	{"UTF-16 LE", []byte{255, 254}, 2, binary.LittleEndian},
+	{"UTF-16 BE", []byte{254, 255}, 2, binary.BigEndian},
+}
+
+// transcode converts UTF-16 and UTF-32 content to UTF-8 so it is counted like any other file rather than
+// being identified as binary due to its NUL bytes. The bytes of the file stay as they are on disk.
+func (c *Counter) transcode(job *FileJob) {
+	encoding, content, ok := detectWideEncoding(job.Content, c.Config.GuessEncoding)
+	if !ok {
+		return
+	}
+
+	if Verbose {
+		printWarn(fmt.Sprintf("transcoding %s from %s to UTF-8", job.Location, encoding.name))
+	}
+
+	job.Content = encoding.decode(content)
+}
+
+// detectWideEncoding returns the encoding of the content and the content without its BOM. Without a
+// BOM the encoding is only guessed when asked to.
+func detectWideEncoding(content []byte, guess bool) (wideEncoding, []byte, bool) {
+	for _, encoding := range wideEncodings {
+		if bytes.HasPrefix(content, encoding.bom) {
+			return encoding, content[len(encoding.bom):], true
+		}
+	}
+
+	if guess {
+		for _, encoding := range wideEncodings {
+			if encoding.likely(content) {
+				return encoding, content, true
+			}
+		}
+	}
+
+	return wideEncoding{}, nil, false
+}
+
+// likely checks if content without a BOM looks to be in the encoding. Source code is mostly ASCII so
+// in UTF-16 and UTF-32 nearly every code unit has its high bytes set to NUL and the low byte not.
+func (encoding wideEncoding) likely(content []byte) bool {
+	if len(content) > encodingSampleBytes {
+		content = content[:encodingSampleBytes]
+	}
+	content = content[:len(content)-len(content)%encoding.width]
+	if len(content) == 0 {
+		return false
+	}
+
+	low := 0
+	if encoding.order == binary.BigEndian {
+		low = encoding.width - 1
+	}
+
+	units, ascii := 0, 0
+	for i := 0; i < len(content); i += encoding.width {
+		unit := content[i : i+encoding.width]
+		units++
+
+		zeros := bytes.Count(unit, []byte{0})
+		if zeros == encoding.width {
+			// A NUL character is never in text
+			return false
+		}
+		if unit[low] != 0 && zeros == encoding.width-1 {
+			ascii++
+		}
+	}
+
+	return ascii*10 >= units*9
+}
+
+// decode converts the content to UTF-8 replacing anything which is not valid with U+FFFD
+func (encoding wideEncoding) decode(content []byte) []byte {
+	decoded := make([]byte, 0, len(content))
+
+	if encoding.width == 2 {
+		units := make([]uint16, 0, len(content)/2)
+		for i := 0; i+1 < len(content); i += 2 {
+			units = append(units, encoding.order.Uint16(content[i:]))
+		}
+		for _, r := range utf16.Decode(units) {
+			decoded = utf8.AppendRune(decoded, r)
+		}
+	} else {
+		for i := 0; i+3 < len(content); i += 4 {
+			decoded = utf8.AppendRune(decoded, rune(encoding.order.Uint32(content[i:])))
+		}
+	}
+
+	if len(content)%encoding.width != 0 {
+		decoded = utf8.AppendRune(decoded, utf8.RuneError)
+	}
+
+	return decoded
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+const encodingSource = "// comment é\nif (x) { y(\"😀\"); }\n\n/* block */\n"
+
+// encodeWide encodes the text as UTF-16 or UTF-32 in the byte order given
+func encodeWide(text string, width int, order binary.ByteOrder) []byte {
+	var buf bytes.Buffer
+
+	if width == 2 {
+		for _, unit := range utf16.Encode([]rune(text)) {
+			_ = binary.Write(&buf, order, unit)
+		}
+	} else {
+		for _, r := range text {
+			_ = binary.Write(&buf, order, uint32(r))
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func TestTranscodeByteOrderMarks(t *testing.T) {
+	encodings := map[string]struct {
+		width int
+		order binary.ByteOrder
+	}{
+		string([]byte{254, 255}):       {2, binary.BigEndian},
+		string([]byte{255, 254}):       {2, binary.LittleEndian},
+		string([]byte{0, 0, 254, 255}): {4, binary.BigEndian},
+		string([]byte{255, 254, 0, 0}): {4, binary.LittleEndian},
+	}
+
+	counter := mustNewCounter(t, NewConfig())
+
+	for _, bom := range ByteOrderMarks {
+		encoding, wide := encodings[string(bom)]
+
+		content := append(append([]byte{}, bom...), []byte("x = 1\n")...)
+		if wide {
+			content = append(append([]byte{}, bom...), encodeWide(encodingSource, encoding.width, encoding.order)...)
+		}
+
+		job := &FileJob{Content: content, Bytes: int64(len(content))}
+		counter.transcode(job)
+
+		if wide && string(job.Content) != encodingSource {
+			t.Errorf("BOM %v expected transcoded content got %q", bom, job.Content)
+		}
+		if !wide && !bytes.Equal(job.Content, content) {
+			t.Errorf("BOM %v expected content to be left alone got %q", bom, job.Content)
+		}
+		if job.Bytes != int64(len(content)) {
+			t.Errorf("BOM %v expected bytes to stay as on disk got %d", bom, job.Bytes)
+		}
+	}
+}
+
+func TestCountFileUTF16(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+	counter.loadLanguageFeature("C#")
+
+	content := append([]byte{255, 254}, encodeWide(encodingSource, 2, binary.LittleEndian)...)
+	job := &FileJob{Filename: "a.cs", Language: "C#", PossibleLanguages: []string{"C#"}, Content: content, Bytes: int64(len(content))}
+
+	if !counter.countFile(job) {
+		t.Fatal("expected the file to be counted")
+	}
+	if job.Binary {
+		t.Error("expected UTF-16 to not be identified as binary")
+	}
+	if job.Lines != 4 || job.Code != 1 || job.Comment != 2 || job.Blank != 1 || job.Complexity != 1 {
+		t.Errorf("expected 4 lines 1 code 2 comment 1 blank 1 complexity got %d %d %d %d %d", job.Lines, job.Code, job.Comment, job.Blank, job.Complexity)
+	}
+}
+
+func TestGuessWideEncoding(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  []byte
+		expected string
+	}{
+		{"UTF-16 LE", encodeWide(encodingSource, 2, binary.LittleEndian), "UTF-16 LE"},
+		{"UTF-16 BE", encodeWide(encodingSource, 2, binary.BigEndian), "UTF-16 BE"},
+		{"UTF-32 LE", encodeWide(encodingSource, 4, binary.LittleEndian), "UTF-32 LE"},
+		{"UTF-32 BE", encodeWide(encodingSource, 4, binary.BigEndian), "UTF-32 BE"},
+		{"UTF-8", []byte(encodingSource), ""},
+		{"binary", []byte{0x7f, 'E', 'L', 'F', 2, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0}, ""},
+		{"mostly not ASCII", encodeWide(strings.Repeat("日本語", 10), 2, binary.LittleEndian), ""},
+		{"empty", []byte{}, ""},
+	}
+
+	for _, c := range cases {
+		encoding, _, ok := detectWideEncoding(c.content, true)
+		if ok != (c.expected != "") || encoding.name != c.expected {
+			t.Errorf("%s expected %q got %q", c.name, c.expected, encoding.name)
+		}
+	}
+
+	if _, _, ok := detectWideEncoding(encodeWide(encodingSource, 2, binary.LittleEndian), false); ok {
+		t.Error("expected no guess unless asked for")
+	}
+}
+
+func TestWideEncodingDecodeInvalid(t *testing.T) {
+	utf16le := wideEncodings[2]
+
+	// Unpaired surrogate followed by an odd trailing byte
+	if got := string(utf16le.decode([]byte{0x00, 0xd8, 'a', 0, 'b'})); got != "�a�" {
+		t.Errorf("expected replacement characters got %q", got)
+	}
+
+	utf32le := wideEncodings[0]
+	if got := string(utf32le.decode([]byte{0xff, 0xff, 0xff, 0x7f, 'a', 0, 0, 0})); got != "�a" {
+		t.Errorf("expected replacement character got %q", got)
+	}
+}
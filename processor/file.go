@@ -3,7 +3,9 @@
 package processor
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -14,6 +16,9 @@ import (
 	"github.com/dbaggerman/cuba"
 )
 
+// The number of directory entries read at a time between checks for cancellation
+const readdirBatchSize = 1024
+
 // Used as quick lookup for files with the same name to avoid some processing
 // needs to be sync.Map as it potentially could be called by many GoRoutines
 var extensionCache sync.Map
@@ -48,24 +53,38 @@ func getExtension(name string) string {
 
 // DirectoryJob is a struct for dealing with directories we want to walk
 type DirectoryJob struct {
-	root    string
-	path    string
-	ignores []gitignore.IgnoreMatcher
+	root     string
+	path     string
+	ignores  []gitignore.IgnoreMatcher
+	settings *directorySettings
 }
 
 // DirectoryWalker is responsible for actually walking directories using cuba
 type DirectoryWalker struct {
+	ctx      context.Context
 	buffer   *cuba.Pool
 	output   chan<- *FileJob
 	excludes []*regexp.Regexp
+	counter  *Counter
 }
 
 // NewDirectoryWalker create the new directory walker
 func NewDirectoryWalker(output chan<- *FileJob) *DirectoryWalker {
+	return NewDirectoryWalkerContext(context.Background(), output)
+}
+
+// NewDirectoryWalkerContext create the new directory walker which stops walking once the context is done
+func NewDirectoryWalkerContext(ctx context.Context, output chan<- *FileJob) *DirectoryWalker {
+	return globalCounter().newDirectoryWalker(ctx, output)
+}
+
+func (c *Counter) newDirectoryWalker(ctx context.Context, output chan<- *FileJob) *DirectoryWalker {
 	directoryWalker := &DirectoryWalker{
-		output: output,
+		ctx:     ctx,
+		output:  output,
+		counter: c,
 	}
-	for _, exclude := range Exclude {
+	for _, exclude := range c.Config.Exclude {
 		regexpResult, err := regexp.Compile(exclude)
 		if err == nil {
 			directoryWalker.excludes = append(directoryWalker.excludes, regexpResult)
@@ -75,7 +94,7 @@ func NewDirectoryWalker(output chan<- *FileJob) *DirectoryWalker {
 	}
 
 	directoryWalker.buffer = cuba.New(directoryWalker.Walk, cuba.NewStack())
-	directoryWalker.buffer.SetMaxWorkers(int32(DirectoryWalkerJobWorkers))
+	directoryWalker.buffer.SetMaxWorkers(int32(c.Config.DirectoryWalkerJobWorkers))
 
 	return directoryWalker
 }
@@ -90,9 +109,9 @@ func (dw *DirectoryWalker) Start(root string) error {
 	}
 
 	if !fileInfo.IsDir() {
-		fileJob := newFileJob(root, filepath.Base(root), fileInfo)
+		fileJob := dw.counter.newFileJob(root, filepath.Base(root), filepath.Base(root), fileInfo, nil)
 		if fileJob != nil {
-			dw.output <- fileJob
+			dw.send(fileJob)
 		}
 
 		return nil
@@ -115,40 +134,96 @@ func (dw *DirectoryWalker) Run() {
 	close(dw.output)
 }
 
+// send passes the job on returning false without sending if the context is done first
+func (dw *DirectoryWalker) send(fileJob *FileJob) bool {
+	select {
+	case dw.output <- fileJob:
+		return true
+	case <-dw.ctx.Done():
+		return false
+	}
+}
+
 // Walk walks the directory as quickly as it can
 func (dw *DirectoryWalker) Walk(handle *cuba.Handle) {
 	job := handle.Item().(*DirectoryJob)
+	config := dw.counter.Config
+
+	// Directories already queued still get handed to us after cancellation so drop them here
+	if dw.ctx.Err() != nil {
+		return
+	}
 
 	ignores := job.ignores
+	settings := job.settings
 
 	dirents, err := dw.Readdir(job.path)
 	if err != nil {
-		printError(err.Error())
+		if dw.ctx.Err() == nil {
+			printError(err.Error())
+		}
 		return
 	}
 
+	var hasGitDir, hasGitIgnore, hasIgnore, hasGitAttributes bool
 	for _, dirent := range dirents {
 		name := dirent.Name()
 
-		if (!GitIgnore && name == ".gitignore") || (!Ignore && name == ".ignore") {
+		switch {
+		case name == ".git" && dirent.IsDir():
+			hasGitDir = true
+		case name == ".gitignore":
+			hasGitIgnore = true
+		case name == ".ignore":
+			hasIgnore = true
+		case name == GitAttributesFilename && !dirent.IsDir():
+			hasGitAttributes = true
+		}
+
+		if !config.NoSccrc && name == SccrcFilename && !dirent.IsDir() {
 			path := filepath.Join(job.path, name)
 
-			ignore, err := gitignore.NewGitIgnore(path)
+			loaded, err := dw.counter.loadSccrc(path, job.settings)
 			if err != nil {
-				printError(fmt.Sprintf("failed to load gitignore %s: %v", job.path, err))
+				printError(fmt.Sprintf("failed to load %s: %v", path, err))
 			} else {
-				ignores = append(ignores, ignore)
+				settings = loaded
 			}
 		}
 	}
 
+	// Attributes apply on top of any .sccrc in the same directory
+	if !config.NoGitAttributes && hasGitAttributes {
+		path := filepath.Join(job.path, GitAttributesFilename)
+
+		loaded, err := dw.counter.loadGitAttributes(path, job.path, settings)
+		if err != nil {
+			printError(fmt.Sprintf("failed to load %s: %v", path, err))
+		} else {
+			settings = loaded
+		}
+	}
+
+	// Ignore files are stacked from lowest to highest precedence which for a repository root is
+	// the global excludes file, then .git/info/exclude, then .gitignore and finally .ignore
+	if !config.NoGitIgnore && hasGitDir {
+		ignores = dw.appendIgnore(ignores, gitignore.GlobalExcludesFile(), job.path, true)
+		ignores = dw.appendIgnore(ignores, filepath.Join(job.path, ".git", "info", "exclude"), job.path, true)
+	}
+	if !config.NoGitIgnore && hasGitIgnore {
+		ignores = dw.appendIgnore(ignores, filepath.Join(job.path, ".gitignore"), job.path, false)
+	}
+	if !config.NoIgnore && hasIgnore {
+		ignores = dw.appendIgnore(ignores, filepath.Join(job.path, ".ignore"), job.path, false)
+	}
+
 DIRENTS:
 	for _, dirent := range dirents {
 		name := dirent.Name()
 		path := filepath.Join(job.path, name)
 		isDir := dirent.IsDir()
 
-		for _, deny := range PathDenyList {
+		for _, deny := range config.PathDenyList {
 			if strings.HasSuffix(path, deny) {
 				if Verbose {
 					printWarn(fmt.Sprintf("skipping directory due to being in denylist: %s", path))
@@ -166,51 +241,101 @@ DIRENTS:
 			}
 		}
 
-		for _, ignore := range ignores {
-			if ignore.Match(path, isDir) {
-				if Verbose {
-					printWarn("skipping directory due to ignore: " + path)
-				}
-				continue DIRENTS
+		if settings.excluded(name, path) {
+			if Verbose {
+				printWarn(fmt.Sprintf("skipping file/directory due to match exclude in %s: %s", SccrcFilename, name))
+			}
+			continue DIRENTS
+		}
+
+		if gitignore.Ignored(ignores, path, isDir) {
+			if Verbose {
+				printWarn("skipping file/directory due to ignore: " + path)
 			}
+			continue DIRENTS
 		}
 
 		if isDir {
 			handle.Push(
 				&DirectoryJob{
-					root:    job.root,
-					path:    path,
-					ignores: ignores,
+					root:     job.root,
+					path:     path,
+					ignores:  ignores,
+					settings: settings,
 				},
 			)
 		} else {
-			fileJob := newFileJob(path, name, dirent)
-			if fileJob != nil {
-				dw.output <- fileJob
+			relative, err := filepath.Rel(job.root, path)
+			if err != nil {
+				relative = path
+			}
+
+			fileJob := dw.counter.newFileJob(path, relative, name, dirent, settings)
+			if fileJob != nil && !dw.send(fileJob) {
+				return
 			}
 		}
 	}
 }
 
-// Readdir reads a directory such that we know what files are in there
+// appendIgnore loads the ignore file with patterns relative to base adding it to the end of the stack.
+// Files which may not exist such as .git/info/exclude are skipped quietly when missing.
+func (dw *DirectoryWalker) appendIgnore(ignores []gitignore.IgnoreMatcher, path string, base string, optional bool) []gitignore.IgnoreMatcher {
+	if path == "" {
+		return ignores
+	}
+
+	ignore, err := gitignore.NewGitIgnore(path, base)
+	if err != nil {
+		if !optional || !os.IsNotExist(err) {
+			printError(fmt.Sprintf("failed to load gitignore %s: %v", path, err))
+		}
+		return ignores
+	}
+
+	// The stack is shared with sibling directories so never append into its backing array
+	return append(ignores[:len(ignores):len(ignores)], ignore)
+}
+
+// Readdir reads a directory such that we know what files are in there. Large directories
+// are read in batches so that the walk can stop part way through once the context is done.
 func (dw *DirectoryWalker) Readdir(path string) ([]os.FileInfo, error) {
+	if err := dw.ctx.Err(); err != nil {
+		return []os.FileInfo{}, err
+	}
+
 	file, err := os.Open(path)
 	if err != nil {
 		return []os.FileInfo{}, fmt.Errorf("failed to open %s: %v", path, err)
 	}
 	defer file.Close()
 
-	dirents, err := file.Readdir(-1)
-	if err != nil {
-		return []os.FileInfo{}, fmt.Errorf("failed to read %s: %v", path, err)
+	var dirents []os.FileInfo
+	for {
+		batch, err := file.Readdir(readdirBatchSize)
+		dirents = append(dirents, batch...)
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return []os.FileInfo{}, fmt.Errorf("failed to read %s: %v", path, err)
+		}
+		if err := dw.ctx.Err(); err != nil {
+			return []os.FileInfo{}, err
+		}
 	}
 
 	return dirents, nil
 }
 
-func newFileJob(path, name string, fileInfo os.FileInfo) *FileJob {
-	if NoLarge {
-		if fileInfo.Size() >= LargeByteCount {
+// newFileJob creates the job for the file at path returning nil if it should not be counted. The relative
+// path is from where the walk started and is what the vendored rules are matched against.
+func (c *Counter) newFileJob(path, relative, name string, fileInfo os.FileInfo, settings *directorySettings) *FileJob {
+	config := c.Config
+
+	if config.NoLarge {
+		if fileInfo.Size() >= config.LargeByteCount {
 			if Verbose {
 				printWarn(fmt.Sprintf("skipping large file due to byte size: %s", path))
 			}
@@ -222,7 +347,7 @@ func newFileJob(path, name string, fileInfo os.FileInfo) *FileJob {
 	// Check if the file is a symlink and if we want to count those then work out its path and rejig
 	// everything so we can count the real file to ensure the counts are correct
 	if fileInfo.Mode()&os.ModeSymlink == os.ModeSymlink {
-		if !IncludeSymLinks {
+		if !config.IncludeSymLinks {
 			if Verbose {
 				printWarn(fmt.Sprintf("skipping symlink file: %s", name))
 			}
@@ -243,13 +368,39 @@ func newFileJob(path, name string, fileInfo os.FileInfo) *FileJob {
 		return nil
 	}
 
-	language, extension := DetectLanguage(name)
+	// Vendored files are known from their path so with --no-vendored they are never read
+	vendored := c.vendored(settings, path, relative)
+	if vendored && config.IgnoreVendored {
+		if Verbose {
+			printWarn(fmt.Sprintf("skipping vendored file: %s", path))
+		}
+		return nil
+	}
+
+	language, extension := c.detectLanguage(name)
+	if countAs, ok := settings.countAsLanguage(name); ok {
+		language = countAs
+	}
+
+	// A linguist-language attribute wins over everything else including modelines
+	linguistLanguage, languageAttribute := c.linguistLanguage(settings, path)
+	if languageAttribute {
+		language = linguistLanguage
+		if Verbose {
+			printWarn(fmt.Sprintf("using linguist-language %s from %s for %s", strings.Join(language, ","), GitAttributesFilename, path))
+		}
+	}
+
+	// Files with an extension which is not known may still say what they are with a modeline
+	if len(language) == 0 && len(config.AllowListExtensions) == 0 {
+		language = c.unknownModelineLanguage(path, fileInfo.Size())
+	}
 
 	if len(language) != 0 {
 		// check if extensions in the allow list, which should limit to just those extensions
-		if len(AllowListExtensions) != 0 {
+		if len(config.AllowListExtensions) != 0 {
 			ok := false
-			for _, x := range AllowListExtensions {
+			for _, x := range config.AllowListExtensions {
 				if x == extension {
 					ok = true
 				}
@@ -264,9 +415,9 @@ func newFileJob(path, name string, fileInfo os.FileInfo) *FileJob {
 		}
 
 		// check if we should exclude this type
-		if len(ExcludeListExtensions) != 0 {
+		if len(config.ExcludeListExtensions) != 0 {
 			ok := true
-			for _, x := range ExcludeListExtensions {
+			for _, x := range config.ExcludeListExtensions {
 				if x == extension {
 					ok = false
 				}
@@ -280,9 +431,9 @@ func newFileJob(path, name string, fileInfo os.FileInfo) *FileJob {
 			}
 		}
 
-		if len(ExcludeFilename) != 0 {
+		if len(config.ExcludeFilename) != 0 {
 			ok := true
-			for _, x := range ExcludeFilename {
+			for _, x := range config.ExcludeFilename {
 				if strings.Contains(name, x) {
 					ok = false
 				}
@@ -297,7 +448,7 @@ func newFileJob(path, name string, fileInfo os.FileInfo) *FileJob {
 		}
 
 		for _, l := range language {
-			LoadLanguageFeature(l)
+			c.loadLanguageFeature(l)
 		}
 
 		return &FileJob{
@@ -307,6 +458,10 @@ func newFileJob(path, name string, fileInfo os.FileInfo) *FileJob {
 			Extension:         extension,
 			PossibleLanguages: language,
 			Bytes:             fileInfo.Size(),
+			Vendored:          vendored,
+			modTime:           fileInfo.ModTime().UnixNano(),
+			settings:          settings,
+			languageAttribute: languageAttribute,
 		}
 	} else if Verbose {
 		printWarn(fmt.Sprintf("skipping file unknown extension: %s", name))
@@ -3,6 +3,7 @@
 package processor
 
 import (
+	"context"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -219,7 +220,7 @@ func TestNewFileJobFullname(t *testing.T) {
 	AllowListExtensions = []string{}
 
 	fi, _ := os.Stat("../examples/issue114/makefile")
-	job := newFileJob("../examples/issue114/", "makefile", fi)
+	job := globalCounter().newFileJob("../examples/issue114/", "makefile", "makefile", fi, nil)
 
 	if job.PossibleLanguages[0] != "Makefile" {
 		t.Error("Expected makefile got", job.PossibleLanguages[0])
@@ -230,7 +231,7 @@ func TestNewFileJob(t *testing.T) {
 	ProcessConstants()
 
 	fi, _ := os.Stat("../examples/issue114/java")
-	job := newFileJob("../examples/issue114/", "java", fi)
+	job := globalCounter().newFileJob("../examples/issue114/", "java", "java", fi, nil)
 
 	if job.PossibleLanguages[0] != "#!" {
 		t.Error("Expected special value #! got", job.PossibleLanguages[0])
@@ -242,7 +243,7 @@ func TestNewFileJobGitIgnore(t *testing.T) {
 	ProcessConstants()
 
 	fi, _ := os.Stat("../examples/issue114/.gitignore")
-	job := newFileJob("../examples/issue114/", ".gitignore", fi)
+	job := globalCounter().newFileJob("../examples/issue114/", ".gitignore", ".gitignore", fi, nil)
 
 	if job.PossibleLanguages[0] != "gitignore" {
 		t.Error("Expected gitignore got", job.PossibleLanguages[0])
@@ -254,7 +255,7 @@ func TestNewFileJobIgnore(t *testing.T) {
 	ProcessConstants()
 
 	fi, _ := os.Stat("../examples/issue114/.ignore")
-	job := newFileJob("../examples/issue114/", ".ignore", fi)
+	job := globalCounter().newFileJob("../examples/issue114/", ".ignore", ".ignore", fi, nil)
 
 	if job.PossibleLanguages[0] != "ignore" {
 		t.Error("Expected ignore got", job.PossibleLanguages[0])
@@ -265,7 +266,7 @@ func TestNewFileJobLicense(t *testing.T) {
 	ProcessConstants()
 
 	fi, _ := os.Stat("../examples/issue114/license")
-	job := newFileJob("../examples/issue114/", "license", fi)
+	job := globalCounter().newFileJob("../examples/issue114/", "license", "license", fi, nil)
 
 	if job.PossibleLanguages[0] != "License" {
 		t.Error("Expected License got", job.PossibleLanguages[0])
@@ -276,7 +277,7 @@ func TestNewFileJobYAML(t *testing.T) {
 	ProcessConstants()
 
 	fi, _ := os.Stat("../examples/issue114/.travis.yml")
-	job := newFileJob("../examples/issue114/", ".travis.yml", fi)
+	job := globalCounter().newFileJob("../examples/issue114/", ".travis.yml", ".travis.yml", fi, nil)
 
 	found := false
 	for _, j := range job.PossibleLanguages {
@@ -294,7 +295,7 @@ func TestNewFileJobYAMLCloudformation(t *testing.T) {
 	ProcessConstants()
 
 	fi, _ := os.Stat("../examples/issue114/.travis.yml")
-	job := newFileJob("../examples/issue114/", ".travis.yml", fi)
+	job := globalCounter().newFileJob("../examples/issue114/", ".travis.yml", ".travis.yml", fi, nil)
 
 	found := false
 	for _, j := range job.PossibleLanguages {
@@ -314,7 +315,7 @@ func TestNewFileJobSize(t *testing.T) {
 	LargeByteCount = 1
 
 	fi, _ := os.Stat("file_test.go")
-	job := newFileJob("file_test.go", "file_test.go", fi)
+	job := globalCounter().newFileJob("file_test.go", "file_test.go", "file_test.go", fi, nil)
 
 	if job != nil {
 		t.Error("Expected nil got", job)
@@ -352,3 +353,114 @@ func randStringBytes(n int) string {
 	}
 	return string(b)
 }
+
+func TestWalkDirectoryCancelled(t *testing.T) {
+	ProcessConstants()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	inputChan := make(chan *FileJob)
+	dirwalker := NewDirectoryWalkerContext(ctx, inputChan)
+	if err := dirwalker.Start("../"); err != nil {
+		t.Fatalf("dirwalker.Start returned error: %v", err)
+	}
+
+	// The channel is unbuffered and never read until Run finishes so a walker
+	// ignoring the context would block here forever
+	dirwalker.Run()
+
+	count := 0
+	for range inputChan {
+		count++
+	}
+
+	if count != 0 {
+		t.Errorf("Expected no files from cancelled walk got %d", count)
+	}
+}
+
+func TestReaddirCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	dirwalker := NewDirectoryWalkerContext(ctx, make(chan *FileJob))
+	_, err := dirwalker.Readdir("../")
+	if err != context.Canceled {
+		t.Errorf("Expected context.Canceled got %v", err)
+	}
+}
+
+func TestReadFileContextCancelled(t *testing.T) {
+	reader := NewFileReader()
+
+	content, err := reader.ReadFileContext(context.Background(), "file_test.go", 100)
+	if err != nil || len(content) == 0 {
+		t.Fatalf("Expected content got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = reader.ReadFileContext(ctx, "file_test.go", 100)
+	if err != context.Canceled {
+		t.Errorf("Expected context.Canceled got %v", err)
+	}
+}
+
+func TestWalkDirectoryGitIgnoreStack(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("GIT_CONFIG_GLOBAL", filepath.Join(dir, "gitconfig"))
+
+	files := map[string]string{
+		"gitconfig":              "[core]\n\texcludesFile = " + filepath.Join(dir, "global.ignore") + "\n",
+		"global.ignore":          "*_global.go\n",
+		"repo/.git/info/exclude": "*_exclude.go\n",
+		"repo/.gitignore":        "*.py\n!keep.py\nbuild/\n",
+		"repo/.ignore":           "!wanted_exclude.go\n",
+		"repo/a_global.go":       "",
+		"repo/a_exclude.go":      "",
+		"repo/wanted_exclude.go": "",
+		"repo/a.py":              "",
+		"repo/keep.py":           "",
+		"repo/build/out.go":      "",
+		"repo/sub/.gitignore":    "!b.py\n",
+		"repo/sub/b.py":          "",
+		"repo/sub/c.py":          "",
+		"repo/main.go":           "",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		_ = os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	counter := mustNewCounter(t, NewConfig())
+	inputChan := make(chan *FileJob, 100)
+	dirwalker := counter.newDirectoryWalker(context.Background(), inputChan)
+	if err := dirwalker.Start(filepath.Join(dir, "repo")); err != nil {
+		t.Fatalf("dirwalker.Start returned error: %v", err)
+	}
+	dirwalker.Run()
+
+	seen := map[string]bool{}
+	for fileJob := range inputChan {
+		rel, _ := filepath.Rel(filepath.Join(dir, "repo"), fileJob.Location)
+		seen[filepath.ToSlash(rel)] = true
+	}
+
+	for _, name := range []string{"wanted_exclude.go", "keep.py", "sub/b.py", "main.go"} {
+		if !seen[name] {
+			t.Errorf("expected %s to be walked", name)
+		}
+	}
+	for _, name := range []string{"a_global.go", "a_exclude.go", "a.py", "build/out.go", "sub/c.py"} {
+		if seen[name] {
+			t.Errorf("expected %s to be ignored", name)
+		}
+	}
+}
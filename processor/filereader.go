@@ -4,6 +4,7 @@ package processor
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -23,6 +24,16 @@ func NewFileReader() FileReader {
 
 // ReadFile actually reads the file into a buffer size controlled by LargeByteCount
 func (reader *FileReader) ReadFile(path string, size int) ([]byte, error) {
+	return reader.ReadFileContext(context.Background(), path, size)
+}
+
+// ReadFileContext is ReadFile but gives up once the context is done, which is checked
+// before opening the file and between each chunk read so slow reads can be abandoned
+func (reader *FileReader) ReadFileContext(ctx context.Context, path string, size int) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	fd, err := os.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("error opening %s: %v", path, err)
@@ -43,10 +54,24 @@ func (reader *FileReader) ReadFile(path string, size int) ([]byte, error) {
 
 	reader.Buffer.Grow(size)
 
-	_, err = io.Copy(reader.Buffer, fd)
+	_, err = io.Copy(reader.Buffer, &contextReader{ctx: ctx, reader: fd})
 	if err != nil {
 		return nil, fmt.Errorf("error reading %s: %v", path, err)
 	}
 
 	return reader.Buffer.Bytes(), nil
 }
+
+// contextReader stops reading from the underlying reader once the context is done
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return r.reader.Read(p)
+}
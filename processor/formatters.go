@@ -5,8 +5,11 @@ package processor
 import (
 	"bytes"
 	"encoding/csv"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -14,6 +17,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mattn/go-runewidth"
@@ -36,13 +40,21 @@ var tabularShortFormatBodyNoComplexity = "%-22s %11d %11d %10d %11d %9d\n"
 var tabularShortFormatFileNoComplexity = "%s %11d %10d %11d %9d\n"
 var longNameTruncate = 22
 
-var tabularWideBreak = "─────────────────────────────────────────────────────────────────────────────────────────────────────────────\n"
-var tabularWideBreakCi = "-------------------------------------------------------------------------------------------------------------\n"
-var tabularWideFormatHead = "%-33s %9s %9s %8s %9s %8s %10s %16s\n"
-var tabularWideFormatBody = "%-33s %9d %9d %8d %9d %8d %10d %16.2f\n"
-var tabularWideFormatFile = "%s %9d %8d %9d %8d %10d %16.2f\n"
+var tabularWideBreak = "───────────────────────────────────────────────────────────────────────────────────────────────────────────────────────\n"
+var tabularWideBreakCi = "-----------------------------------------------------------------------------------------------------------------------\n"
+var tabularWideFormatHead = "%-33s %9s %9s %8s %9s %8s %10s %9s %16s\n"
+var tabularWideFormatBody = "%-33s %9d %9d %8d %9d %8d %10d %9d %16.2f\n"
+var tabularWideFormatFile = "%s %9d %8d %9d %8d %10d %9d %16.2f\n"
 var wideFormatFileTruncate = 42
 
+var tabularShortFormatFunctionHead = "%-48s %9s %9s %10s\n"
+var tabularShortFormatFunctionBody = "%s %9d %9d %10d\n"
+var shortFormatFunctionTruncate = 48
+
+var tabularWideFormatFunctionHead = "%-68s %9s %9s %9s %10s %9s\n"
+var tabularWideFormatFunctionBody = "%s %9d %9d %9d %10d %9d\n"
+var wideFormatFunctionTruncate = 68
+
 var openMetricsMetadata = `# TYPE scc_files count
 # HELP scc_files Number of sourcecode files.
 # TYPE scc_lines count
@@ -55,6 +67,8 @@ var openMetricsMetadata = `# TYPE scc_files count
 # HELP scc_blanks Number of blank lines.
 # TYPE scc_complexity count
 # HELP scc_complexity Code complexity.
+# TYPE scc_cognitive_complexity count
+# HELP scc_cognitive_complexity Code complexity weighted by nesting.
 # TYPE scc_bytes count
 # UNIT scc_bytes bytes
 # HELP scc_bytes Size in bytes.
@@ -148,39 +162,34 @@ func getTabularWideBreak() string {
 func toClocYAML(input chan *FileJob) string {
 	startTime := makeTimestampMilli()
 
+	aggregate := newLanguageAggregator(false, false).consume(input)
 	languages := map[string]languageSummaryCloc{}
-	var sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity int64 = 0, 0, 0, 0, 0, 0
+	for _, summary := range aggregate.summaries() {
+		languages[summary.Name] = clocSummary(summary)
+	}
 
-	for res := range input {
-		sumFiles++
-		sumLines += res.Lines
-		sumCode += res.Code
-		sumComment += res.Comment
-		sumBlank += res.Blank
-		sumComplexity += res.Complexity
-
-		_, ok := languages[res.Language]
-
-		if !ok {
-			languages[res.Language] = languageSummaryCloc{
-				Name:    res.Language,
-				Code:    res.Code,
-				Comment: res.Comment,
-				Blank:   res.Blank,
-				Count:   1,
-			}
-		} else {
-			tmp := languages[res.Language]
-
-			languages[res.Language] = languageSummaryCloc{
-				Name:    res.Language,
-				Code:    tmp.Code + res.Code,
-				Comment: tmp.Comment + res.Comment,
-				Blank:   tmp.Blank + res.Blank,
-				Count:   tmp.Count + 1,
-			}
-		}
+	yamlString := clocYAML(languages, aggregate.total)
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
+	}
+
+	return yamlString
+}
+
+func clocSummary(summary LanguageSummary) languageSummaryCloc {
+	return languageSummaryCloc{
+		Name:    summary.Name,
+		Code:    summary.Code,
+		Comment: summary.Comment,
+		Blank:   summary.Blank,
+		Count:   summary.Count,
 	}
+}
+
+// clocYAML writes the body between the header and the sum cloc puts at the start and end of its report
+func clocYAML(body interface{}, total LanguageSummary) string {
+	sumFiles, sumLines, sumCode, sumComment, sumBlank := total.Count, total.Lines, total.Code, total.Comment, total.Blank
 
 	es := float64(makeTimestampMilli()-startTimeMilli) * float64(0.001)
 
@@ -208,19 +217,13 @@ func toClocYAML(input chan *FileJob) string {
 
 	reportYaml, _ := yaml.Marshal(reportStart)
 	sumYaml, _ := yaml.Marshal(reportEnd)
-	languageYaml, _ := yaml.Marshal(languages)
-	yamlString := "# https://github.com/boyter/scc/\n" + string(reportYaml) + string(languageYaml) + string(sumYaml)
-
-	if Debug {
-		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
-	}
-
-	return yamlString
+	bodyYaml, _ := yaml.Marshal(body)
+	return "# https://github.com/boyter/scc/\n" + string(reportYaml) + string(bodyYaml) + string(sumYaml)
 }
 
 func toJSON(input chan *FileJob) string {
 	startTime := makeTimestampMilli()
-	language := aggregateLanguageSummary(input)
+	language := aggregateLanguageSummary(input, Files || ByFunction)
 	language = sortLanguageSummary(language)
 
 	jsonString, _ := json.Marshal(language)
@@ -233,6 +236,10 @@ func toJSON(input chan *FileJob) string {
 }
 
 func toCSV(input chan *FileJob) string {
+	if ByFunction {
+		return toCSVFunctions(input)
+	}
+
 	if Files {
 		return toCSVFiles(input)
 	}
@@ -241,7 +248,7 @@ func toCSV(input chan *FileJob) string {
 }
 
 func toCSVSummary(input chan *FileJob) string {
-	language := aggregateLanguageSummary(input)
+	language := aggregateLanguageSummary(input, Files)
 	language = sortLanguageSummary(language)
 
 	records := [][]string{}
@@ -254,7 +261,8 @@ func toCSVSummary(input chan *FileJob) string {
 			fmt.Sprint(result.Comment),
 			fmt.Sprint(result.Blank),
 			fmt.Sprint(result.Complexity),
-			fmt.Sprint(result.Bytes)})
+			fmt.Sprint(result.Bytes),
+			fmt.Sprint(result.CognitiveComplexity)})
 	}
 
 	// Cater for the common case of adding plural even for those options that don't make sense
@@ -315,7 +323,8 @@ func toCSVSummary(input chan *FileJob) string {
 		"Comments",
 		"Blanks",
 		"Complexity",
-		"Bytes"},
+		"Bytes",
+		"CognitiveComplexity"},
 	}
 
 	recordsEnd = append(recordsEnd, records...)
@@ -341,7 +350,8 @@ func toCSVFiles(input chan *FileJob) string {
 			fmt.Sprint(result.Comment),
 			fmt.Sprint(result.Blank),
 			fmt.Sprint(result.Complexity),
-			fmt.Sprint(result.Bytes)})
+			fmt.Sprint(result.Bytes),
+			fmt.Sprint(result.CognitiveComplexity)})
 	}
 
 	// Cater for the common case of adding plural even for those options that don't make sense
@@ -406,7 +416,8 @@ func toCSVFiles(input chan *FileJob) string {
 		"Comments",
 		"Blanks",
 		"Complexity",
-		"Bytes"},
+		"Bytes",
+		"CognitiveComplexity"},
 	}
 
 	recordsEnd = append(recordsEnd, records...)
@@ -419,6 +430,47 @@ func toCSVFiles(input chan *FileJob) string {
 	return b.String()
 }
 
+func toCSVFunctions(input chan *FileJob) string {
+	var files []*FileJob
+	for res := range input {
+		files = append(files, res)
+	}
+
+	records := [][]string{{
+		"Language",
+		"Provider",
+		"Filename",
+		"Function",
+		"StartLine",
+		"EndLine",
+		"Lines",
+		"Code",
+		"Complexity",
+		"CognitiveComplexity"},
+	}
+
+	for _, f := range sortFunctions(collectFunctions(files)) {
+		records = append(records, []string{
+			f.Language,
+			f.Location,
+			f.Filename,
+			f.Name,
+			fmt.Sprint(f.StartLine),
+			fmt.Sprint(f.EndLine),
+			fmt.Sprint(f.Lines),
+			fmt.Sprint(f.Code),
+			fmt.Sprint(f.Complexity),
+			fmt.Sprint(f.CognitiveComplexity)})
+	}
+
+	b := &bytes.Buffer{}
+	w := csv.NewWriter(b)
+	_ = w.WriteAll(records)
+	w.Flush()
+
+	return b.String()
+}
+
 func toOpenMetrics(input chan *FileJob) string {
 	if Files {
 		return toOpenMetricsFiles(input)
@@ -428,7 +480,7 @@ func toOpenMetrics(input chan *FileJob) string {
 }
 
 func toOpenMetricsSummary(input chan *FileJob) string {
-	language := aggregateLanguageSummary(input)
+	language := aggregateLanguageSummary(input, Files)
 	language = sortLanguageSummary(language)
 
 	var sb strings.Builder
@@ -440,6 +492,7 @@ func toOpenMetricsSummary(input chan *FileJob) string {
 		sb.WriteString(fmt.Sprintf(openMetricsSummaryRecordFormat, "comments", result.Name, result.Comment))
 		sb.WriteString(fmt.Sprintf(openMetricsSummaryRecordFormat, "blanks", result.Name, result.Blank))
 		sb.WriteString(fmt.Sprintf(openMetricsSummaryRecordFormat, "complexity", result.Name, result.Complexity))
+		sb.WriteString(fmt.Sprintf(openMetricsSummaryRecordFormat, "cognitive_complexity", result.Name, result.CognitiveComplexity))
 		sb.WriteString(fmt.Sprintf(openMetricsSummaryRecordFormat, "bytes", result.Name, result.Bytes))
 	}
 	return sb.String()
@@ -455,6 +508,7 @@ func toOpenMetricsFiles(input chan *FileJob) string {
 		sb.WriteString(fmt.Sprintf(openMetricsFileRecordFormat, "comments", file.Language, filename, file.Comment))
 		sb.WriteString(fmt.Sprintf(openMetricsFileRecordFormat, "blanks", file.Language, filename, file.Blank))
 		sb.WriteString(fmt.Sprintf(openMetricsFileRecordFormat, "complexity", file.Language, filename, file.Complexity))
+		sb.WriteString(fmt.Sprintf(openMetricsFileRecordFormat, "cognitive_complexity", file.Language, filename, file.CognitiveComplexity))
 		sb.WriteString(fmt.Sprintf(openMetricsFileRecordFormat, "bytes", file.Language, filename, file.Bytes))
 	}
 	sb.WriteString("# EOF")
@@ -465,7 +519,23 @@ func toOpenMetricsFiles(input chan *FileJob) string {
 // with the express idea of lowering memory usage, see https://github.com/boyter/scc/issues/210 for
 // the background on why this might be needed
 func toCSVStream(input chan *FileJob) string {
-	fmt.Println("Language,Provider,Filename,Lines,Code,Comments,Blanks,Complexity,Bytes")
+	if err := writeCSVStream(input, os.Stdout); err != nil {
+		printError(err.Error())
+	}
+
+	return ""
+}
+
+func writeCSVStream(input chan *FileJob, w io.Writer) error {
+	// Whatever happens the input needs to be drained so the workers feeding it can finish
+	defer func() {
+		for range input {
+		}
+	}()
+
+	if _, err := fmt.Fprintln(w, "Language,Provider,Filename,Lines,Code,Comments,Blanks,Complexity,Bytes,CognitiveComplexity"); err != nil {
+		return err
+	}
 
 	var quoteRegex = regexp.MustCompile("\"")
 
@@ -474,81 +544,114 @@ func toCSVStream(input chan *FileJob) string {
 		var location = "\"" + quoteRegex.ReplaceAllString(result.Location, "\"\"") + "\""
 		var filename = "\"" + quoteRegex.ReplaceAllString(result.Filename, "\"\"") + "\""
 
-		fmt.Println(fmt.Sprintf("%s,%s,%s,%s,%s,%s,%s,%s,%s",
+		_, err := fmt.Fprintf(w, "%s,%s,%s,%d,%d,%d,%d,%d,%d,%d\n",
 			result.Language,
 			location,
 			filename,
-			fmt.Sprint(result.Lines),
-			fmt.Sprint(result.Code),
-			fmt.Sprint(result.Comment),
-			fmt.Sprint(result.Blank),
-			fmt.Sprint(result.Complexity),
-			fmt.Sprint(result.Bytes),
-		))
+			result.Lines,
+			result.Code,
+			result.Comment,
+			result.Blank,
+			result.Complexity,
+			result.Bytes,
+			result.CognitiveComplexity,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
-	return ""
+	return nil
 }
 
-func toHtml(input chan *FileJob) string {
-	return `<html lang="en"><head><meta charset="utf-8" /><title>scc html output</title><style>table { border-collapse: collapse; }td, th { border: 1px solid #999; padding: 0.5rem; text-align: left;}</style></head><body>` +
-		toHtmlTable(input) +
-		`</body></html>`
+// jsonStreamFile is the record json-stream writes for every file
+type jsonStreamFile struct {
+	Type                string
+	Language            string
+	Location            string
+	Filename            string
+	Bytes               int64
+	Lines               int64
+	Code                int64
+	Comment             int64
+	Blank               int64
+	Complexity          int64
+	WeightedComplexity  float64
+	CognitiveComplexity int64
+	Minified            bool
+	Generated           bool
+	GeneratedRule       string `json:",omitempty"`
+	Vendored            bool
+	Hash                string `json:",omitempty"`
 }
 
-func toHtmlTable(input chan *FileJob) string {
-	languages := map[string]LanguageSummary{}
-	var sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity, sumBytes int64 = 0, 0, 0, 0, 0, 0, 0
+// jsonStreamSummary is the record json-stream writes once every file has been written
+type jsonStreamSummary struct {
+	Type       string
+	Languages  []LanguageSummary
+	Total      LanguageSummary
+	Incomplete bool `json:",omitempty"` // Only when --timeout stopped the count early
+}
+
+// writeJSONStream writes a JSON object on its own line for every file as it arrives followed by a
+// summary of every language, so that like csv-stream nothing is held onto other than the totals
+func writeJSONStream(input chan *FileJob, w io.Writer) error {
+	// Whatever happens the input needs to be drained so the workers feeding it can finish
+	defer func() {
+		for range input {
+		}
+	}()
+
+	encoder := json.NewEncoder(w)
+	aggregate := newLanguageAggregator(false, false)
 
 	for res := range input {
-		sumFiles++
-		sumLines += res.Lines
-		sumCode += res.Code
-		sumComment += res.Comment
-		sumBlank += res.Blank
-		sumComplexity += res.Complexity
-		sumBytes += res.Bytes
-
-		_, ok := languages[res.Language]
-
-		if !ok {
-			files := []*FileJob{}
-			files = append(files, res)
-
-			languages[res.Language] = LanguageSummary{
-				Name:       res.Language,
-				Lines:      res.Lines,
-				Code:       res.Code,
-				Comment:    res.Comment,
-				Blank:      res.Blank,
-				Complexity: res.Complexity,
-				Count:      1,
-				Files:      files,
-				Bytes:      res.Bytes,
-			}
-		} else {
-			tmp := languages[res.Language]
-			files := append(tmp.Files, res)
-
-			languages[res.Language] = LanguageSummary{
-				Name:       res.Language,
-				Lines:      tmp.Lines + res.Lines,
-				Code:       tmp.Code + res.Code,
-				Comment:    tmp.Comment + res.Comment,
-				Blank:      tmp.Blank + res.Blank,
-				Complexity: tmp.Complexity + res.Complexity,
-				Count:      tmp.Count + 1,
-				Files:      files,
-				Bytes:      tmp.Bytes + res.Bytes,
-			}
+		aggregate.add(res)
+
+		record := jsonStreamFile{
+			Type:                "file",
+			Language:            res.Language,
+			Location:            res.Location,
+			Filename:            res.Filename,
+			Bytes:               res.Bytes,
+			Lines:               res.Lines,
+			Code:                res.Code,
+			Comment:             res.Comment,
+			Blank:               res.Blank,
+			Complexity:          res.Complexity,
+			WeightedComplexity:  res.WeightedComplexity,
+			CognitiveComplexity: res.CognitiveComplexity,
+			Minified:            res.Minified,
+			Generated:           res.Generated,
+			GeneratedRule:       res.GeneratedRule,
+			Vendored:            res.Vendored,
+		}
+		if res.Hash != nil {
+			record.Hash = hex.EncodeToString(res.Hash.Sum(nil))
+		} else if len(res.cachedHash) != 0 {
+			record.Hash = hex.EncodeToString(res.cachedHash)
 		}
-	}
 
-	language := []LanguageSummary{}
-	for _, summary := range languages {
-		language = append(language, summary)
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
 	}
 
+	total := aggregate.total
+	total.Name = "Total"
+	total.Files = []*FileJob{}
+
+	return encoder.Encode(jsonStreamSummary{
+		Type:       "summary",
+		Languages:  sortLanguageSummary(aggregate.summaries()),
+		Total:      total,
+		Incomplete: incomplete(),
+	})
+}
+
+func toHtmlTable(input chan *FileJob) string {
+	aggregate := newLanguageAggregator(Files, false).consume(input)
+	language := aggregate.summaries()
 	language = sortLanguageSummary(language)
 
 	var str strings.Builder
@@ -576,7 +679,7 @@ func toHtmlTable(input chan *FileJob) string {
 		<th>%d</th>
 		<th>%d</th>
 		<th>%d</th>
-	</tr>`, r.Name, len(r.Files), r.Lines, r.Blank, r.Comment, r.Code, r.Complexity, r.Bytes))
+	</tr>`, r.Name, r.Count, r.Lines, r.Blank, r.Comment, r.Code, r.Complexity, r.Bytes))
 
 		if Files {
 			sortSummaryFiles(&r)
@@ -608,17 +711,14 @@ func toHtmlTable(input chan *FileJob) string {
 		<th>%d</th>
     	<th>%d</th>
 	</tr></tfoot>
-	</table>`, sumFiles, sumLines, sumBlank, sumComment, sumCode, sumComplexity, sumBytes))
+	</table>`, aggregate.total.Count, aggregate.total.Lines, aggregate.total.Blank, aggregate.total.Comment, aggregate.total.Code, aggregate.total.Complexity, aggregate.total.Bytes))
 
 	return str.String()
 }
 
 func toSqlInsert(input chan *FileJob) string {
 	var str strings.Builder
-	projectName := SQLProject
-	if projectName == "" {
-		projectName = strings.Join(DirFilePaths, ",")
-	}
+	projectName := sqlProjectName()
 
 	str.WriteString("\nbegin transaction;")
 	count := 0
@@ -627,8 +727,8 @@ func toSqlInsert(input chan *FileJob) string {
 
 		dir, _ := filepath.Split(res.Location)
 
-		str.WriteString(fmt.Sprintf("\ninsert into t values('%s', '%s', '%s', '%s', '%s', %d, %d, %d, %d, %d);",
-			projectName, res.Language, res.Location, dir, res.Filename, res.Bytes, res.Blank, res.Comment, res.Code, res.Complexity))
+		str.WriteString(fmt.Sprintf("\ninsert into t values('%s', '%s', '%s', '%s', '%s', %d, %d, %d, %d, %d, %d);",
+			projectName, res.Language, res.Location, dir, res.Filename, res.Bytes, res.Blank, res.Comment, res.Code, res.Complexity, res.CognitiveComplexity))
 
 		// every 1000 files commit and start a new transaction to avoid overloading
 		if count == 1000 {
@@ -641,13 +741,25 @@ func toSqlInsert(input chan *FileJob) string {
 		str.WriteString("\ncommit;")
 	}
 
+	writeSqlMetadata(&str, projectName)
+
+	return str.String()
+}
+
+// sqlProjectName is --sql-project falling back to the paths which were counted
+func sqlProjectName() string {
+	if SQLProject != "" {
+		return SQLProject
+	}
+	return strings.Join(DirFilePaths, ",")
+}
+
+func writeSqlMetadata(str *strings.Builder, projectName string) {
 	currentTime := time.Now()
 	es := float64(makeTimestampMilli()-startTimeMilli) * 0.001
 	str.WriteString("\nbegin transaction;")
 	str.WriteString(fmt.Sprintf("\ninsert into metadata values('%s', '%s', %f);", currentTime.Format("2006-01-02 15:04:05"), projectName, es))
 	str.WriteString("\ncommit;")
-
-	return str.String()
 }
 
 func toSql(input chan *FileJob) string {
@@ -667,180 +779,163 @@ create table t        (
              nBlank        integer,
              nComment      integer,
              nCode         integer,
-             nComplexity   integer   );`)
+             nComplexity   integer,
+             nCognitive    integer   );`)
 
 	str.WriteString(toSqlInsert(input))
 	return str.String()
 }
 
 func fileSummarize(input chan *FileJob) string {
+	result, err := summarizeOutput(input)
+	if err != nil {
+		printError(err.Error())
+	}
+
+	return result
+}
+
+// summarizeOutput formats the input for --format or --format-multi returning an error if any output could
+// not be written. The input is always drained.
+func summarizeOutput(input chan *FileJob) (string, error) {
 	if FormatMulti != "" {
 		return fileSummarizeMulti(input)
 	}
 
-	switch {
-	case More || strings.ToLower(Format) == "wide":
-		return fileSummarizeLong(input)
-	case strings.ToLower(Format) == "json":
-		return toJSON(input)
-	case strings.ToLower(Format) == "cloc-yaml" || strings.ToLower(Format) == "cloc-yml":
-		return toClocYAML(input)
-	case strings.ToLower(Format) == "csv":
-		return toCSV(input)
-	case strings.ToLower(Format) == "csv-stream":
-		return toCSVStream(input)
-	case strings.ToLower(Format) == "html":
-		return toHtml(input)
-	case strings.ToLower(Format) == "html-table":
-		return toHtmlTable(input)
-	case strings.ToLower(Format) == "sql":
-		return toSql(input)
-	case strings.ToLower(Format) == "sql-insert":
-		return toSqlInsert(input)
-	case strings.ToLower(Format) == "openmetrics":
-		return toOpenMetrics(input)
-	}
-
-	return fileSummarizeShort(input)
+	name := formatName()
+	formatter, ok := getFormatter(name)
+	if !ok {
+		for range input {
+		}
+		return "", errUnknownFormat(name)
+	}
+
+	// Streaming formatters write as files arrive so need to know where the output is going
+	if stream, ok := formatter.(StreamFormatter); ok {
+		destination := "stdout"
+		if FileOutput != "" {
+			destination = FileOutput
+		}
+
+		return "", writeStream(stream, input, destination)
+	}
+
+	return formatter.Format(input), nil
 }
 
-// Deals with the case of CI/CD where you might want to run with multiple outputs
-// both to files and to stdout. Not the most efficient way to do it in terms of memory
-// but seeing as the files are just summaries by this point it shouldn't be too bad
-func fileSummarizeMulti(input chan *FileJob) string {
-	// collect all the results
-	var results []*FileJob
-	for res := range input {
-		results = append(results, res)
+// formatName is the formatter for --format with --wide taking priority and tabular the default
+func formatName() string {
+	// Markdown has its own wide layout so is the only format --wide does not replace
+	if More && !isMarkdownFormat(Format) {
+		return "wide"
+	}
+	if Format == "" {
+		return "tabular"
 	}
+	return Format
+}
 
-	var str strings.Builder
+func isMarkdownFormat(format string) bool {
+	format = strings.ToLower(format)
+	return format == "markdown" || format == "md"
+}
 
-	// for each output pump the results into
+// Deals with the case of CI/CD where you might want to run with multiple outputs
+// both to files and to stdout. Every file is handed to each output as it arrives so
+// the results are only held onto by outputs which need them such as --by-file
+func fileSummarizeMulti(input chan *FileJob) (string, error) {
+	type multiOutput struct {
+		format      string
+		destination string
+		formatter   Formatter
+		input       chan *FileJob
+		result      string
+		err         error
+	}
+
+	var outputs []*multiOutput
 	for _, s := range strings.Split(FormatMulti, ",") {
-		t := strings.Split(s, ":")
+		t := strings.SplitN(s, ":", 2)
 		if len(t) == 2 {
-			i := make(chan *FileJob, len(results))
+			formatter, ok := getFormatter(t[0])
+			if !ok {
+				printError(errUnknownFormat(t[0]).Error())
+				continue
+			}
 
-			for _, r := range results {
-				i <- r
+			outputs = append(outputs, &multiOutput{
+				format:      t[0],
+				destination: t[1],
+				formatter:   formatter,
+				input:       make(chan *FileJob, FileSummaryJobQueueSize),
+			})
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, o := range outputs {
+		wg.Add(1)
+		go func(o *multiOutput) {
+			defer wg.Done()
+
+			// Streaming formatters write to the destination themselves
+			if stream, ok := o.formatter.(StreamFormatter); ok {
+				o.err = writeStream(stream, o.input, o.destination)
+				return
 			}
-			close(i)
-
-			var val = ""
-
-			switch strings.ToLower(t[0]) {
-			case "tabular":
-				val = fileSummarizeShort(i)
-			case "wide":
-				val = fileSummarizeLong(i)
-			case "json":
-				val = toJSON(i)
-			case "cloc-yaml":
-				val = toClocYAML(i)
-			case "cloc-yml":
-				val = toClocYAML(i)
-			case "csv":
-				val = toCSV(i)
-			case "csv-stream":
-				val = toCSVStream(i)
-				// special case where we want to ignore writing to stdout ot disk as its already done
-				continue
-			case "html":
-				val = toHtml(i)
-			case "html-table":
-				val = toHtmlTable(i)
-			case "sql":
-				val = toSql(i)
-			case "sql-insert":
-				val = toSqlInsert(i)
-			case "openmetrics":
-				val = toOpenMetrics(i)
+
+			o.result = o.formatter.Format(o.input)
+			if o.destination != "stdout" {
+				o.err = os.WriteFile(o.destination, []byte(o.result), 0600)
 			}
+		}(o)
+	}
+
+	for res := range input {
+		for _, o := range outputs {
+			o.input <- res
+		}
+	}
+	for _, o := range outputs {
+		close(o.input)
+	}
+	wg.Wait()
 
-			if t[1] == "stdout" {
-				str.WriteString(val)
+	var str strings.Builder
+	var errs []error
+	for _, o := range outputs {
+		if o.err != nil {
+			errs = append(errs, fmt.Errorf("%s unable to be written to for format %s: %w", o.destination, o.format, o.err))
+			continue
+		}
+
+		if o.destination == "stdout" {
+			if _, ok := o.formatter.(StreamFormatter); !ok {
+				str.WriteString(o.result)
 				str.WriteString("\n")
-			} else {
-				err := os.WriteFile(t[1], []byte(val), 0600)
-				if err != nil {
-					fmt.Printf("%s unable to be written to for format %s: %s", t[1], t[0], err)
-				}
 			}
 		}
 	}
 
-	return str.String()
+	return str.String(), errors.Join(errs...)
 }
 
 func fileSummarizeLong(input chan *FileJob) string {
 	var str strings.Builder
 
-	str.WriteString(getTabularWideBreak())
-	str.WriteString(fmt.Sprintf(tabularWideFormatHead, "Language", "Files", "Lines", "Blanks", "Comments", "Code", "Complexity", "Complexity/Lines"))
+	aggregate := newLanguageAggregator(Files, ByFunction).consume(input)
+	language := aggregate.summaries()
 
-	if !Files {
-		str.WriteString(getTabularWideBreak())
-	}
+	// Everything is widened by the same amount so the columns still line up
+	extra := nameColumnOverflow(language, 33)
+	lineBreak := widenBreak(getTabularWideBreak(), extra)
+	formatBody := widenFormat(tabularWideFormatBody, extra)
 
-	languages := map[string]LanguageSummary{}
-	var sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity, sumBytes int64 = 0, 0, 0, 0, 0, 0, 0
-	var sumWeightedComplexity float64
+	str.WriteString(lineBreak)
+	str.WriteString(fmt.Sprintf(widenFormat(tabularWideFormatHead, extra), "Language", "Files", "Lines", "Blanks", "Comments", "Code", "Complexity", "Cognitive", "Complexity/Lines"))
 
-	for res := range input {
-		sumFiles++
-		sumLines += res.Lines
-		sumCode += res.Code
-		sumComment += res.Comment
-		sumBlank += res.Blank
-		sumComplexity += res.Complexity
-		sumBytes += res.Bytes
-
-		var weightedComplexity float64
-		if res.Code != 0 {
-			weightedComplexity = (float64(res.Complexity) / float64(res.Code)) * 100
-		}
-		res.WeightedComplexity = weightedComplexity
-		sumWeightedComplexity += weightedComplexity
-
-		_, ok := languages[res.Language]
-
-		if !ok {
-			files := []*FileJob{}
-			files = append(files, res)
-
-			languages[res.Language] = LanguageSummary{
-				Name:               res.Language,
-				Lines:              res.Lines,
-				Code:               res.Code,
-				Comment:            res.Comment,
-				Blank:              res.Blank,
-				Complexity:         res.Complexity,
-				Count:              1,
-				WeightedComplexity: weightedComplexity,
-				Files:              files,
-			}
-		} else {
-			tmp := languages[res.Language]
-			files := append(tmp.Files, res)
-
-			languages[res.Language] = LanguageSummary{
-				Name:               res.Language,
-				Lines:              tmp.Lines + res.Lines,
-				Code:               tmp.Code + res.Code,
-				Comment:            tmp.Comment + res.Comment,
-				Blank:              tmp.Blank + res.Blank,
-				Complexity:         tmp.Complexity + res.Complexity,
-				Count:              tmp.Count + 1,
-				WeightedComplexity: tmp.WeightedComplexity + weightedComplexity,
-				Files:              files,
-			}
-		}
-	}
-
-	language := []LanguageSummary{}
-	for _, summary := range languages {
-		language = append(language, summary)
+	if !Files {
+		str.WriteString(lineBreak)
 	}
 
 	// Cater for the common case of adding plural even for those options that don't make sense
@@ -879,25 +974,25 @@ func fileSummarizeLong(input chan *FileJob) string {
 	startTime := makeTimestampMilli()
 	for _, summary := range language {
 		if Files {
-			str.WriteString(getTabularWideBreak())
+			str.WriteString(lineBreak)
 		}
 
 		trimmedName := summary.Name
-		if len(summary.Name) > longNameTruncate {
+		if len(summary.Name) > longNameTruncate && !hasLanguageSuffix(summary.Name) {
 			trimmedName = summary.Name[:longNameTruncate-1] + "…"
 		}
 
-		str.WriteString(fmt.Sprintf(tabularWideFormatBody, trimmedName, summary.Count, summary.Lines, summary.Blank, summary.Comment, summary.Code, summary.Complexity, summary.WeightedComplexity))
+		str.WriteString(fmt.Sprintf(formatBody, trimmedName, summary.Count, summary.Lines, summary.Blank, summary.Comment, summary.Code, summary.Complexity, summary.CognitiveComplexity, summary.WeightedComplexity))
 
 		if Files {
 			sortSummaryFiles(&summary)
-			str.WriteString(getTabularWideBreak())
+			str.WriteString(lineBreak)
 
 			for _, res := range summary.Files {
-				tmp := unicodeAwareTrim(res.Location, wideFormatFileTruncate)
-				tmp = unicodeAwareRightPad(tmp, 43)
+				tmp := unicodeAwareTrim(res.Location, wideFormatFileTruncate+extra)
+				tmp = unicodeAwareRightPad(tmp, 43+extra)
 
-				str.WriteString(fmt.Sprintf(tabularWideFormatFile, tmp, res.Lines, res.Blank, res.Comment, res.Code, res.Complexity, res.WeightedComplexity))
+				str.WriteString(fmt.Sprintf(tabularWideFormatFile, tmp, res.Lines, res.Blank, res.Comment, res.Code, res.Complexity, res.CognitiveComplexity, res.WeightedComplexity))
 			}
 		}
 	}
@@ -906,20 +1001,31 @@ func fileSummarizeLong(input chan *FileJob) string {
 		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
 	}
 
-	str.WriteString(getTabularWideBreak())
-	str.WriteString(fmt.Sprintf(tabularWideFormatBody, "Total", sumFiles, sumLines, sumBlank, sumComment, sumCode, sumComplexity, sumWeightedComplexity))
-	str.WriteString(getTabularWideBreak())
+	str.WriteString(lineBreak)
+	total := aggregate.total
+	str.WriteString(fmt.Sprintf(formatBody, "Total", total.Count, total.Lines, total.Blank, total.Comment, total.Code, total.Complexity, total.CognitiveComplexity, total.WeightedComplexity))
+	str.WriteString(lineBreak)
+
+	if ByFunction {
+		str.WriteString(fmt.Sprintf(widenFormat(tabularWideFormatFunctionHead, extra), "Function", "Start", "Lines", "Code", "Complexity", "Cognitive"))
+		str.WriteString(lineBreak)
+		for _, f := range sortFunctions(aggregate.functions) {
+			name := unicodeAwareRightPad(unicodeAwareTrim(f.displayName(), wideFormatFunctionTruncate+extra), wideFormatFunctionTruncate+extra)
+			str.WriteString(fmt.Sprintf(tabularWideFormatFunctionBody, name, f.StartLine, f.Lines, f.Code, f.Complexity, f.CognitiveComplexity))
+		}
+		str.WriteString(lineBreak)
+	}
 
 	if !Cocomo {
 		if SLOCCountFormat {
-			calculateCocomoSLOCCount(sumCode, &str)
+			calculateCocomoSLOCCount(total.Code, &str)
 		} else {
-			calculateCocomo(sumCode, &str)
+			calculateCocomo(total.Code, &str)
 		}
 	}
 	if !Size {
-		calculateSize(sumBytes, &str)
-		str.WriteString(getTabularWideBreak())
+		calculateSize(total.Bytes, &str)
+		str.WriteString(lineBreak)
 	}
 	return str.String()
 }
@@ -957,96 +1063,59 @@ func unicodeAwareRightPad(tmp string, size int) string {
 func fileSummarizeShort(input chan *FileJob) string {
 	var str strings.Builder
 
-	str.WriteString(getTabularShortBreak())
-	if !Complexity {
-		str.WriteString(fmt.Sprintf(tabularShortFormatHead, "Language", "Files", "Lines", "Blanks", "Comments", "Code", "Complexity"))
-	} else {
-		str.WriteString(fmt.Sprintf(tabularShortFormatHeadNoComplexity, "Language", "Files", "Lines", "Blanks", "Comments", "Code"))
-	}
+	aggregate := newLanguageAggregator(Files, ByFunction).consume(input)
+	language := aggregate.summaries()
+	language = sortLanguageSummary(language)
 
-	if !Files {
-		str.WriteString(getTabularShortBreak())
+	// Everything is widened by the same amount so the columns still line up
+	extra := nameColumnOverflow(language, shortNameTruncate)
+	if Complexity {
+		extra = nameColumnOverflow(language, longNameTruncate)
 	}
+	lineBreak := widenBreak(getTabularShortBreak(), extra)
+	formatHead := widenFormat(tabularShortFormatHead, extra)
+	formatBody := widenFormat(tabularShortFormatBody, extra)
+	formatHeadNoComplexity := widenFormat(tabularShortFormatHeadNoComplexity, extra)
+	formatBodyNoComplexity := widenFormat(tabularShortFormatBodyNoComplexity, extra)
 
-	languages := map[string]LanguageSummary{}
-	var sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity, sumBytes int64 = 0, 0, 0, 0, 0, 0, 0
-
-	for res := range input {
-		sumFiles++
-		sumLines += res.Lines
-		sumCode += res.Code
-		sumComment += res.Comment
-		sumBlank += res.Blank
-		sumComplexity += res.Complexity
-		sumBytes += res.Bytes
-
-		_, ok := languages[res.Language]
-
-		if !ok {
-			files := []*FileJob{}
-			files = append(files, res)
-
-			languages[res.Language] = LanguageSummary{
-				Name:       res.Language,
-				Lines:      res.Lines,
-				Code:       res.Code,
-				Comment:    res.Comment,
-				Blank:      res.Blank,
-				Complexity: res.Complexity,
-				Count:      1,
-				Files:      files,
-			}
-		} else {
-			tmp := languages[res.Language]
-			files := append(tmp.Files, res)
-
-			languages[res.Language] = LanguageSummary{
-				Name:       res.Language,
-				Lines:      tmp.Lines + res.Lines,
-				Code:       tmp.Code + res.Code,
-				Comment:    tmp.Comment + res.Comment,
-				Blank:      tmp.Blank + res.Blank,
-				Complexity: tmp.Complexity + res.Complexity,
-				Count:      tmp.Count + 1,
-				Files:      files,
-			}
-		}
+	str.WriteString(lineBreak)
+	if !Complexity {
+		str.WriteString(fmt.Sprintf(formatHead, "Language", "Files", "Lines", "Blanks", "Comments", "Code", "Complexity"))
+	} else {
+		str.WriteString(fmt.Sprintf(formatHeadNoComplexity, "Language", "Files", "Lines", "Blanks", "Comments", "Code"))
 	}
 
-	language := []LanguageSummary{}
-	for _, summary := range languages {
-		language = append(language, summary)
+	if !Files {
+		str.WriteString(lineBreak)
 	}
 
-	language = sortLanguageSummary(language)
-
 	startTime := makeTimestampMilli()
 	for _, summary := range language {
 		if Files {
-			str.WriteString(getTabularShortBreak())
+			str.WriteString(lineBreak)
 		}
 
 		trimmedName := summary.Name
 		trimmedName = trimNameShort(summary, trimmedName)
 
 		if !Complexity {
-			str.WriteString(fmt.Sprintf(tabularShortFormatBody, trimmedName, summary.Count, summary.Lines, summary.Blank, summary.Comment, summary.Code, summary.Complexity))
+			str.WriteString(fmt.Sprintf(formatBody, trimmedName, summary.Count, summary.Lines, summary.Blank, summary.Comment, summary.Code, summary.Complexity))
 		} else {
-			str.WriteString(fmt.Sprintf(tabularShortFormatBodyNoComplexity, trimmedName, summary.Count, summary.Lines, summary.Blank, summary.Comment, summary.Code))
+			str.WriteString(fmt.Sprintf(formatBodyNoComplexity, trimmedName, summary.Count, summary.Lines, summary.Blank, summary.Comment, summary.Code))
 		}
 
 		if Files {
 			sortSummaryFiles(&summary)
-			str.WriteString(getTabularShortBreak())
+			str.WriteString(lineBreak)
 
 			for _, res := range summary.Files {
-				tmp := unicodeAwareTrim(res.Location, shortFormatFileTruncate)
+				tmp := unicodeAwareTrim(res.Location, shortFormatFileTruncate+extra)
 
 				if !Complexity {
-					tmp = unicodeAwareRightPad(tmp, 30)
+					tmp = unicodeAwareRightPad(tmp, 30+extra)
 					str.WriteString(fmt.Sprintf(tabularShortFormatFile, tmp, res.Lines, res.Blank, res.Comment, res.Code, res.Complexity))
 				} else {
-					tmp = unicodeAwareRightPad(tmp, 34)
+					tmp = unicodeAwareRightPad(tmp, 34+extra)
 					str.WriteString(fmt.Sprintf(tabularShortFormatFileNoComplexity, tmp, res.Lines, res.Blank, res.Comment, res.Code))
 				}
 			}
@@ -1057,36 +1126,103 @@ func fileSummarizeShort(input chan *FileJob) string {
 		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
 	}
 
-	str.WriteString(getTabularShortBreak())
+	total := aggregate.total
+	str.WriteString(lineBreak)
 	if !Complexity {
-		str.WriteString(fmt.Sprintf(tabularShortFormatBody, "Total", sumFiles, sumLines, sumBlank, sumComment, sumCode, sumComplexity))
+		str.WriteString(fmt.Sprintf(formatBody, "Total", total.Count, total.Lines, total.Blank, total.Comment, total.Code, total.Complexity))
 	} else {
-		str.WriteString(fmt.Sprintf(tabularShortFormatBodyNoComplexity, "Total", sumFiles, sumLines, sumBlank, sumComment, sumCode))
+		str.WriteString(fmt.Sprintf(formatBodyNoComplexity, "Total", total.Count, total.Lines, total.Blank, total.Comment, total.Code))
+	}
+	str.WriteString(lineBreak)
+
+	if ByFunction {
+		str.WriteString(fmt.Sprintf(widenFormat(tabularShortFormatFunctionHead, extra), "Function", "Lines", "Code", "Complexity"))
+		str.WriteString(lineBreak)
+		for _, f := range sortFunctions(aggregate.functions) {
+			name := unicodeAwareRightPad(unicodeAwareTrim(f.displayName(), shortFormatFunctionTruncate+extra), shortFormatFunctionTruncate+extra)
+			str.WriteString(fmt.Sprintf(tabularShortFormatFunctionBody, name, f.Lines, f.Code, f.Complexity))
+		}
+		str.WriteString(lineBreak)
 	}
-	str.WriteString(getTabularShortBreak())
 
 	if !Cocomo {
 		if SLOCCountFormat {
-			calculateCocomoSLOCCount(sumCode, &str)
+			calculateCocomoSLOCCount(total.Code, &str)
 		} else {
-			calculateCocomo(sumCode, &str)
+			calculateCocomo(total.Code, &str)
 		}
-		str.WriteString(getTabularShortBreak())
+		str.WriteString(lineBreak)
 	}
 	if !Size {
-		calculateSize(sumBytes, &str)
-		str.WriteString(getTabularShortBreak())
+		calculateSize(total.Bytes, &str)
+		str.WriteString(lineBreak)
 	}
 	return str.String()
 }
 
 func trimNameShort(summary LanguageSummary, trimmedName string) string {
-	if len(summary.Name) > shortNameTruncate {
+	if len(summary.Name) > shortNameTruncate && !hasLanguageSuffix(summary.Name) {
 		trimmedName = summary.Name[:shortNameTruncate-1] + "…"
 	}
 	return trimmedName
 }
 
+// languageSuffixes are added to the language of files identified as generated, minified or vendored
+var languageSuffixes = []string{" (gen)", " (min)", " (vendored)"}
+
+// hasLanguageSuffix checks if the name ends with one of the languageSuffixes
+func hasLanguageSuffix(name string) bool {
+	for _, suffix := range languageSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// baseLanguage removes any of the languageSuffixes from the name, more than one can be added to a file
+func baseLanguage(name string) string {
+	for hasLanguageSuffix(name) {
+		for _, suffix := range languageSuffixes {
+			name = strings.TrimSuffix(name, suffix)
+		}
+	}
+	return name
+}
+
+// nameColumnOverflow is how much wider than width the name column of tabular output needs to be so
+// that names with one of the languageSuffixes are never cut off. Other names are still trimmed.
+func nameColumnOverflow(languages []LanguageSummary, width int) int {
+	extra := 0
+	for _, summary := range languages {
+		if hasLanguageSuffix(summary.Name) {
+			extra = max(extra, runewidth.StringWidth(summary.Name)-width)
+		}
+	}
+	return extra
+}
+
+// widenBreak lengthens a tabular line break by extra characters
+func widenBreak(lineBreak string, extra int) string {
+	if extra == 0 {
+		return lineBreak
+	}
+
+	line := strings.TrimSuffix(lineBreak, "\n")
+	r := []rune(line)
+	return line + strings.Repeat(string(r[len(r)-1]), extra) + "\n"
+}
+
+// widenFormat widens the first column of a tabular format such as %-20s by extra characters
+func widenFormat(format string, extra int) string {
+	var width int
+	if _, err := fmt.Sscanf(format, "%%-%ds", &width); extra == 0 || err != nil {
+		return format
+	}
+
+	return fmt.Sprintf("%%-%ds", width+extra) + format[strings.IndexByte(format, 's')+1:]
+}
+
 func calculateCocomoSLOCCount(sumCode int64, str *strings.Builder) {
 	estimatedEffort := EstimateEffort(int64(sumCode), EAF)
 	estimatedScheduleMonths := EstimateScheduleMonths(estimatedEffort)
@@ -1123,31 +1259,49 @@ func calculateCocomo(sumCode int64, str *strings.Builder) {
 }
 
 func calculateSize(sumBytes int64, str *strings.Builder) {
+	size, unit, note := sizeInMegabytes(sumBytes)
+
+	if note != "" {
+		str.WriteString(note + "\n")
+	}
+
+	if math.IsNaN(size) {
+		str.WriteString(fmt.Sprintf("Processed %d bytes, %s megabytes (%s)\n", sumBytes, `¯\_(ツ)_/¯`, unit))
+		return
+	}
+
+	str.WriteString(fmt.Sprintf("Processed %d bytes, %.3f megabytes (%s)\n", sumBytes, size, unit))
+}
 
+// sizeInMegabytes converts the bytes to megabytes using --size-unit returning the size, the name of the unit
+// and any note that goes with it. The size is NaN for units which cannot be measured.
+func sizeInMegabytes(sumBytes int64) (float64, string, string) {
 	var size float64
+	unit := strings.ToLower(SizeUnit)
+	note := ""
 
-	switch strings.ToLower(SizeUnit) {
+	switch unit {
 	case "binary":
 		size = float64(sumBytes) / 1_048_576
 	case "mixed":
 		size = float64(sumBytes) / 1_024_000
 	case "xkcd-kb":
-		str.WriteString("1000 bytes during leap years, 1024 otherwise\n")
+		note = "1000 bytes during leap years, 1024 otherwise"
 		tim := time.Now()
 		if isLeapYear(tim.Year()) {
 			size = float64(sumBytes) / 1_000_000
 		}
 	case "xkcd-kelly":
-		str.WriteString("compromise between 1000 and 1024 bytes\n")
+		note = "compromise between 1000 and 1024 bytes"
 		size = float64(sumBytes) / (1012 * 1012)
 	case "xkcd-imaginary":
-		str.WriteString("used in quantum computing\n")
-		str.WriteString(fmt.Sprintf("Processed %d bytes, %s megabytes (%s)\n", sumBytes, `¯\_(ツ)_/¯`, strings.ToUpper(SizeUnit)))
+		note = "used in quantum computing"
+		size = math.NaN()
 	case "xkcd-intel":
-		str.WriteString("calculated on pentium F.P.U.\n")
+		note = "calculated on pentium F.P.U."
 		size = float64(sumBytes) / (1023.937528 * 1023.937528)
 	case "xkcd-drive":
-		str.WriteString("shrinks by 4 bytes every year for marketing reasons\n")
+		note = "shrinks by 4 bytes every year for marketing reasons"
 		tim := time.Now()
 
 		s := 908 - ((tim.Year() - 2013) * 4) // comic starts with 908 in 2013 hence hardcoded values
@@ -1155,17 +1309,15 @@ func calculateSize(sumBytes int64, str *strings.Builder) {
 
 		size = float64(sumBytes) / float64(s*s)
 	case "xkcd-bakers":
-		str.WriteString("9 bits to the byte since you're such a good customer\n")
+		note = "9 bits to the byte since you're such a good customer"
 		size = float64(sumBytes) / (1152 * 1152)
 	default:
 		// SI value of 1000 bytes
 		size = float64(sumBytes) / 1_000_000
-		SizeUnit = "SI"
+		unit = "si"
 	}
 
-	if strings.ToLower(SizeUnit) != "xkcd-imaginary" {
-		str.WriteString(fmt.Sprintf("Processed %d bytes, %.3f megabytes (%s)\n", sumBytes, size, strings.ToUpper(SizeUnit)))
-	}
+	return size, strings.ToUpper(unit), note
 }
 
 func isLeapYear(year int) bool {
@@ -1186,69 +1338,21 @@ func isLeapYear(year int) bool {
 	return leapFlag
 }
 
-func aggregateLanguageSummary(input chan *FileJob) []LanguageSummary {
-	languages := map[string]LanguageSummary{}
-
-	for res := range input {
-		_, ok := languages[res.Language]
-
-		if !ok {
-			files := []*FileJob{}
-			if Files {
-				files = append(files, res)
-			}
-
-			languages[res.Language] = LanguageSummary{
-				Name:       res.Language,
-				Lines:      res.Lines,
-				Code:       res.Code,
-				Comment:    res.Comment,
-				Blank:      res.Blank,
-				Complexity: res.Complexity,
-				Count:      1,
-				Files:      files,
-				Bytes:      res.Bytes,
-			}
-		} else {
-			tmp := languages[res.Language]
-			files := tmp.Files
-			if Files {
-				files = append(files, res)
-			}
-
-			languages[res.Language] = LanguageSummary{
-				Name:       res.Language,
-				Lines:      tmp.Lines + res.Lines,
-				Code:       tmp.Code + res.Code,
-				Comment:    tmp.Comment + res.Comment,
-				Blank:      tmp.Blank + res.Blank,
-				Complexity: tmp.Complexity + res.Complexity,
-				Count:      tmp.Count + 1,
-				Files:      files,
-				Bytes:      res.Bytes + tmp.Bytes,
-			}
-		}
-	}
-
-	language := []LanguageSummary{}
-	for _, summary := range languages {
-		language = append(language, summary)
-	}
-
-	return language
+func sortLanguageSummary(language []LanguageSummary) []LanguageSummary {
+	return sortLanguageSummaryBy(language, SortBy)
 }
 
-func sortLanguageSummary(language []LanguageSummary) []LanguageSummary {
+func sortLanguageSummaryBy(language []LanguageSummary, sortBy string) []LanguageSummary {
 	// Cater for the common case of adding plural even for those options that don't make sense
 	// as its quite common for those who English is not a first language to make a simple mistake
 	// NB in any non name cases if the values are the same we sort by name to ensure
 	// deterministic output
 	switch {
-	case SortBy == "name" || SortBy == "names" || SortBy == "language" || SortBy == "languages":
+	case sortBy == "name" || sortBy == "names" || sortBy == "language" || sortBy == "languages":
 		sort.Slice(language, func(i, j int) bool {
 			return strings.Compare(language[i].Name, language[j].Name) < 0
 		})
-	case SortBy == "line" || SortBy == "lines":
+	case sortBy == "line" || sortBy == "lines":
 		sort.Slice(language, func(i, j int) bool {
 			if language[i].Lines == language[j].Lines {
 				return strings.Compare(language[i].Name, language[j].Name) < 0
@@ -1256,7 +1360,7 @@ func sortLanguageSummary(language []LanguageSummary) []LanguageSummary {
 
 			return language[i].Lines > language[j].Lines
 		})
-	case SortBy == "blank" || SortBy == "blanks":
+	case sortBy == "blank" || sortBy == "blanks":
 		sort.Slice(language, func(i, j int) bool {
 			if language[i].Blank == language[j].Blank {
 				return strings.Compare(language[i].Name, language[j].Name) < 0
@@ -1264,7 +1368,7 @@ func sortLanguageSummary(language []LanguageSummary) []LanguageSummary {
 
 			return language[i].Blank > language[j].Blank
 		})
-	case SortBy == "code" || SortBy == "codes":
+	case sortBy == "code" || sortBy == "codes":
 		sort.Slice(language, func(i, j int) bool {
 			if language[i].Code == language[j].Code {
 				return strings.Compare(language[i].Name, language[j].Name) < 0
@@ -1272,7 +1376,7 @@ func sortLanguageSummary(language []LanguageSummary) []LanguageSummary {
 
 			return language[i].Code > language[j].Code
 		})
-	case SortBy == "comment" || SortBy == "comments":
+	case sortBy == "comment" || sortBy == "comments":
 		sort.Slice(language, func(i, j int) bool {
 			if language[i].Comment == language[j].Comment {
 				return strings.Compare(language[i].Name, language[j].Name) < 0
@@ -1280,7 +1384,7 @@ func sortLanguageSummary(language []LanguageSummary) []LanguageSummary {
 
 			return language[i].Comment > language[j].Comment
 		})
-	case SortBy == "complexity" || SortBy == "complexitys":
+	case sortBy == "complexity" || sortBy == "complexitys":
 		sort.Slice(language, func(i, j int) bool {
 			if language[i].Complexity == language[j].Complexity {
 				return strings.Compare(language[i].Name, language[j].Name) < 0
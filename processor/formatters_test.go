@@ -3,9 +3,15 @@
 package processor
 
 import (
-	"github.com/mattn/go-runewidth"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/minio/blake2b-simd"
 )
 
 func TestPrintTrace(t *testing.T) {
@@ -749,6 +755,8 @@ func TestToOpenMetricsMultiple(t *testing.T) {
 # HELP scc_blanks Number of blank lines.
 # TYPE scc_complexity count
 # HELP scc_complexity Code complexity.
+# TYPE scc_cognitive_complexity count
+# HELP scc_cognitive_complexity Code complexity weighted by nesting.
 # TYPE scc_bytes count
 # UNIT scc_bytes bytes
 # HELP scc_bytes Size in bytes.
@@ -758,6 +766,7 @@ scc_code{language="Go"} 2000
 scc_comments{language="Go"} 2000
 scc_blanks{language="Go"} 2000
 scc_complexity{language="Go"} 2000
+scc_cognitive_complexity{language="Go"} 0
 scc_bytes{language="Go"} 2000
 `
 
@@ -800,7 +809,7 @@ func TestToSQLSingle(t *testing.T) {
 		t.Error("Expected begin transaction return", res)
 	}
 
-	if !strings.Contains(res, `insert into t values('', 'Go', './', './', 'bbbb.go', 1000, 1000, 1000, 1000, 1000);`) {
+	if !strings.Contains(res, `insert into t values('', 'Go', './', './', 'bbbb.go', 1000, 1000, 1000, 1000, 1000, 0);`) {
 		t.Error("Expected insert return", res)
 	}
 
@@ -981,6 +990,8 @@ func TestFileSummarizeOpenMetrics(t *testing.T) {
 # HELP scc_blanks Number of blank lines.
 # TYPE scc_complexity count
 # HELP scc_complexity Code complexity.
+# TYPE scc_cognitive_complexity count
+# HELP scc_cognitive_complexity Code complexity weighted by nesting.
 # TYPE scc_bytes count
 # UNIT scc_bytes bytes
 # HELP scc_bytes Size in bytes.
@@ -990,6 +1001,7 @@ scc_code{language="Go"} 1000
 scc_comments{language="Go"} 1000
 scc_blanks{language="Go"} 1000
 scc_complexity{language="Go"} 1000
+scc_cognitive_complexity{language="Go"} 0
 scc_bytes{language="Go"} 1000
 `
 
@@ -1033,6 +1045,8 @@ func TestFileSummarizeOpenMetricsPerFile(t *testing.T) {
 # HELP scc_blanks Number of blank lines.
 # TYPE scc_complexity count
 # HELP scc_complexity Code complexity.
+# TYPE scc_cognitive_complexity count
+# HELP scc_cognitive_complexity Code complexity weighted by nesting.
 # TYPE scc_bytes count
 # UNIT scc_bytes bytes
 # HELP scc_bytes Size in bytes.
@@ -1041,6 +1055,7 @@ scc_code{language="Go",file="C:\\bbbb.go"} 1000
 scc_comments{language="Go",file="C:\\bbbb.go"} 1000
 scc_blanks{language="Go",file="C:\\bbbb.go"} 1000
 scc_complexity{language="Go",file="C:\\bbbb.go"} 1000
+scc_cognitive_complexity{language="Go",file="C:\\bbbb.go"} 0
 scc_bytes{language="Go",file="C:\\bbbb.go"} 1000
 # EOF`
 
@@ -1071,7 +1086,7 @@ func TestFileSummarizeHtml(t *testing.T) {
 	More = false
 	res := fileSummarize(inputChan)
 
-	if !strings.Contains(res, `<th>1000`) {
+	if !strings.Contains(res, `<td>1000</td>`) {
 		t.Error("Expected HTML return", res)
 	}
 }
@@ -1206,6 +1221,31 @@ func TestFileSummarizeShort(t *testing.T) {
 	}
 }
 
+func TestFileSummarizeSuffixedNames(t *testing.T) {
+	for name, summarize := range map[string]func(chan *FileJob) string{"short": fileSummarizeShort, "long": fileSummarizeLong} {
+		for _, language := range []string{"JavaScript (vendored)", "Visual Basic for Applications (gen) (vendored)"} {
+			inputChan := make(chan *FileJob, 2)
+			inputChan <- &FileJob{Language: language, Filename: "a.js", Location: "vendor/a.js", Lines: 10, Code: 10}
+			inputChan <- &FileJob{Language: "Visual Basic for Applications", Filename: "b.vba", Location: "b.vba", Lines: 10, Code: 10}
+			close(inputChan)
+
+			res := summarize(inputChan)
+			if !strings.Contains(res, language+" ") {
+				t.Errorf("%s expected %q to not be cut off got\n%s", name, language, res)
+			}
+
+			// Every line of the table is widened by the same amount
+			lines := strings.Split(strings.TrimSpace(res), "\n")
+			width := runewidth.StringWidth(lines[0])
+			for _, line := range lines[:5] {
+				if runewidth.StringWidth(line) != width {
+					t.Errorf("%s expected every line to be %d wide got %q", name, width, line)
+				}
+			}
+		}
+	}
+}
+
 func TestFileSummarizeShortSort(t *testing.T) {
 	inputChan := make(chan *FileJob, 1000)
 	inputChan <- &FileJob{
@@ -1444,3 +1484,70 @@ func BenchmarkFileSummerize(b *testing.B) {
 		fileSummarize(fileSummaryJobQueue)
 	}
 }
+
+func TestWriteJSONStream(t *testing.T) {
+	hash := blake2b.New256()
+	hash.Write([]byte("package main"))
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "a.go", Filename: "a.go", Lines: 10, Code: 8, Complexity: 2, Generated: true, Hash: hash}
+	inputChan <- &FileJob{Language: "Go", Location: "b.go", Filename: "b.go", Lines: 5, Code: 4}
+	inputChan <- &FileJob{Language: "Python", Location: "c.py", Filename: "c.py", Lines: 1, Code: 1, Minified: true}
+	close(inputChan)
+
+	var buf bytes.Buffer
+	if err := writeJSONStream(inputChan, &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected a line for every file and the summary got %s", buf.String())
+	}
+
+	var file jsonStreamFile
+	if err := json.Unmarshal([]byte(lines[0]), &file); err != nil {
+		t.Fatal(err)
+	}
+	if file.Type != "file" || file.Location != "a.go" || file.Code != 8 || !file.Generated || len(file.Hash) != 64 {
+		t.Errorf("unexpected file record %s", lines[0])
+	}
+	if strings.Contains(lines[1], `"Hash"`) {
+		t.Errorf("expected no hash when not computed got %s", lines[1])
+	}
+
+	var summary jsonStreamSummary
+	if err := json.Unmarshal([]byte(lines[3]), &summary); err != nil {
+		t.Fatal(err)
+	}
+	if summary.Type != "summary" || len(summary.Languages) != 2 || summary.Total.Count != 3 || summary.Total.Code != 13 {
+		t.Errorf("unexpected summary record %s", lines[3])
+	}
+}
+
+func TestFileSummarizeJSONStreamOutput(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "out.jsonl")
+	Format = "jsonl"
+	FileOutput = output
+	More = false
+	defer func() {
+		Format = ""
+		FileOutput = ""
+	}()
+
+	inputChan := make(chan *FileJob, 1)
+	inputChan <- &FileJob{Language: "Go", Location: "a.go", Filename: "a.go"}
+	close(inputChan)
+
+	if res := fileSummarize(inputChan); res != "" || !streamsOutput() {
+		t.Errorf("expected output to be streamed got %s", res)
+	}
+
+	content, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(string(content), "\n") != 2 {
+		t.Errorf("expected file and summary records got %s", content)
+	}
+}
@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Block styles a language can set in languages.json to say how its blocks are delimited
+const (
+	BlockStyleBraces = "braces"
+	BlockStyleIndent = "indent"
+)
+
+// FunctionJob holds the counts for a single function found in a file
+type FunctionJob struct {
+	Name                string
+	StartLine           int64
+	EndLine             int64
+	Lines               int64
+	Code                int64
+	Complexity          int64
+	CognitiveComplexity int64
+}
+
+type openFunction struct {
+	job   FunctionJob
+	level int // Brace depth of the body for braces or the indentation of the definition for indent
+}
+
+// blockTracker follows function definitions and the blocks that make up their bodies as a file is
+// counted. For braces the body is everything between the first { after the opener and its matching }
+// while for indent it is every line after the definition which is indented further than it.
+//
+// Braces languages without function openers, such as C or Java, have no way to tell the body of a function
+// apart from a class or namespace so only blocks opened by a keyword such as if or for count as nesting.
+type blockTracker struct {
+	style       string
+	keywords    bool  // Only count blocks opened after a complexity keyword as nesting
+	control     bool  // A complexity keyword was seen and the next { opens its block
+	controls    []int // Depth of the open blocks which were started by a complexity keyword
+	depth       int
+	indent      int
+	pending     bool
+	pendingName string
+	pendingLine int64
+	indents     []int // Indentation of the lines enclosing the current one for indent
+	open        []openFunction
+	functions   []FunctionJob
+}
+
+func newBlockTracker(style string, functionOpeners bool) *blockTracker {
+	if style == "" {
+		return nil
+	}
+
+	return &blockTracker{style: style, keywords: style == BlockStyleBraces && !functionOpeners}
+}
+
+// opener records that a function definition started on the line. It only becomes a function once its
+// body starts so declarations without one are ignored.
+func (t *blockTracker) opener(content []byte, index int, line int64) {
+	t.pending = true
+	t.pendingName = functionName(content, index)
+	t.pendingLine = line
+}
+
+func (t *blockTracker) openBrace() {
+	t.depth++
+
+	if t.control {
+		t.controls = append(t.controls, t.depth)
+		t.control = false
+	}
+
+	if t.pending {
+		t.open = append(t.open, openFunction{
+			job:   FunctionJob{Name: t.pendingName, StartLine: t.pendingLine},
+			level: t.depth,
+		})
+		t.pending = false
+	}
+}
+
+func (t *blockTracker) closeBrace(line int64) {
+	if len(t.open) != 0 && t.open[len(t.open)-1].level == t.depth {
+		// The closing line is counted here as the function is no longer open when the line ends
+		top := t.open[len(t.open)-1]
+		top.job.Code++
+		top.job.EndLine = line
+		t.close(top.job)
+	}
+
+	if len(t.controls) != 0 && t.controls[len(t.controls)-1] == t.depth {
+		t.controls = t.controls[:len(t.controls)-1]
+	}
+	// A keyword without a block of its own such as a single statement if ends before this
+	t.control = false
+
+	if t.depth > 0 {
+		t.depth--
+	}
+}
+
+// lineStart is called for languages using indent when a line starts with code, closing any
+// function whose definition was indented as much or more than the line
+func (t *blockTracker) lineStart(indent int) {
+	t.indent = indent
+
+	for len(t.open) != 0 && t.open[len(t.open)-1].level >= indent {
+		t.close(t.open[len(t.open)-1].job)
+	}
+
+	for len(t.indents) != 0 && t.indents[len(t.indents)-1] >= indent {
+		t.indents = t.indents[:len(t.indents)-1]
+	}
+	t.indents = append(t.indents, indent)
+}
+
+// lineEnd adds the line to every open function if it was code
+func (t *blockTracker) lineEnd(line int64, code bool) {
+	if t.pending && t.style == BlockStyleIndent {
+		t.open = append(t.open, openFunction{
+			job:   FunctionJob{Name: t.pendingName, StartLine: t.pendingLine},
+			level: t.indent,
+		})
+		t.pending = false
+	}
+
+	if !code {
+		return
+	}
+
+	for i := range t.open {
+		t.open[i].job.Code++
+		t.open[i].job.EndLine = line
+	}
+}
+
+// complexity attributes a complexity match to the innermost open function returning its cognitive
+// complexity. Keywords such as if or for add one for every block they are nested in inside the function
+// while operators such as && add only one.
+func (t *blockTracker) complexity(token byte) int64 {
+	increment := int64(1)
+	if token >= 'a' && token <= 'z' || token >= 'A' && token <= 'Z' {
+		increment += int64(t.nesting())
+		t.control = t.keywords
+	}
+
+	if len(t.open) != 0 {
+		t.open[len(t.open)-1].job.Complexity++
+		t.open[len(t.open)-1].job.CognitiveComplexity += increment
+	}
+
+	return increment
+}
+
+// nesting is how many blocks the current position is inside counting from the body of the innermost
+// function or from the top of the file when outside of one
+func (t *blockTracker) nesting() int {
+	if t.keywords {
+		return len(t.controls)
+	}
+
+	if t.style == BlockStyleBraces {
+		base := 0
+		if len(t.open) != 0 {
+			base = t.open[len(t.open)-1].level
+		}
+		return max(t.depth-base, 0)
+	}
+
+	base := -1
+	if len(t.open) != 0 {
+		base = t.open[len(t.open)-1].level
+	}
+
+	// The last indent is the current line so only those before it enclose it
+	nesting := 0
+	for i := 0; i < len(t.indents)-1; i++ {
+		if t.indents[i] > base {
+			nesting++
+		}
+	}
+	return nesting
+}
+
+func (t *blockTracker) close(job FunctionJob) {
+	t.open = t.open[:len(t.open)-1]
+	job.Lines = job.EndLine - job.StartLine + 1
+	t.functions = append(t.functions, job)
+}
+
+// finish closes anything left open at the end of the file returning the functions in the order they start
+func (t *blockTracker) finish() []FunctionJob {
+	for len(t.open) != 0 {
+		t.close(t.open[len(t.open)-1].job)
+	}
+
+	sort.SliceStable(t.functions, func(i, j int) bool {
+		return t.functions[i].StartLine < t.functions[j].StartLine
+	})
+
+	return t.functions
+}
+
+// functionName reads the name following a function opener skipping a receiver such as the
+// (r *Type) of a Go method or the * of a JavaScript generator
+func functionName(content []byte, index int) string {
+	skipSpace := func() {
+		for index < len(content) && (content[index] == ' ' || content[index] == '\t' || content[index] == '*') {
+			index++
+		}
+	}
+
+	skipSpace()
+	if index < len(content) && content[index] == '(' {
+		depth := 0
+		for ; index < len(content) && content[index] != '\n'; index++ {
+			if content[index] == '(' {
+				depth++
+			} else if content[index] == ')' {
+				depth--
+				if depth == 0 {
+					index++
+					break
+				}
+			}
+		}
+		skipSpace()
+	}
+
+	start := index
+	for index < len(content) {
+		c := content[index]
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '$' || c == '.' || c >= 0x80 {
+			index++
+			continue
+		}
+		break
+	}
+
+	if index == start {
+		return "(anonymous)"
+	}
+	return string(content[start:index])
+}
+
+// functionResult is a function along with the file it was found in for output
+type functionResult struct {
+	FunctionJob
+	Language string
+	Location string
+	Filename string
+}
+
+// displayName is how the function is shown in tabular output with the location first so trimming
+// long paths from the left keeps the name
+func (f functionResult) displayName() string {
+	return fmt.Sprintf("%s:%d %s", f.Location, f.StartLine, f.Name)
+}
+
+func collectFunctions(files []*FileJob) []functionResult {
+	var functions []functionResult
+	for _, file := range files {
+		for _, function := range file.Functions {
+			functions = append(functions, functionResult{
+				FunctionJob: function,
+				Language:    file.Language,
+				Location:    file.Location,
+				Filename:    file.Filename,
+			})
+		}
+	}
+
+	return functions
+}
+
+// sortFunctions orders the functions so the worst come first, by complexity unless sorting by name, lines or code
+func sortFunctions(functions []functionResult) []functionResult {
+	switch SortBy {
+	case "name", "names":
+		sort.SliceStable(functions, func(i, j int) bool {
+			return functions[i].Name < functions[j].Name
+		})
+	case "line", "lines":
+		sort.SliceStable(functions, func(i, j int) bool {
+			return functions[i].Lines > functions[j].Lines
+		})
+	case "code", "codes":
+		sort.SliceStable(functions, func(i, j int) bool {
+			return functions[i].Code > functions[j].Code
+		})
+	default:
+		sort.SliceStable(functions, func(i, j int) bool {
+			if functions[i].Complexity != functions[j].Complexity {
+				return functions[i].Complexity > functions[j].Complexity
+			}
+			return functions[i].Lines > functions[j].Lines
+		})
+	}
+
+	return functions
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func countFunctions(t *testing.T, name string, content string) []FunctionJob {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := NewConfig()
+	config.Files = true
+	config.Functions = true
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(summary) != 1 || len(summary[0].Files) != 1 {
+		t.Fatalf("expected a single file got %v", summary)
+	}
+	return summary[0].Files[0].Functions
+}
+
+func TestFunctionsBraces(t *testing.T) {
+	functions := countFunctions(t, "main.go", `package main
+
+// Method has a closure which counts towards it
+func (r *Receiver) Method(a int) int {
+	s := "}"
+	if a > 1 {
+		f := func() {
+			for {
+			}
+		}
+		f()
+	}
+	return a
+}
+
+func Declared() int
+
+func Small() { return }
+`)
+
+	expected := []FunctionJob{
+		{Name: "Method", StartLine: 4, EndLine: 14, Lines: 11, Code: 11, Complexity: 2, CognitiveComplexity: 4},
+		{Name: "Small", StartLine: 18, EndLine: 18, Lines: 1, Code: 1, Complexity: 0},
+	}
+	if len(functions) != len(expected) {
+		t.Fatalf("expected %v got %v", expected, functions)
+	}
+	for i := range expected {
+		if functions[i] != expected[i] {
+			t.Errorf("expected %v got %v", expected[i], functions[i])
+		}
+	}
+}
+
+func TestFunctionsIndent(t *testing.T) {
+	functions := countFunctions(t, "main.py", `import os
+
+def outer(a):
+    if a:
+        return 1
+# comment at the start of a line
+
+    def inner():
+        pass
+    return inner
+
+class Thing:
+    def method(self):
+        return 1
+print(outer(1))
+`)
+
+	expected := []FunctionJob{
+		{Name: "outer", StartLine: 3, EndLine: 10, Lines: 8, Code: 6, Complexity: 1, CognitiveComplexity: 1},
+		{Name: "inner", StartLine: 8, EndLine: 9, Lines: 2, Code: 2, Complexity: 0},
+		{Name: "method", StartLine: 13, EndLine: 14, Lines: 2, Code: 2, Complexity: 0},
+	}
+	if len(functions) != len(expected) {
+		t.Fatalf("expected %v got %v", expected, functions)
+	}
+	for i := range expected {
+		if functions[i] != expected[i] {
+			t.Errorf("expected %v got %v", expected[i], functions[i])
+		}
+	}
+}
+
+func TestFunctionsDisabled(t *testing.T) {
+	dir := t.TempDir()
+	_ = os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\nfunc a() {\n}\n"), 0644)
+
+	config := NewConfig()
+	config.Files = true
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(summary[0].Files[0].Functions) != 0 {
+		t.Errorf("expected no functions got %v", summary[0].Files[0].Functions)
+	}
+}
+
+func TestToCSVFunctions(t *testing.T) {
+	ByFunction = true
+	defer func() { ByFunction = false }()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{
+		Language: "Go",
+		Filename: "main.go",
+		Location: "main.go",
+		Functions: []FunctionJob{
+			{Name: "small", StartLine: 1, EndLine: 2, Lines: 2, Code: 2, Complexity: 0},
+			{Name: "large", StartLine: 4, EndLine: 9, Lines: 6, Code: 5, Complexity: 3, CognitiveComplexity: 5},
+		},
+	}
+	close(inputChan)
+
+	res := toCSV(inputChan)
+	lines := strings.Split(strings.TrimSpace(res), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and two functions got %s", res)
+	}
+	if lines[1] != "Go,main.go,main.go,large,4,9,6,5,3,5" {
+		t.Errorf("expected most complex function first got %s", lines[1])
+	}
+}
+
+func TestCognitiveComplexity(t *testing.T) {
+	cases := []struct {
+		name      string
+		content   string
+		cognitive int64
+	}{
+		{"flat.go", "package main\nfunc a() {\n\tif a {\n\t}\n\tif b {\n\t}\n}\n", 2},
+		{"nested.go", "package main\nfunc a() {\n\tif a {\n\t\tfor {\n\t\t\tif b && c {\n\t\t\t}\n\t\t}\n\t}\n}\n", 7},
+		{"nested.py", "def a():\n    if a:\n        for b in c:\n# comment\n            if d:\n                pass\n    if e:\n        pass\n", 7},
+		{"nested.java", "class A {\n\tvoid a() {\n\t\tif (a) {\n\t\t\tif (b) {\n\t\t\t}\n\t\t}\n\t}\n}\n", 3},
+		{"flat.java", "class A {\n\tvoid a() {\n\t\tif (a) {\n\t\t}\n\t\tif (b) {\n\t\t}\n\t}\n}\n", 2},
+		{"nested.cs", "namespace N\n{\n\tclass A\n\t{\n\t\tvoid B()\n\t\t{\n\t\t\tfor (;;)\n\t\t\t{\n\t\t\t\tif (a)\n\t\t\t\t{\n\t\t\t\t}\n\t\t\t}\n\t\t}\n\t}\n}\n", 3},
+		{"nested.c", "int a() {\n\tif (a) return 1;\n\twhile (b) {\n\t\tif (c) {\n\t\t}\n\t}\n\tif (d) {\n\t}\n}\n", 5},
+		{"nested.yaml", "a:\n  b:\n    c: 1\n", 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			_ = os.WriteFile(filepath.Join(dir, tc.name), []byte(tc.content), 0644)
+
+			config := NewConfig()
+			config.Files = true
+			summary, err := mustNewCounter(t, config).Run(context.Background(), []string{dir})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if summary[0].CognitiveComplexity != tc.cognitive || summary[0].Files[0].CognitiveComplexity != tc.cognitive {
+				t.Errorf("expected %d got %d", tc.cognitive, summary[0].Files[0].CognitiveComplexity)
+			}
+		})
+	}
+}
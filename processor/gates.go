@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// exitCodeGate is the exit code when any quality gate fails so it can be told apart from scc failing
+// or a file breaking a threshold
+const exitCodeGate = 3
+
+// Scopes a quality gate rule can be checked against
+const (
+	GateScopeFile     = "file"
+	GateScopeLanguage = "language"
+	GateScopeTotal    = "total"
+)
+
+// gateMetrics are the values a quality gate rule can check taken from the summary of a file, language or
+// everything. The ratios are percentages.
+var gateMetrics = map[string]func(summary LanguageSummary) float64{
+	"files":      func(s LanguageSummary) float64 { return float64(s.Count) },
+	"lines":      func(s LanguageSummary) float64 { return float64(s.Lines) },
+	"code":       func(s LanguageSummary) float64 { return float64(s.Code) },
+	"comment":    func(s LanguageSummary) float64 { return float64(s.Comment) },
+	"blank":      func(s LanguageSummary) float64 { return float64(s.Blank) },
+	"bytes":      func(s LanguageSummary) float64 { return float64(s.Bytes) },
+	"complexity": func(s LanguageSummary) float64 { return float64(s.Complexity) },
+	"cognitive":  func(s LanguageSummary) float64 { return float64(s.CognitiveComplexity) },
+	"comment-ratio": func(s LanguageSummary) float64 {
+		if s.Code+s.Comment == 0 {
+			return 0
+		}
+		return float64(s.Comment) / float64(s.Code+s.Comment) * 100
+	},
+	"complexity-lines": func(s LanguageSummary) float64 {
+		if s.Code == 0 {
+			return 0
+		}
+		return float64(s.Complexity) / float64(s.Code) * 100
+	},
+}
+
+// qualityGatesFile is the YAML structure of the file given to --quality-gates
+type qualityGatesFile struct {
+	Rules []gateRule `yaml:"rules"`
+}
+
+// gateRule is a budget which every file, language or the total of everything must keep within
+type gateRule struct {
+	Name     string   `yaml:"name"`
+	Scope    string   `yaml:"scope"`
+	Language string   `yaml:"language"` // Only checks files or languages with this name when set
+	Metric   string   `yaml:"metric"`
+	Max      *float64 `yaml:"max"`
+	Min      *float64 `yaml:"min"`
+}
+
+// gateFailure is a rule which was broken by the named file, language or total
+type gateFailure struct {
+	index  int // Position of the rule in the file
+	Rule   gateRule
+	Target string
+	Value  float64
+}
+
+func (f gateFailure) String() string {
+	if f.Rule.Max != nil && f.Value > *f.Rule.Max {
+		return fmt.Sprintf("%s: %s has %s of %s above the max of %s", f.Rule.Name, f.Target, f.Rule.Metric, formatMeasure(f.Value), formatMeasure(*f.Rule.Max))
+	}
+
+	return fmt.Sprintf("%s: %s has %s of %s below the min of %s", f.Rule.Name, f.Target, f.Rule.Metric, formatMeasure(f.Value), formatMeasure(*f.Rule.Min))
+}
+
+// qualityGates checks the rules against every file as it passes on to the output and against the language
+// summaries and total once every file has been seen
+type qualityGates struct {
+	rules     []gateRule
+	aggregate *languageAggregator
+	failures  []gateFailure
+}
+
+// loadQualityGates reads and checks the rules in the file
+func loadQualityGates(path string) (*qualityGates, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file qualityGatesFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("unable to parse quality gates %s: %v", path, err)
+	}
+
+	for i := range file.Rules {
+		rule := &file.Rules[i]
+		rule.Scope = strings.ToLower(rule.Scope)
+		rule.Metric = strings.ToLower(rule.Metric)
+
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rule %d", i+1)
+		}
+		if rule.Scope == "" {
+			rule.Scope = GateScopeTotal
+		}
+
+		switch rule.Scope {
+		case GateScopeFile, GateScopeLanguage, GateScopeTotal:
+		default:
+			return nil, fmt.Errorf("quality gate %q has unknown scope %q expected one of [file, language, total]", rule.Name, rule.Scope)
+		}
+		if _, ok := gateMetrics[rule.Metric]; !ok {
+			return nil, fmt.Errorf("quality gate %q has unknown metric %q expected one of [%s]", rule.Name, rule.Metric, strings.Join(gateMetricNames(), ", "))
+		}
+		if rule.Max == nil && rule.Min == nil {
+			return nil, fmt.Errorf("quality gate %q needs a max or min", rule.Name)
+		}
+	}
+
+	return &qualityGates{
+		rules:     file.Rules,
+		aggregate: newLanguageAggregator(false, false),
+	}, nil
+}
+
+func gateMetricNames() []string {
+	names := make([]string, 0, len(gateMetrics))
+	for name := range gateMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// needsComplexity is true when any rule checks the complexity which otherwise may not be calculated
+func (g *qualityGates) needsComplexity() bool {
+	for _, rule := range g.rules {
+		switch rule.Metric {
+		case "complexity", "cognitive", "complexity-lines":
+			return true
+		}
+	}
+
+	return false
+}
+
+// check records a failure if the value is outside of the rule
+func (g *qualityGates) check(index int, target string, summary LanguageSummary) {
+	rule := g.rules[index]
+	value := gateMetrics[rule.Metric](summary)
+	if (rule.Max != nil && value > *rule.Max) || (rule.Min != nil && value < *rule.Min) {
+		g.failures = append(g.failures, gateFailure{index: index, Rule: rule, Target: target, Value: value})
+	}
+}
+
+// add checks the file rules against the file. Generated, minified and vendored files are checked and
+// totalled as the language they are written in so a rule for Go covers them as well.
+func (g *qualityGates) add(res *FileJob) {
+	language := baseLanguage(res.Language)
+
+	base := *res
+	base.Language = language
+	g.aggregate.add(&base)
+
+	for i, rule := range g.rules {
+		if rule.Scope != GateScopeFile || (rule.Language != "" && !strings.EqualFold(rule.Language, language)) {
+			continue
+		}
+
+		summary := LanguageSummary{Name: language}
+		addToSummary(&summary, res)
+		g.check(i, res.Location, summary)
+	}
+}
+
+// finish checks the rules for languages and the total once every file has been added
+func (g *qualityGates) finish() {
+	languages := sortLanguageSummaryBy(g.aggregate.summaries(), "name")
+
+	for i, rule := range g.rules {
+		switch rule.Scope {
+		case GateScopeLanguage:
+			for _, summary := range languages {
+				if rule.Language == "" || strings.EqualFold(rule.Language, summary.Name) {
+					g.check(i, summary.Name, summary)
+				}
+			}
+		case GateScopeTotal:
+			// A language limits the total to that language which is the same as checking its summary
+			if rule.Language != "" {
+				for _, summary := range languages {
+					if strings.EqualFold(rule.Language, summary.Name) {
+						g.check(i, summary.Name, summary)
+					}
+				}
+				continue
+			}
+			g.check(i, "Total", g.aggregate.total)
+		}
+	}
+
+	// Files arrive in whatever order they were counted so sort to keep the report stable between runs
+	sort.SliceStable(g.failures, func(i, j int) bool {
+		if g.failures[i].index != g.failures[j].index {
+			return g.failures[i].index < g.failures[j].index
+		}
+		return g.failures[i].Target < g.failures[j].Target
+	})
+}
+
+// watch passes every file through checking the rules against it. The failures are complete once the
+// returned channel is closed.
+func (g *qualityGates) watch(input chan *FileJob) chan *FileJob {
+	output := make(chan *FileJob, FileSummaryJobQueueSize)
+
+	go func() {
+		for res := range input {
+			g.add(res)
+			output <- res
+		}
+		g.finish()
+		close(output)
+	}()
+
+	return output
+}
+
+// report writes out every failed rule returning false if there were any
+func (g *qualityGates) report(w io.Writer) bool {
+	if len(g.failures) == 0 {
+		if Verbose {
+			_, _ = fmt.Fprintf(w, "Quality gates passed: %d rules\n", len(g.rules))
+		}
+		return true
+	}
+
+	failed := map[int]bool{}
+	for _, f := range g.failures {
+		failed[f.index] = true
+	}
+
+	_, _ = fmt.Fprintf(w, "Quality gates failed: %d of %d rules\n", len(failed), len(g.rules))
+	for _, f := range g.failures {
+		_, _ = fmt.Fprintf(w, "  FAIL %s\n", f)
+	}
+
+	return false
+}
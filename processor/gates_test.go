@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeGates(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "gates.yaml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func runGates(t *testing.T, content string, files ...*FileJob) (*qualityGates, string, bool) {
+	gates, err := loadQualityGates(writeGates(t, content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputChan := make(chan *FileJob, len(files))
+	for _, f := range files {
+		inputChan <- f
+	}
+	close(inputChan)
+
+	passed := 0
+	for range gates.watch(inputChan) {
+		passed++
+	}
+	if passed != len(files) {
+		t.Errorf("expected every file passed through got %d", passed)
+	}
+
+	var buf bytes.Buffer
+	ok := gates.report(&buf)
+	return gates, buf.String(), ok
+}
+
+func TestQualityGates(t *testing.T) {
+	gates, report, ok := runGates(t, `
+rules:
+  - name: no huge go files
+    scope: file
+    language: go
+    metric: code
+    max: 1500
+  - name: total complexity
+    metric: complexity
+    max: 20
+  - name: java comments
+    scope: language
+    language: Java
+    metric: comment-ratio
+    min: 10
+  - name: python comments
+    scope: language
+    language: Python
+    metric: comment-ratio
+    min: 10
+`,
+		&FileJob{Language: "Go", Location: "b.go", Code: 1600, Complexity: 5},
+		&FileJob{Language: "Go", Location: "a.go", Code: 2000, Complexity: 5},
+		&FileJob{Language: "Go", Location: "c.go", Code: 100, Complexity: 5},
+		&FileJob{Language: "Python", Location: "d.py", Code: 1600, Comment: 10, Complexity: 5},
+		&FileJob{Language: "Java", Location: "e.java", Code: 90, Comment: 10, Complexity: 5},
+	)
+
+	if ok {
+		t.Error("expected gates to fail")
+	}
+
+	expected := []string{
+		"no huge go files: a.go has code of 2000 above the max of 1500",
+		"no huge go files: b.go has code of 1600 above the max of 1500",
+		"total complexity: Total has complexity of 25 above the max of 20",
+		"python comments: Python has comment-ratio of 0.62 below the min of 10",
+	}
+	if len(gates.failures) != len(expected) {
+		t.Fatalf("expected %d failures got %s", len(expected), report)
+	}
+	for i, e := range expected {
+		if gates.failures[i].String() != e {
+			t.Errorf("expected %q got %q", e, gates.failures[i].String())
+		}
+	}
+
+	if !strings.HasPrefix(report, "Quality gates failed: 3 of 4 rules\n") {
+		t.Errorf("unexpected report %s", report)
+	}
+}
+
+func TestQualityGatesPass(t *testing.T) {
+	Verbose = false
+	_, report, ok := runGates(t, `
+rules:
+  - metric: files
+    min: 1
+`, &FileJob{Language: "Go", Location: "a.go", Code: 10})
+
+	if !ok || report != "" {
+		t.Errorf("expected gates to pass quietly got %s", report)
+	}
+}
+
+func TestQualityGatesSuffixedLanguages(t *testing.T) {
+	gates, report, _ := runGates(t, `
+rules:
+  - name: no huge go files
+    scope: file
+    language: Go
+    metric: code
+    max: 1500
+  - name: go budget
+    scope: language
+    language: go
+    metric: files
+    max: 2
+  - name: go total
+    language: Go
+    metric: code
+    max: 3000
+`,
+		&FileJob{Language: "Go", Location: "a.go", Code: 100},
+		&FileJob{Language: "Go (gen)", Location: "api.pb.go", Code: 2000, Generated: true},
+		&FileJob{Language: "Go (gen) (vendored)", Location: "vendor/x.pb.go", Code: 1000, Generated: true, Vendored: true},
+	)
+
+	expected := []string{
+		"no huge go files: api.pb.go has code of 2000 above the max of 1500",
+		"go budget: Go has files of 3 above the max of 2",
+		"go total: Go has code of 3100 above the max of 3000",
+	}
+	if len(gates.failures) != len(expected) {
+		t.Fatalf("expected %d failures got %s", len(expected), report)
+	}
+	for i, e := range expected {
+		if gates.failures[i].String() != e {
+			t.Errorf("expected %q got %q", e, gates.failures[i].String())
+		}
+	}
+}
+
+func TestLoadQualityGatesInvalid(t *testing.T) {
+	for _, c := range []struct {
+		content  string
+		expected string
+	}{
+		{"rules:\n  - metric: code\n", "needs a max or min"},
+		{"rules:\n  - metric: loc\n    max: 1\n", "unknown metric"},
+		{"rules:\n  - metric: code\n    scope: repo\n    max: 1\n", "unknown scope"},
+		{"rules:\n  - metric: code\n    maximum: 1\n", "unable to parse"},
+	} {
+		_, err := loadQualityGates(writeGates(t, c.content))
+		if err == nil || !strings.Contains(err.Error(), c.expected) {
+			t.Errorf("expected error containing %q got %v", c.expected, err)
+		}
+	}
+}
+
+func TestQualityGatesNeedsComplexity(t *testing.T) {
+	gates, err := loadQualityGates(writeGates(t, "rules:\n  - metric: code\n    max: 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gates.needsComplexity() {
+		t.Error("expected complexity not needed for code")
+	}
+
+	gates, err = loadQualityGates(writeGates(t, "rules:\n  - metric: Complexity-Lines\n    max: 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gates.needsComplexity() {
+		t.Error("expected complexity needed for complexity-lines")
+	}
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+)
+
+// generatedHeadBytes is how much of the file markers are searched for in when a rule has no before pattern
+const generatedHeadBytes = 1_000
+
+// generatedRule is a GeneratedRule with its patterns compiled, any which were not set being nil
+type generatedRule struct {
+	name     string
+	filename *regexp.Regexp
+	marker   *regexp.Regexp
+	before   *regexp.Regexp
+	content  *regexp.Regexp
+}
+
+// compileGeneratedRules compiles the rules of a language. The patterns are checked when the languages are
+// loaded so they are expected to compile.
+func compileGeneratedRules(rules []GeneratedRule) []generatedRule {
+	compile := func(pattern string) *regexp.Regexp {
+		if pattern == "" {
+			return nil
+		}
+		return regexp.MustCompile(pattern)
+	}
+
+	compiled := []generatedRule{}
+	for _, rule := range rules {
+		compiled = append(compiled, generatedRule{
+			name:     rule.Name,
+			filename: compile(rule.Filename),
+			marker:   compile(rule.Marker),
+			before:   compile(rule.Before),
+			content:  compile(rule.Content),
+		})
+	}
+
+	return compiled
+}
+
+// matches checks every pattern set on the rule, the cheap filename check going first
+func (rule generatedRule) matches(filename string, content []byte) bool {
+	if rule.filename != nil && !rule.filename.MatchString(filename) {
+		return false
+	}
+	if rule.marker != nil && !rule.matchesMarker(content) {
+		return false
+	}
+	if rule.content != nil && !rule.content.Match(content) {
+		return false
+	}
+
+	return true
+}
+
+// matchesMarker looks for the marker on each line up to the one matching before, such as the package clause
+// for Go, or in the first generatedHeadBytes when the rule has no before
+func (rule generatedRule) matchesMarker(content []byte) bool {
+	if rule.before == nil && len(content) > generatedHeadBytes {
+		content = content[:generatedHeadBytes]
+	}
+
+	for len(content) != 0 {
+		line := content
+		if i := bytes.IndexByte(content, '\n'); i != -1 {
+			line, content = content[:i], content[i+1:]
+		} else {
+			content = nil
+		}
+		line = bytes.TrimSuffix(line, []byte("\r"))
+
+		if rule.before != nil && rule.before.Match(line) {
+			return false
+		}
+		if rule.marker.Match(line) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// generatedBy returns the name of the first rule which identifies the file as generated or an empty string.
+// linguist-generated in .gitattributes decides either way, then the rules for the language are checked
+// followed by the --generated-markers searched for in the first generatedHeadBytes.
+func (c *Counter) generatedBy(fileJob *FileJob, rules []generatedRule) string {
+	settings := c.settings(fileJob)
+
+	if generated, ok := settings.linguistAttribute(fileJob.Location, "linguist-generated"); ok {
+		if generated {
+			return "linguist-generated"
+		}
+		return ""
+	}
+
+	for _, rule := range rules {
+		if rule.matches(fileJob.Filename, fileJob.Content) {
+			return rule.name
+		}
+	}
+
+	head := fileJob.Content
+	if len(head) > generatedHeadBytes {
+		head = head[:generatedHeadBytes]
+	}
+	head = bytes.ToLower(head)
+
+	for _, marker := range settings.generatedMarkers {
+		if bytes.Contains(head, bytes.ToLower([]byte(marker))) {
+			return fmt.Sprintf("marker:%s", marker)
+		}
+	}
+
+	return ""
+}
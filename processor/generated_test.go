@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuiltInGeneratedRulesValid(t *testing.T) {
+	for name, language := range loadDatabase() {
+		if err := validateLanguage(name, Language{Extensions: []string{"x"}, Generated: language.Generated}); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGeneratedRuleMarkerBefore(t *testing.T) {
+	rule := compileGeneratedRules([]GeneratedRule{{Name: "go", Marker: `^// Code generated .* DO NOT EDIT\.$`, Before: `^package `}})[0]
+
+	cases := []struct {
+		content  string
+		expected bool
+	}{
+		{"// Code generated by protoc-gen-go. DO NOT EDIT.\npackage api\n", true},
+		{"// Copyright\r\n\r\n// Code generated by mockgen. DO NOT EDIT.\r\n\r\npackage mocks\r\n", true},
+		{strings.Repeat("// License\n", 200) + "// Code generated by sqlc. DO NOT EDIT.\npackage db\n", true},
+		{"package api\n\n// Code generated by hand. DO NOT EDIT.\n", false},
+		{"// Code generated by protoc-gen-go. DO NOT EDIT. Really\npackage api\n", false},
+		{"package api\n", false},
+	}
+
+	for _, c := range cases {
+		if got := rule.matches("a.go", []byte(c.content)); got != c.expected {
+			t.Errorf("content %q expected %t got %t", c.content, c.expected, got)
+		}
+	}
+}
+
+func TestGeneratedRuleMarkerHead(t *testing.T) {
+	rule := compileGeneratedRules([]GeneratedRule{{Name: "rust", Marker: `^// @generated\b`}})[0]
+
+	if !rule.matches("a.rs", []byte("// @generated by cargo\nfn main() {}\n")) {
+		t.Error("expected marker in the head to match")
+	}
+	if rule.matches("a.rs", []byte(strings.Repeat("x", generatedHeadBytes)+"\n// @generated\n")) {
+		t.Error("expected marker after the head to be ignored")
+	}
+}
+
+func TestGeneratedRuleAllPatterns(t *testing.T) {
+	rule := compileGeneratedRules([]GeneratedRule{{Name: "both", Filename: `\.js$`, Content: `webpackBootstrap`}})[0]
+
+	if !rule.matches("bundle.js", []byte("/******/ (() => { // webpackBootstrap\n")) {
+		t.Error("expected filename and content to match")
+	}
+	if rule.matches("bundle.ts", []byte("/******/ (() => { // webpackBootstrap\n")) {
+		t.Error("expected filename to also be required")
+	}
+	if rule.matches("bundle.js", []byte("console.log(1)\n")) {
+		t.Error("expected content to also be required")
+	}
+}
+
+func TestGeneratedBuiltInRules(t *testing.T) {
+	config := NewConfig()
+	config.Generated = true
+	counter := mustNewCounter(t, config)
+
+	cases := []struct {
+		language string
+		filename string
+		content  string
+		expected string
+	}{
+		{"Go", "api.pb.go", "package api\n", "protobuf"},
+		{"Go", "zz_generated.go", "package api\n", "go-generated-suffix"},
+		{"Go", "mock.go", "// Code generated by MockGen. DO NOT EDIT.\n\npackage mocks\n", "go-code-generated"},
+		{"Go", "main.go", "package main\n", ""},
+		{"JSON", "package-lock.json", "{}\n", "npm-lockfile"},
+		{"YAML", "pnpm-lock.yaml", "lockfileVersion: '6.0'\n", "pnpm-lockfile"},
+		{"JavaScript", "main.3f2a9c1b7d4e5f60.js", "x\n", "angular-build"},
+		{"JavaScript", "polyfills-es2015.3f2a9c1b7d4e5f60.js", "x\n", "angular-build"},
+		{"JavaScript", "main.js", "/******/ (function(modules) { // webpackBootstrap\n", "webpack-bundle"},
+		{"JavaScript", "main.js", "console.log(1)\n", ""},
+		{"Python", "api_pb2_grpc.py", "import grpc\n", "protobuf"},
+		{"C#", "Form1.Designer.cs", "class Form1 {}\n", "designer"},
+		{"C#", "Parser.g.cs", "class Parser {}\n", "designer"},
+		{"C#", "Model.cs", "// <auto-generated>\nclass Model {}\n", "auto-generated"},
+		{"Dart", "user.freezed.dart", "part of 'user.dart';\n", "build-runner"},
+		{"Rust", "lib.rs", "// @generated by build.rs\n", "rust-generated"},
+		{"Java", "Api.java", "import javax.annotation.processing.Generated;\n", "javax-generated"},
+		{"Go", "notes.go", "// do not edit this by hand\npackage notes\n", "marker:do not edit"},
+	}
+
+	for _, c := range cases {
+		counter.loadLanguageFeature(c.language)
+
+		job := &FileJob{Language: c.language, Filename: c.filename, Location: c.filename, Content: []byte(c.content), Bytes: int64(len(c.content))}
+		counter.CountStats(job)
+
+		if job.GeneratedRule != c.expected || job.Generated != (c.expected != "") {
+			t.Errorf("%s %s expected rule %q got %q", c.language, c.filename, c.expected, job.GeneratedRule)
+		}
+		if job.Generated && job.Language != c.language+" (gen)" {
+			t.Errorf("expected %s (gen) got %s", c.language, job.Language)
+		}
+	}
+}
+
+func TestGeneratedDisabled(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+	counter.loadLanguageFeature("Go")
+
+	job := &FileJob{Language: "Go", Filename: "api.pb.go", Content: []byte("package api\n"), Bytes: 12}
+	counter.CountStats(job)
+
+	if job.Generated || job.GeneratedRule != "" || job.Language != "Go" {
+		t.Errorf("expected no generated detection without --gen got %v %q", job.Generated, job.GeneratedRule)
+	}
+}
@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/boyter/scc/v3/processor/gitrepo"
+)
+
+var errGitModes = errors.New("--git-staged and --git-head cannot be used together")
+
+// gitMode reports if the files should come from git rather than walking the directories
+func (c *Counter) gitMode() bool {
+	return c.Config.GitTracked || c.Config.GitStaged || c.Config.GitHead
+}
+
+// walkGit sends a job for every file git tracks under the paths closing output once done. Depending on the
+// Config the files are listed from the index or the tree at HEAD and read from the working tree or blobs.
+func (c *Counter) walkGit(ctx context.Context, paths []string, output chan<- *FileJob) error {
+	defer close(output)
+
+	var excludes []*regexp.Regexp
+	for _, exclude := range c.Config.Exclude {
+		r, err := regexp.Compile(exclude)
+		if err != nil {
+			printError(err.Error())
+			continue
+		}
+		excludes = append(excludes, r)
+	}
+
+	for _, p := range paths {
+		if err := c.walkGitPath(ctx, p, excludes, output); err != nil {
+			return fmt.Errorf("failed to list git files in %s: %v", p, err)
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (c *Counter) walkGitPath(ctx context.Context, root string, excludes []*regexp.Regexp, output chan<- *FileJob) error {
+	repo, err := gitrepo.Open(root)
+	if err != nil {
+		return err
+	}
+
+	var entries []gitrepo.Entry
+	if c.Config.GitHead {
+		entries, err = repo.HeadTree()
+	} else {
+		entries, err = repo.Index()
+	}
+	if err != nil {
+		return err
+	}
+
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return err
+	}
+	prefix, err := filepath.Rel(repo.WorkTree, absRoot)
+	if err != nil {
+		return err
+	}
+	prefix = filepath.ToSlash(prefix)
+
+	fromBlob := c.Config.GitStaged || c.Config.GitHead
+	lister := gitLister{counter: c, repo: repo, settings: map[string]*directorySettings{}}
+	seen := map[string]bool{}
+
+	for _, entry := range entries {
+		if prefix != "." && entry.Path != prefix && !strings.HasPrefix(entry.Path, prefix+"/") {
+			continue
+		}
+
+		// Conflicted files have an entry per side of the merge and the one in the working tree is what gets counted
+		if seen[entry.Path] || (fromBlob && entry.Stage != 0) {
+			continue
+		}
+		seen[entry.Path] = true
+
+		if !entry.IsRegular() {
+			if Verbose {
+				printWarn(fmt.Sprintf("skipping non-regular git entry: %s", entry.Path))
+			}
+			continue
+		}
+
+		location := filepath.Join(repo.WorkTree, filepath.FromSlash(entry.Path))
+		name := path.Base(entry.Path)
+		settings := lister.directorySettings(path.Dir(entry.Path))
+		if c.gitExcluded(repo.WorkTree, entry.Path, excludes, settings) {
+			continue
+		}
+
+		var fileJob *FileJob
+		if fromBlob {
+			fileJob = c.newBlobFileJob(repo, entry, location, name, settings)
+		} else {
+			fileInfo, err := os.Lstat(location)
+			if err != nil {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping tracked file missing from the working tree: %s", location))
+				}
+				continue
+			}
+			fileJob = c.newFileJob(location, entry.Path, name, fileInfo, settings)
+		}
+
+		if fileJob == nil {
+			continue
+		}
+
+		select {
+		case output <- fileJob:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// gitExcluded applies the same deny list and exclude checks as the directory walker to every part of the path
+func (c *Counter) gitExcluded(workTree string, entryPath string, excludes []*regexp.Regexp, settings *directorySettings) bool {
+	parts := strings.Split(entryPath, "/")
+	for i, part := range parts {
+		partLocation := filepath.Join(workTree, filepath.FromSlash(strings.Join(parts[:i+1], "/")))
+
+		for _, deny := range c.Config.PathDenyList {
+			if strings.HasSuffix(partLocation, deny) {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping file due to being in denylist: %s", partLocation))
+				}
+				return true
+			}
+		}
+
+		for _, exclude := range excludes {
+			if exclude.MatchString(part) || exclude.MatchString(partLocation) {
+				if Verbose {
+					printWarn("skipping file due to match exclude: " + partLocation)
+				}
+				return true
+			}
+		}
+
+		if settings.excluded(part, partLocation) {
+			if Verbose {
+				printWarn(fmt.Sprintf("skipping file due to match exclude in %s: %s", SccrcFilename, partLocation))
+			}
+			return true
+		}
+	}
+
+	return false
+}
+
+// newBlobFileJob creates a job whose content is read from the git object rather than the working tree
+func (c *Counter) newBlobFileJob(repo *gitrepo.Repository, entry gitrepo.Entry, location string, name string, settings *directorySettings) *FileJob {
+	fileJob := c.newFileJob(location, entry.Path, name, blobFileInfo{name: name, size: entry.Size, mode: entry.Mode}, settings)
+	if fileJob == nil {
+		return nil
+	}
+
+	// The cache checks size and modification time first so use the object id in place of the time
+	// as it changes whenever the content does
+	fileJob.modTime = int64(binary.BigEndian.Uint64(entry.Hash[:8]))
+	fileJob.readContent = func() ([]byte, error) {
+		return repo.ReadBlob(entry.Hash)
+	}
+
+	return fileJob
+}
+
+// gitLister tracks the .sccrc and .gitattributes settings for each directory as tracked files are listed
+type gitLister struct {
+	counter  *Counter
+	repo     *gitrepo.Repository
+	settings map[string]*directorySettings
+}
+
+// directorySettings returns the settings for the directory relative to the work tree loading any
+// .sccrc and .gitattributes from the work tree in it and its parents the first time it is seen
+func (l *gitLister) directorySettings(dir string) *directorySettings {
+	if l.counter.Config.NoSccrc && l.counter.Config.NoGitAttributes {
+		return nil
+	}
+
+	if settings, ok := l.settings[dir]; ok {
+		return settings
+	}
+
+	var parent *directorySettings
+	if dir != "." {
+		parent = l.directorySettings(path.Dir(dir))
+	}
+
+	settings := parent
+	base := filepath.Join(l.repo.WorkTree, filepath.FromSlash(dir))
+	rc := filepath.Join(base, SccrcFilename)
+	if _, err := os.Stat(rc); err == nil && !l.counter.Config.NoSccrc {
+		loaded, err := l.counter.loadSccrc(rc, parent)
+		if err != nil {
+			printError(fmt.Sprintf("failed to load %s: %v", rc, err))
+		} else {
+			settings = loaded
+		}
+	}
+
+	attributes := filepath.Join(base, GitAttributesFilename)
+	if _, err := os.Stat(attributes); err == nil && !l.counter.Config.NoGitAttributes {
+		loaded, err := l.counter.loadGitAttributes(attributes, base, settings)
+		if err != nil {
+			printError(fmt.Sprintf("failed to load %s: %v", attributes, err))
+		} else {
+			settings = loaded
+		}
+	}
+
+	l.settings[dir] = settings
+	return settings
+}
+
+// blobFileInfo describes a git object so it can be checked the same way as a file
+type blobFileInfo struct {
+	name string
+	size int64
+	mode uint32
+}
+
+func (b blobFileInfo) Name() string       { return b.name }
+func (b blobFileInfo) Size() int64        { return b.size }
+func (b blobFileInfo) Mode() os.FileMode  { return os.FileMode(b.mode & 0777) }
+func (b blobFileInfo) ModTime() time.Time { return time.Time{} }
+func (b blobFileInfo) IsDir() bool        { return false }
+func (b blobFileInfo) Sys() interface{}   { return nil }
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func gitCommand(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v %s", args, err, out)
+	}
+}
+
+func TestRunGitModes(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	t.Setenv("GIT_CONFIG_GLOBAL", filepath.Join(dir, "gitconfig"))
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	write := func(name string, content string) {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		_ = os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gitCommand(t, dir, "init", "-q", ".")
+	gitCommand(t, dir, "config", "user.email", "test@example.com")
+	gitCommand(t, dir, "config", "user.name", "test")
+
+	// One line committed, two staged and three in the working tree along with an untracked file
+	write("main.go", "package main\n")
+	gitCommand(t, dir, "add", ".")
+	gitCommand(t, dir, "commit", "-q", "-m", "one")
+	write("main.go", "package main\nfunc a() {}\n")
+	write("lib/lib.py", "print(1)\n")
+	gitCommand(t, dir, "add", ".")
+	write("main.go", "package main\nfunc a() {}\nfunc b() {}\n")
+	write("build/untracked.py", "print(1)\nprint(2)\n")
+
+	cases := []struct {
+		name   string
+		config func(*Config)
+		goCode int64
+		python int64
+	}{
+		{"walk", func(c *Config) {}, 3, 3},
+		{"tracked", func(c *Config) { c.GitTracked = true }, 3, 1},
+		{"staged", func(c *Config) { c.GitStaged = true }, 2, 1},
+		{"head", func(c *Config) { c.GitHead = true }, 1, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := NewConfig()
+			tc.config(&config)
+
+			summary, err := mustNewCounter(t, config).Run(context.Background(), []string{dir})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			goSummary, _ := findLanguageSummary(summary, "Go")
+			pythonSummary, _ := findLanguageSummary(summary, "Python")
+			if goSummary.Code != tc.goCode || pythonSummary.Code != tc.python {
+				t.Errorf("expected Go %d Python %d got Go %d Python %d", tc.goCode, tc.python, goSummary.Code, pythonSummary.Code)
+			}
+		})
+	}
+}
+
+func TestNewCounterGitModes(t *testing.T) {
+	config := NewConfig()
+	config.GitStaged = true
+	config.GitHead = true
+
+	if _, err := NewCounter(config); err == nil {
+		t.Error("expected error using staged and head together")
+	}
+}
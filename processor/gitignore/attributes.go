@@ -0,0 +1,127 @@
+package gitignore
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GitAttributes holds the patterns from a gitattributes file along with the attributes each sets
+// following the rules in https://git-scm.com/docs/gitattributes
+type GitAttributes struct {
+	lines []attributesLine
+	path  string
+}
+
+type attributesLine struct {
+	pattern    pattern
+	attributes []attribute
+}
+
+// attribute is an attribute as written on a line. Set attributes have the value true and unset
+// ones false. Unspecified ones written as !name are not specified and clear the attribute.
+type attribute struct {
+	name      string
+	value     string
+	specified bool
+}
+
+// NewGitAttributes loads the gitattributes file with patterns relative to base
+func NewGitAttributes(gitattributes string, base string) (GitAttributes, error) {
+	file, err := os.Open(gitattributes)
+	if err != nil {
+		return GitAttributes{}, err
+	}
+	defer file.Close()
+
+	return NewGitAttributesFromReader(base, file), nil
+}
+
+// NewGitAttributesFromReader reads gitattributes lines from r with patterns relative to path
+func NewGitAttributesFromReader(path string, r io.Reader) GitAttributes {
+	g := GitAttributes{
+		path: path,
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		// Macros are only allowed in the top level file and negative patterns are forbidden so
+		// both are skipped as git does for patterns
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "[attr]") || strings.HasPrefix(fields[0], "!") {
+			continue
+		}
+
+		p, ok := parsePattern(fields[0])
+		if !ok {
+			continue
+		}
+
+		line := attributesLine{pattern: p}
+		for _, field := range fields[1:] {
+			line.attributes = append(line.attributes, parseAttribute(field))
+		}
+		g.lines = append(g.lines, line)
+	}
+	return g
+}
+
+func parseAttribute(field string) attribute {
+	switch {
+	case strings.HasPrefix(field, "-"):
+		return attribute{name: field[1:], value: "false", specified: true}
+	case strings.HasPrefix(field, "!"):
+		return attribute{name: field[1:]}
+	}
+
+	if i := strings.IndexByte(field, '='); i >= 0 {
+		return attribute{name: field[:i], value: field[i+1:], specified: true}
+	}
+
+	return attribute{name: field, value: "true", specified: true}
+}
+
+// lookup finds the last line which matches the path and mentions the attribute as that is the one
+// which decides, returning false for found if no line does
+func (g GitAttributes) lookup(path string, name string) (value string, specified bool, found bool) {
+	relativePath, err := filepath.Rel(g.path, path)
+	if err != nil {
+		return "", false, false
+	}
+
+	relativePath = filepath.ToSlash(relativePath)
+	if relativePath == "." || relativePath == ".." || strings.HasPrefix(relativePath, "../") {
+		return "", false, false
+	}
+
+	basename := relativePath
+	if i := strings.LastIndexByte(relativePath, '/'); i >= 0 {
+		basename = relativePath[i+1:]
+	}
+
+	for i := len(g.lines) - 1; i >= 0; i-- {
+		line := g.lines[i]
+		for j := len(line.attributes) - 1; j >= 0; j-- {
+			if line.attributes[j].name == name && line.pattern.match(relativePath, basename, false) {
+				return line.attributes[j].value, line.attributes[j].specified, true
+			}
+		}
+	}
+
+	return "", false, false
+}
+
+// Attribute returns the value of the attribute for the file at path checking the attributes ordered
+// from lowest to highest precedence, as they are found walking down from the root. Set attributes
+// are "true" and unset ones "false". It is false when no line specifies the attribute for the path.
+func Attribute(attributes []GitAttributes, path string, name string) (string, bool) {
+	for i := len(attributes) - 1; i >= 0; i-- {
+		if value, specified, found := attributes[i].lookup(path, name); found {
+			return value, specified
+		}
+	}
+
+	return "", false
+}
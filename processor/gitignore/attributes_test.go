@@ -0,0 +1,60 @@
+package gitignore
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGitAttributes(t *testing.T) {
+	attributes := NewGitAttributesFromReader("/repo", strings.NewReader(`# comment
+[attr]binary -diff -merge -text
+*.js linguist-vendored
+!*.min.js linguist-vendored
+vendor/** linguist-vendored linguist-language=Go
+docs/*.md -linguist-vendored
+*.pb.go linguist-generated=true
+*.pb.go !linguist-generated
+build/ linguist-generated
+`))
+
+	cases := []struct {
+		path      string
+		name      string
+		value     string
+		specified bool
+	}{
+		{"app.js", "linguist-vendored", "true", true},
+		{"lib/app.min.js", "linguist-vendored", "true", true},
+		{"vendor/lib/a.c", "linguist-vendored", "true", true},
+		{"vendor/lib/a.c", "linguist-language", "Go", true},
+		{"docs/readme.md", "linguist-vendored", "false", true},
+		{"api/api.pb.go", "linguist-generated", "", false},
+		{"build/out.js", "linguist-generated", "", false},
+		{"main.go", "linguist-vendored", "", false},
+		{"app.js", "binary", "", false},
+	}
+
+	for _, c := range cases {
+		value, specified := Attribute([]GitAttributes{attributes}, filepath.FromSlash("/repo/"+c.path), c.name)
+		if value != c.value || specified != c.specified {
+			t.Errorf("path %s attribute %s expected %q %v got %q %v", c.path, c.name, c.value, c.specified, value, specified)
+		}
+	}
+}
+
+func TestGitAttributesPrecedence(t *testing.T) {
+	root := NewGitAttributesFromReader("/repo", strings.NewReader("*.h linguist-language=C\n*.inc linguist-language=PHP\n"))
+	sub := NewGitAttributesFromReader("/repo/sub", strings.NewReader("*.h linguist-language=C++\n*.inc !linguist-language\n"))
+	attributes := []GitAttributes{root, sub}
+
+	if value, _ := Attribute(attributes, "/repo/sub/a.h", "linguist-language"); value != "C++" {
+		t.Errorf("expected deeper file to win got %s", value)
+	}
+	if value, _ := Attribute(attributes, "/repo/a.h", "linguist-language"); value != "C" {
+		t.Errorf("expected deeper file to not apply outside its directory got %s", value)
+	}
+	if _, specified := Attribute(attributes, "/repo/sub/a.inc", "linguist-language"); specified {
+		t.Error("expected deeper unspecified to clear the attribute")
+	}
+}
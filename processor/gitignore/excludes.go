@@ -0,0 +1,139 @@
+package gitignore
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GlobalExcludesFile returns the path of the global excludes file set by core.excludesFile in the
+// user's git configuration, falling back to the default location git uses when it is not set
+func GlobalExcludesFile() string {
+	home, _ := os.UserHomeDir()
+	xdg := os.Getenv("XDG_CONFIG_HOME")
+	if xdg == "" && home != "" {
+		xdg = filepath.Join(home, ".config")
+	}
+
+	var configs []string
+	if global := os.Getenv("GIT_CONFIG_GLOBAL"); global != "" {
+		configs = append(configs, global)
+	} else {
+		if xdg != "" {
+			configs = append(configs, filepath.Join(xdg, "git", "config"))
+		}
+		if home != "" {
+			configs = append(configs, filepath.Join(home, ".gitconfig"))
+		}
+	}
+
+	// Later files take precedence the same way they do in git
+	excludesFile := ""
+	for _, config := range configs {
+		file, err := os.Open(config)
+		if err != nil {
+			continue
+		}
+		if value, ok := parseExcludesFile(file); ok {
+			excludesFile = value
+		}
+		_ = file.Close()
+	}
+
+	if excludesFile != "" {
+		if excludesFile == "~" || strings.HasPrefix(excludesFile, "~/") {
+			excludesFile = filepath.Join(home, excludesFile[1:])
+		}
+		return excludesFile
+	}
+
+	if xdg == "" {
+		return ""
+	}
+	return filepath.Join(xdg, "git", "ignore")
+}
+
+// parseExcludesFile finds the last core.excludesFile value in a git config file. Section and
+// key names are case insensitive, values may be quoted and comments start with # or ;
+func parseExcludesFile(r io.Reader) (string, bool) {
+	value := ""
+	found := false
+	inCore := false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		if line[0] == '[' {
+			end := strings.IndexByte(line, ']')
+			if end == -1 {
+				inCore = false
+				continue
+			}
+			inCore = strings.EqualFold(strings.TrimSpace(line[1:end]), "core")
+			line = strings.TrimSpace(line[end+1:])
+			if line == "" || line[0] == '#' || line[0] == ';' {
+				continue
+			}
+		}
+
+		if !inCore {
+			continue
+		}
+
+		key, rest, ok := strings.Cut(line, "=")
+		if !ok || !strings.EqualFold(strings.TrimSpace(key), "excludesfile") {
+			continue
+		}
+
+		value = parseConfigValue(rest)
+		found = true
+	}
+
+	return value, found
+}
+
+// parseConfigValue handles quoting, escapes and trailing comments in a git config value
+func parseConfigValue(raw string) string {
+	var sb strings.Builder
+	inQuote := false
+	pendingSpace := false
+
+	raw = strings.TrimSpace(raw)
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+		switch {
+		case c == '"':
+			inQuote = !inQuote
+			continue
+		case !inQuote && (c == '#' || c == ';'):
+			return sb.String()
+		case !inQuote && (c == ' ' || c == '\t'):
+			pendingSpace = sb.Len() > 0
+			continue
+		case c == '\\' && i+1 < len(raw):
+			i++
+			switch raw[i] {
+			case 'n':
+				c = '\n'
+			case 't':
+				c = '\t'
+			default:
+				c = raw[i]
+			}
+		}
+
+		if pendingSpace {
+			sb.WriteByte(' ')
+			pendingSpace = false
+		}
+		sb.WriteByte(c)
+	}
+
+	return sb.String()
+}
@@ -9,13 +9,16 @@ import (
 )
 
 type IgnoreMatcher interface {
+	// Match reports if the path is ignored including when one of its parent directories is
 	Match(path string, isDir bool) bool
+	// MatchResult reports if any pattern matched the path itself and if so whether the last
+	// one to match ignores it or re-includes it through negation
+	MatchResult(path string, isDir bool) (matched bool, ignored bool)
 }
 
 type gitIgnore struct {
-	ignorePatterns scanStrategy
-	acceptPatterns scanStrategy
-	path           string
+	patterns []pattern
+	path     string
 }
 
 func NewGitIgnore(gitignore string, base ...string) (IgnoreMatcher, error) {
@@ -37,34 +40,85 @@ func NewGitIgnore(gitignore string, base ...string) (IgnoreMatcher, error) {
 
 func NewGitIgnoreFromReader(path string, r io.Reader) gitIgnore {
 	g := gitIgnore{
-		ignorePatterns: newIndexScanPatterns(),
-		acceptPatterns: newIndexScanPatterns(),
-		path:           path,
+		path: path,
 	}
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
-		line := strings.Trim(scanner.Text(), " ")
-		if len(line) == 0 || strings.HasPrefix(line, "#") {
-			continue
-		}
-
-		if strings.HasPrefix(line, "!") {
-			g.acceptPatterns.add(strings.TrimPrefix(line, "!"))
-		} else {
-			g.ignorePatterns.add(line)
+		if p, ok := parsePattern(scanner.Text()); ok {
+			g.patterns = append(g.patterns, p)
 		}
 	}
 	return g
 }
 
-func (g gitIgnore) Match(path string, isDir bool) bool {
+// relative returns the path relative to the directory the patterns apply to using / as the separator
+func (g gitIgnore) relative(path string) (string, bool) {
 	relativePath, err := filepath.Rel(g.path, path)
 	if err != nil {
-		return false
+		return "", false
 	}
 
-	if g.acceptPatterns.match(relativePath, isDir) {
+	relativePath = filepath.ToSlash(relativePath)
+	if relativePath == "." || relativePath == ".." || strings.HasPrefix(relativePath, "../") {
+		return "", false
+	}
+
+	return relativePath, true
+}
+
+func (g gitIgnore) Match(path string, isDir bool) bool {
+	relativePath, ok := g.relative(path)
+	if !ok {
 		return false
 	}
-	return g.ignorePatterns.match(relativePath, isDir)
+
+	// It is not possible to re-include a file if a parent directory of that file is excluded
+	for i := 0; i < len(relativePath); i++ {
+		if relativePath[i] == '/' {
+			if matched, ignored := g.match(relativePath[:i], true); matched && ignored {
+				return true
+			}
+		}
+	}
+
+	_, ignored := g.match(relativePath, isDir)
+	return ignored
+}
+
+func (g gitIgnore) MatchResult(path string, isDir bool) (bool, bool) {
+	relativePath, ok := g.relative(path)
+	if !ok {
+		return false, false
+	}
+
+	return g.match(relativePath, isDir)
+}
+
+// match finds the last pattern which matches the relative path as that is the one which decides
+func (g gitIgnore) match(relativePath string, isDir bool) (bool, bool) {
+	basename := relativePath
+	if i := strings.LastIndexByte(relativePath, '/'); i >= 0 {
+		basename = relativePath[i+1:]
+	}
+
+	for i := len(g.patterns) - 1; i >= 0; i-- {
+		if g.patterns[i].match(relativePath, basename, isDir) {
+			return true, !g.patterns[i].negate
+		}
+	}
+
+	return false, false
+}
+
+// Ignored checks the path against matchers ordered from lowest to highest precedence, as they
+// are found walking down from the root, where the last pattern to match in the highest precedence
+// matcher decides. Parent directories are not checked as a walk never descends into ignored ones.
+func Ignored(matchers []IgnoreMatcher, path string, isDir bool) bool {
+	for i := len(matchers) - 1; i >= 0; i-- {
+		if matched, ignored := matchers[i].MatchResult(path, isDir); matched {
+			return ignored
+		}
+	}
+
+	return false
 }
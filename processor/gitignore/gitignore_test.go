@@ -1,13 +1,12 @@
 package gitignore
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
-// Note that this works on the following hence the bug
-// https://golang.org/pkg/path/filepath/#Match
-// it needs to include glob patterns and https://golang.org/pkg/path/filepath/#Glob might help
-
 func TestNewGitIgnore(t *testing.T) {
 	ignore, _ := NewGitIgnore(".ignoretest")
 
@@ -15,3 +14,309 @@ func TestNewGitIgnore(t *testing.T) {
 		t.Error("empty should never match")
 	}
 }
+
+// Cases from the glob column of git's t/t3070-wildmatch.sh which is the WM_PATHNAME behaviour gitignore uses
+func TestWildmatch(t *testing.T) {
+	cases := []struct {
+		match   bool
+		text    string
+		pattern string
+	}{
+		{true, `foo`, `foo`},
+		{false, `foo`, `bar`},
+		{true, ``, ``},
+		{true, `foo`, `???`},
+		{false, `foo`, `??`},
+		{true, `foo`, `*`},
+		{true, `foo`, `f*`},
+		{false, `foo`, `*f`},
+		{true, `foo`, `*foo*`},
+		{true, `foobar`, `*ob*a*r*`},
+		{true, `aaaaaaabababab`, `*ab`},
+		{true, `foo*`, `foo\*`},
+		{false, `foobar`, `foo\*bar`},
+		{true, `f\oo`, `f\\oo`},
+		{true, `ball`, `*[al]?`},
+		{false, `ten`, `[ten]`},
+		{true, `ten`, `**[!te]`},
+		{false, `ten`, `**[!ten]`},
+		{true, `ten`, `t[a-g]n`},
+		{false, `ten`, `t[!a-g]n`},
+		{true, `ton`, `t[!a-g]n`},
+		{true, `ton`, `t[^a-g]n`},
+		{true, `a]b`, `a[]]b`},
+		{true, `a-b`, `a[]-]b`},
+		{true, `a]b`, `a[]-]b`},
+		{false, `aab`, `a[]-]b`},
+		{true, `aab`, `a[]a-]b`},
+		{true, `]`, `]`},
+
+		// Extended slash-matching features
+		{false, `foo/baz/bar`, `foo*bar`},
+		{false, `foo/baz/bar`, `foo**bar`},
+		{true, `foobazbar`, `foo**bar`},
+		{true, `foo/baz/bar`, `foo/**/bar`},
+		{true, `foo/baz/bar`, `foo/**/**/bar`},
+		{true, `foo/b/a/z/bar`, `foo/**/bar`},
+		{true, `foo/b/a/z/bar`, `foo/**/**/bar`},
+		{true, `foo/bar`, `foo/**/bar`},
+		{true, `foo/bar`, `foo/**/**/bar`},
+		{false, `foo/bar`, `foo?bar`},
+		{false, `foo/bar`, `foo[/]bar`},
+		{false, `foo/bar`, `foo[^a-z]bar`},
+		{false, `foo/bar`, `f[^eiu][^eiu][^eiu][^eiu][^eiu]r`},
+		{true, `foo-bar`, `f[^eiu][^eiu][^eiu][^eiu][^eiu]r`},
+		{true, `foo`, `**/foo`},
+		{true, `XXX/foo`, `**/foo`},
+		{true, `bar/baz/foo`, `**/foo`},
+		{false, `bar/baz/foo`, `*/foo`},
+		{false, `foo/bar/baz`, `**/bar*`},
+		{true, `deep/foo/bar/baz`, `**/bar/*`},
+		{false, `deep/foo/bar/baz/`, `**/bar/*`},
+		{true, `deep/foo/bar/baz/`, `**/bar/**`},
+		{false, `deep/foo/bar`, `**/bar/*`},
+		{true, `deep/foo/bar/`, `**/bar/**`},
+		{false, `foo/bar/baz`, `**/bar**`},
+		{true, `foo/bar/baz/x`, `*/bar/**`},
+		{false, `deep/foo/bar/baz/x`, `*/bar/**`},
+		{true, `deep/foo/bar/baz/x`, `**/bar/*/*`},
+
+		// Various additional tests
+		{false, `acrt`, `a[c-c]st`},
+		{true, `acrt`, `a[c-c]rt`},
+		{false, `]`, `[!]-]`},
+		{true, `a`, `[!]-]`},
+		{false, ``, `\`},
+		{false, `\`, `\`},
+		{false, `XXX/\`, `*/\`},
+		{true, `XXX/\`, `*/\\`},
+		{true, `@foo`, `@foo`},
+		{false, `foo`, `@foo`},
+		{true, `[ab]`, `\[ab]`},
+		{true, `[ab]`, `[[]ab]`},
+		{true, `[ab]`, `[[:]ab]`},
+		{false, `[ab]`, `[[::]ab]`},
+		{true, `[ab]`, `[[:digit]ab]`},
+		{true, `[ab]`, `[\[:]ab]`},
+		{true, `?a?b`, `\??\?b`},
+		{true, `abc`, `\a\b\c`},
+		{false, `foo`, ``},
+		{true, `foo/bar/baz/to`, `**/t[o]`},
+
+		// Character class tests
+		{true, `a1B`, `[[:alpha:]][[:digit:]][[:upper:]]`},
+		{false, `a`, `[[:digit:][:upper:][:space:]]`},
+		{true, `A`, `[[:digit:][:upper:][:space:]]`},
+		{true, `1`, `[[:digit:][:upper:][:space:]]`},
+		{false, `1`, `[[:digit:][:upper:][:spaci:]]`},
+		{true, ` `, `[[:digit:][:upper:][:space:]]`},
+		{false, `.`, `[[:digit:][:upper:][:space:]]`},
+		{true, `.`, `[[:digit:][:punct:][:space:]]`},
+		{true, `5`, `[[:xdigit:]]`},
+		{true, `f`, `[[:xdigit:]]`},
+		{true, `D`, `[[:xdigit:]]`},
+		{true, `_`, `[[:alnum:][:alpha:][:blank:][:cntrl:][:digit:][:graph:][:lower:][:print:][:punct:][:space:][:upper:][:xdigit:]]`},
+		{true, `.`, `[^[:alnum:][:alpha:][:blank:][:cntrl:][:digit:][:lower:][:space:][:upper:][:xdigit:]]`},
+		{true, `5`, `[a-c[:digit:]x-z]`},
+		{true, `b`, `[a-c[:digit:]x-z]`},
+		{true, `y`, `[a-c[:digit:]x-z]`},
+		{false, `q`, `[a-c[:digit:]x-z]`},
+
+		// Additional tests, including some malformed wildmatch patterns
+		{true, `]`, `[\\-^]`},
+		{false, `[`, `[\\-^]`},
+		{true, `-`, `[\-_]`},
+		{true, `]`, `[\]]`},
+		{false, `\]`, `[\]]`},
+		{false, `\`, `[\]]`},
+		{false, `ab`, `a[]b`},
+		{false, `a[]b`, `a[]b`},
+		{false, `ab[`, `ab[`},
+		{false, `ab`, `[!`},
+		{false, `ab`, `[-`},
+		{true, `-`, `[-]`},
+		{false, `-`, `[a-`},
+		{false, `-`, `[!a-`},
+		{true, `-`, `[--A]`},
+		{true, `5`, `[--A]`},
+		{true, ` `, `[ --]`},
+		{true, `$`, `[ --]`},
+		{true, `-`, `[ --]`},
+		{false, `0`, `[ --]`},
+		{true, `-`, `[---]`},
+		{true, `-`, `[------]`},
+		{false, `j`, `[a-e-n]`},
+		{true, `-`, `[a-e-n]`},
+		{true, `a`, `[!------]`},
+		{false, `[`, `[]-a]`},
+		{true, `^`, `[]-a]`},
+		{false, `^`, `[!]-a]`},
+		{true, `[`, `[!]-a]`},
+		{true, `^`, `[a^bc]`},
+		{true, `-b]`, `[a-]b]`},
+		{false, `\`, `[\]`},
+		{true, `\`, `[\\]`},
+		{false, `\`, `[!\\]`},
+		{true, `G`, `[A-\\]`},
+		{false, `aaabbb`, `b*a`},
+		{false, `aabcaa`, `*ba*`},
+		{true, `,`, `[,]`},
+		{true, `,`, `[\\,]`},
+		{true, `\`, `[\\,]`},
+		{true, `-`, `[,-.]`},
+		{false, `+`, `[,-.]`},
+		{false, `-.]`, `[,-.]`},
+		{true, `2`, `[\1-\3]`},
+		{true, `3`, `[\1-\3]`},
+		{false, `4`, `[\1-\3]`},
+		{true, `\`, `[[-\]]`},
+		{true, `[`, `[[-\]]`},
+		{true, `]`, `[[-\]]`},
+		{false, `-`, `[[-\]]`},
+
+		// Test recursion
+		{true, `-adobe-courier-bold-o-normal--12-120-75-75-m-70-iso8859-1`, `-*-*-*-*-*-*-12-*-*-*-m-*-*-*`},
+		{false, `-adobe-courier-bold-o-normal--12-120-75-75-X-70-iso8859-1`, `-*-*-*-*-*-*-12-*-*-*-m-*-*-*`},
+		{false, `-adobe-courier-bold-o-normal--12-120-75-75-/-70-iso8859-1`, `-*-*-*-*-*-*-12-*-*-*-m-*-*-*`},
+		{true, `XXX/adobe/courier/bold/o/normal//12/120/75/75/m/70/iso8859/1`, `XXX/*/*/*/*/*/*/12/*/*/*/m/*/*/*`},
+		{false, `XXX/adobe/courier/bold/o/normal//12/120/75/75/X/70/iso8859/1`, `XXX/*/*/*/*/*/*/12/*/*/*/m/*/*/*`},
+		{true, `abcd/abcdefg/abcdefghijk/abcdefghijklmnop.txt`, `**/*a*b*g*n*t`},
+		{false, `abcd/abcdefg/abcdefghijk/abcdefghijklmnop.txtz`, `**/*a*b*g*n*t`},
+		{false, `foo`, `*/*/*`},
+		{false, `foo/bar`, `*/*/*`},
+		{true, `foo/bba/arr`, `*/*/*`},
+		{false, `foo/bb/aa/rr`, `*/*/*`},
+		{true, `foo/bb/aa/rr`, `**/**/**`},
+		{true, `abcXdefXghi`, `*X*i`},
+		{false, `ab/cXd/efXg/hi`, `*X*i`},
+		{true, `ab/cXd/efXg/hi`, `*/*X*/*/*i`},
+		{true, `ab/cXd/efXg/hi`, `**/*X*/**/*i`},
+	}
+
+	for _, c := range cases {
+		if got := wildmatch(c.pattern, c.text); got != c.match {
+			t.Errorf("wildmatch(%q, %q) expected %v got %v", c.pattern, c.text, c.match, got)
+		}
+	}
+}
+
+// Expectations for these were checked against git check-ignore
+func TestMatchSemantics(t *testing.T) {
+	cases := []struct {
+		name     string
+		patterns string
+		path     string
+		isDir    bool
+		ignored  bool
+	}{
+		{"basename any level", "*.log", "a/b/c.log", false, true},
+		{"basename no match", "*.log", "a/b/c.txt", false, false},
+		{"anchored root", "/build", "build", true, true},
+		{"anchored not nested", "/build", "src/build", true, false},
+		{"middle slash anchors", "doc/frotz", "a/doc/frotz", false, false},
+		{"middle slash matches from root", "doc/frotz", "doc/frotz", false, true},
+		{"directory only matches directory", "build/", "build", true, true},
+		{"directory only skips file", "build/", "build", false, false},
+		{"directory only nested", "build/", "src/build", true, true},
+		{"files under ignored directory", "build/", "build/out/a.o", false, true},
+		{"last match wins negation", "*.log\n!keep.log", "keep.log", false, false},
+		{"last match wins ignore", "!keep.log\n*.log", "keep.log", false, true},
+		{"negation needs later match", "*.log\n!keep.log\nkeep.log", "keep.log", false, true},
+		{"cannot re-include below ignored directory", "build/\n!build/keep.txt", "build/keep.txt", false, true},
+		{"re-include from ignored contents", "build/*\n!build/keep.txt", "build/keep.txt", false, false},
+		{"ignored contents", "build/*\n!build/keep.txt", "build/other.txt", false, true},
+		{"re-include directory", "/*\n!/src", "src/main.go", false, false},
+		{"re-include directory siblings", "/*\n!/src", "docs/readme.md", false, true},
+		{"leading double star", "**/foo", "a/b/foo", false, true},
+		{"leading double star root", "**/foo", "foo", false, true},
+		{"leading double star directory", "**/foo/bar", "x/foo/bar", false, true},
+		{"trailing double star", "abc/**", "abc/d/e", false, true},
+		{"trailing double star not directory itself", "abc/**", "abc", true, false},
+		{"middle double star", "a/**/b", "a/x/y/b", false, true},
+		{"middle double star zero", "a/**/b", "a/b", false, true},
+		{"single star stays in directory", "a/*/b", "a/x/y/b", false, false},
+		{"escaped hash", `\#file`, "#file", false, true},
+		{"hash is comment", "#file", "#file", false, false},
+		{"escaped bang", `\!important`, "!important", false, true},
+		{"trailing spaces trimmed", "foo   ", "foo", false, true},
+		{"trailing spaces trimmed not literal", "foo   ", "foo   ", false, false},
+		{"escaped trailing space kept", `foo\ `, "foo ", false, true},
+		{"escaped trailing space required", `foo\ `, "foo", false, false},
+		{"leading spaces kept", " foo", " foo", false, true},
+		{"leading spaces required", " foo", "foo", false, false},
+		{"carriage return stripped", "foo\r", "foo", false, true},
+		{"character class", "*.[oa]", "lib.a", false, true},
+		{"question mark", "fo?", "foo", false, true},
+		{"question mark no slash", "a/fo?", "a/fo/", false, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ignore := NewGitIgnoreFromReader("/repo", strings.NewReader(c.patterns))
+			if got := ignore.Match(filepath.FromSlash("/repo/"+c.path), c.isDir); got != c.ignored {
+				t.Errorf("patterns %q path %q expected %v got %v", c.patterns, c.path, c.ignored, got)
+			}
+		})
+	}
+}
+
+func TestIgnoredPrecedence(t *testing.T) {
+	root := NewGitIgnoreFromReader("/repo", strings.NewReader("*.log\n"))
+	sub := NewGitIgnoreFromReader("/repo/sub", strings.NewReader("!keep.log\n"))
+	matchers := []IgnoreMatcher{root, sub}
+
+	if !Ignored(matchers, "/repo/sub/other.log", false) {
+		t.Error("expected parent pattern to apply when the deeper file does not match")
+	}
+	if Ignored(matchers, "/repo/sub/keep.log", false) {
+		t.Error("expected deeper negation to re-include")
+	}
+	if !Ignored(matchers, "/repo/keep.log", false) {
+		t.Error("expected deeper file to not apply outside its directory")
+	}
+}
+
+func TestParseExcludesFile(t *testing.T) {
+	cases := []struct {
+		config   string
+		expected string
+		found    bool
+	}{
+		{"[core]\n\texcludesFile = ~/.gitignore_global\n", "~/.gitignore_global", true},
+		{"[CORE]\n\tEXCLUDESFILE=/tmp/ignore ; comment\n", "/tmp/ignore", true},
+		{"[core]\n\texcludesfile = \"/tmp/with space\"\n", "/tmp/with space", true},
+		{"[user]\n\texcludesfile = /tmp/nope\n", "", false},
+		{"[core \"sub\"]\n\texcludesfile = /tmp/nope\n", "", false},
+		{"[core]\n\texcludesfile = /tmp/first\n[core]\n\texcludesfile = /tmp/second\n", "/tmp/second", true},
+	}
+
+	for _, c := range cases {
+		got, found := parseExcludesFile(strings.NewReader(c.config))
+		if got != c.expected || found != c.found {
+			t.Errorf("config %q expected %q %v got %q %v", c.config, c.expected, c.found, got, found)
+		}
+	}
+}
+
+func TestGlobalExcludesFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("GIT_CONFIG_GLOBAL", "")
+
+	if got := GlobalExcludesFile(); got != filepath.Join(dir, ".config", "git", "ignore") {
+		t.Errorf("expected default location got %s", got)
+	}
+
+	_ = os.WriteFile(filepath.Join(dir, ".gitconfig"), []byte("[core]\n\texcludesFile = ~/global.ignore\n"), 0600)
+	if got := GlobalExcludesFile(); got != filepath.Join(dir, "global.ignore") {
+		t.Errorf("expected configured location got %s", got)
+	}
+
+	config := filepath.Join(dir, "other.config")
+	_ = os.WriteFile(config, []byte("[core]\n\texcludesFile = /elsewhere/ignore\n"), 0600)
+	t.Setenv("GIT_CONFIG_GLOBAL", config)
+	if got := GlobalExcludesFile(); got != "/elsewhere/ignore" {
+		t.Errorf("expected GIT_CONFIG_GLOBAL location got %s", got)
+	}
+}
@@ -1,69 +1,82 @@
 package gitignore
 
-import (
-	"path/filepath"
-	"strings"
-)
-
-var Separator = string(filepath.Separator)
+import "strings"
 
 type pattern struct {
-	hasRootPrefix     bool
-	hasDirSuffix      bool
-	pathDepth         int
-	matcher           pathMatcher
-	onlyEqualizedPath bool
+	pattern   string
+	negate    bool
+	mustBeDir bool
+	basename  bool
 }
 
-func newPattern(path string) pattern {
-	hasRootPrefix := path[0] == '/'
-	hasDirSuffix := path[len(path)-1] == '/'
+// parsePattern turns a line from a gitignore file into a pattern following the rules in
+// https://git-scm.com/docs/gitignore returning false for blank lines and comments
+func parsePattern(line string) (pattern, bool) {
+	line = strings.TrimSuffix(line, "\r")
+	if line == "" || line[0] == '#' {
+		return pattern{}, false
+	}
+
+	line = trimTrailingSpaces(line)
 
-	var pathDepth int
-	if !hasRootPrefix {
-		pathDepth = strings.Count(path, "/")
+	p := pattern{}
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
 	}
 
-	var matcher pathMatcher
-	matchingPath := strings.Trim(path, "/")
-	if hasMeta(path) {
-		matcher = filepathMatcher{path: matchingPath}
-	} else {
-		matcher = simpleMatcher{path: matchingPath}
+	if strings.HasSuffix(line, "/") {
+		p.mustBeDir = true
+		line = line[:len(line)-1]
 	}
 
-	return pattern{
-		hasRootPrefix: hasRootPrefix,
-		hasDirSuffix:  hasDirSuffix,
-		pathDepth:     pathDepth,
-		matcher:       matcher,
+	if line == "" {
+		return pattern{}, false
 	}
-}
 
-func newPatternForEqualizedPath(path string) pattern {
-	pattern := newPattern(path)
-	pattern.onlyEqualizedPath = true
-	return pattern
+	// Patterns without a slash match at any level, otherwise they are relative to the gitignore
+	if !strings.Contains(line, "/") {
+		p.basename = true
+	}
+	p.pattern = strings.TrimPrefix(line, "/")
+
+	return p, true
 }
 
-func (p pattern) match(path string, isDir bool) bool {
+// trimTrailingSpaces removes trailing spaces unless they are escaped with a backslash
+func trimTrailingSpaces(line string) string {
+	lastSpace := -1
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case ' ':
+			if lastSpace == -1 {
+				lastSpace = i
+			}
+		case '\\':
+			i++
+			if i >= len(line) {
+				return line
+			}
+			lastSpace = -1
+		default:
+			lastSpace = -1
+		}
+	}
 
-	if p.hasDirSuffix && !isDir {
+	if lastSpace != -1 {
+		return line[:lastSpace]
+	}
+	return line
+}
+
+func (p pattern) match(path string, basename string, isDir bool) bool {
+	if p.mustBeDir && !isDir {
 		return false
 	}
 
-	var targetPath string
-	if p.hasRootPrefix || p.onlyEqualizedPath {
-		// absolute pattern or only equalized path mode
-		targetPath = path
-	} else {
-		// relative pattern
-		targetPath = p.equalizeDepth(path)
+	if p.basename {
+		return wildmatch(p.pattern, basename)
 	}
-	return p.matcher.match(targetPath)
-}
 
-func (p pattern) equalizeDepth(path string) string {
-	equalizedPath, _ := cutLastN(path, p.pathDepth+1)
-	return equalizedPath
+	return wildmatch(p.pattern, path)
 }
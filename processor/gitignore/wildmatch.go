@@ -0,0 +1,267 @@
+package gitignore
+
+// This is a port of wildmatch.c from git which is what git itself uses to match
+// gitignore patterns. Only the WM_PATHNAME behaviour is needed so '*' and '?'
+// never match a '/' while '**' can when it is a whole path component.
+
+const (
+	wmNoMatch = iota
+	wmMatch
+	wmAbortAll
+	wmAbortToStarStar
+)
+
+// wildmatch reports if text matches the pattern using git's WM_PATHNAME rules
+func wildmatch(pattern string, text string) bool {
+	return dowild(pattern, 0, text, 0) == wmMatch
+}
+
+func isGlobSpecial(c byte) bool {
+	return c == '*' || c == '?' || c == '[' || c == '\\'
+}
+
+func dowild(p string, pi int, text string, ti int) int {
+	at := func(s string, i int) byte {
+		if i < len(s) {
+			return s[i]
+		}
+		return 0
+	}
+
+	for ; pi < len(p); pi, ti = pi+1, ti+1 {
+		pCh := p[pi]
+		tCh := at(text, ti)
+
+		if tCh == 0 && pCh != '*' {
+			return wmAbortAll
+		}
+
+		switch pCh {
+		case '\\':
+			// Literal match with following character, a trailing backslash never matches
+			pi++
+			if pi >= len(p) || tCh != p[pi] {
+				return wmNoMatch
+			}
+		default:
+			if tCh != pCh {
+				return wmNoMatch
+			}
+		case '?':
+			// Match anything but '/'
+			if tCh == '/' {
+				return wmNoMatch
+			}
+		case '*':
+			matchSlash := false
+			pi++
+			if at(p, pi) == '*' {
+				prev := pi - 2
+				for at(p, pi+1) == '*' {
+					pi++
+				}
+				pi++
+				if (prev < 0 || p[prev] == '/') && (pi >= len(p) || p[pi] == '/' || (p[pi] == '\\' && at(p, pi+1) == '/')) {
+					// Assuming we already match "foo/" and are at "**/" just assume it matches
+					// nothing and go ahead match the rest of the pattern with the remaining string
+					// so that foo/**/bar matches both foo/bar and foo/a/bar
+					if pi < len(p) && p[pi] == '/' && dowild(p, pi+1, text, ti) == wmMatch {
+						return wmMatch
+					}
+					matchSlash = true
+				}
+			}
+
+			if pi >= len(p) {
+				// Trailing "**" matches everything, trailing "*" only if there are no more slashes
+				if !matchSlash {
+					for i := ti; i < len(text); i++ {
+						if text[i] == '/' {
+							return wmNoMatch
+						}
+					}
+				}
+				return wmMatch
+			} else if !matchSlash && p[pi] == '/' {
+				// One asterisk followed by a slash matches the next directory
+				slash := -1
+				for i := ti; i < len(text); i++ {
+					if text[i] == '/' {
+						slash = i
+						break
+					}
+				}
+				if slash == -1 {
+					return wmNoMatch
+				}
+				// The slash is consumed by the outer loop
+				ti = slash
+				continue
+			}
+
+			for {
+				if tCh == 0 {
+					break
+				}
+
+				// Try to advance faster when an asterisk is followed by a literal as
+				// everything before the literal must belong to the asterisk
+				if !isGlobSpecial(p[pi]) {
+					pCh = p[pi]
+					for {
+						tCh = at(text, ti)
+						if tCh == 0 || (!matchSlash && tCh == '/') || tCh == pCh {
+							break
+						}
+						ti++
+					}
+					if tCh != pCh {
+						return wmNoMatch
+					}
+				}
+
+				matched := dowild(p, pi, text, ti)
+				if matched != wmNoMatch {
+					if !matchSlash || matched != wmAbortToStarStar {
+						return matched
+					}
+				} else if !matchSlash && tCh == '/' {
+					return wmAbortToStarStar
+				}
+
+				ti++
+				tCh = at(text, ti)
+			}
+			return wmAbortAll
+		case '[':
+			pi++
+			pCh = at(p, pi)
+			if pCh == '^' {
+				pCh = '!'
+			}
+			negated := pCh == '!'
+			if negated {
+				pi++
+				pCh = at(p, pi)
+			}
+
+			var prevCh byte
+			matched := false
+			for {
+				if pCh == 0 {
+					return wmAbortAll
+				}
+
+				if pCh == '\\' {
+					pi++
+					pCh = at(p, pi)
+					if pCh == 0 {
+						return wmAbortAll
+					}
+					if tCh == pCh {
+						matched = true
+					}
+				} else if pCh == '-' && prevCh != 0 && at(p, pi+1) != 0 && at(p, pi+1) != ']' {
+					pi++
+					pCh = p[pi]
+					if pCh == '\\' {
+						pi++
+						pCh = at(p, pi)
+						if pCh == 0 {
+							return wmAbortAll
+						}
+					}
+					if tCh <= pCh && tCh >= prevCh {
+						matched = true
+					}
+					pCh = 0 // This makes prevCh get set to 0
+				} else if pCh == '[' && at(p, pi+1) == ':' {
+					start := pi + 2
+					end := start
+					for end < len(p) && p[end] != ']' {
+						end++
+					}
+					if end >= len(p) {
+						return wmAbortAll
+					}
+
+					if end-start-1 < 0 || p[end-1] != ':' {
+						// Didn't find ":]" so treat like a normal set
+						pCh = '['
+						if tCh == pCh {
+							matched = true
+						}
+					} else {
+						class, ok := characterClass(p[start:end-1], tCh)
+						if !ok {
+							// Malformed [:class:] string
+							return wmAbortAll
+						}
+						if class {
+							matched = true
+						}
+						pi = end
+						pCh = 0 // This makes prevCh get set to 0
+					}
+				} else if tCh == pCh {
+					matched = true
+				}
+
+				prevCh = pCh
+				pi++
+				pCh = at(p, pi)
+				if pCh == ']' {
+					break
+				}
+			}
+
+			if matched == negated || tCh == '/' {
+				return wmNoMatch
+			}
+		}
+	}
+
+	if ti < len(text) {
+		return wmNoMatch
+	}
+
+	return wmMatch
+}
+
+// characterClass checks the byte against a POSIX character class returning false for ok if the class is unknown
+func characterClass(class string, c byte) (bool, bool) {
+	isUpper := c >= 'A' && c <= 'Z'
+	isLower := c >= 'a' && c <= 'z'
+	isDigit := c >= '0' && c <= '9'
+	isAlpha := isUpper || isLower
+	isPrint := c >= 0x20 && c < 0x7f
+
+	switch class {
+	case "alnum":
+		return isAlpha || isDigit, true
+	case "alpha":
+		return isAlpha, true
+	case "blank":
+		return c == ' ' || c == '\t', true
+	case "cntrl":
+		return c < 0x20 || c == 0x7f, true
+	case "digit":
+		return isDigit, true
+	case "graph":
+		return isPrint && c != ' ', true
+	case "lower":
+		return isLower, true
+	case "print":
+		return isPrint, true
+	case "punct":
+		return isPrint && c != ' ' && !isAlpha && !isDigit, true
+	case "space":
+		return c == ' ' || (c >= '\t' && c <= '\r'), true
+	case "upper":
+		return isUpper, true
+	case "xdigit":
+		return isDigit || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F'), true
+	}
+
+	return false, false
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package gitrepo
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Index entry flags, see Documentation/gitformat-index.txt in git
+const (
+	indexFlagNameMask     = 0x0fff
+	indexFlagStageMask    = 0x3000
+	indexFlagStageShift   = 12
+	indexFlagExtended     = 0x4000
+	indexFlagIntentToAdd  = 0x2000 // In the extended flags
+	indexEntryFixedLength = 62
+)
+
+var errInvalidIndex = errors.New("invalid index")
+
+// Index reads the entries from the index which is the list of files git tracks along with
+// what is staged for each. Entries added with intent to add are skipped as they have no content.
+func (r *Repository) Index() ([]Entry, error) {
+	data, err := os.ReadFile(filepath.Join(r.gitDir, "index"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return parseIndex(data)
+}
+
+func parseIndex(data []byte) ([]Entry, error) {
+	if len(data) < 12+sha1.Size || !bytes.Equal(data[:4], []byte("DIRC")) {
+		return nil, errInvalidIndex
+	}
+
+	// With index.skipHash the checksum is left as zeros so only check it when set
+	body, checksum := data[:len(data)-sha1.Size], data[len(data)-sha1.Size:]
+	if !bytes.Equal(checksum, make([]byte, sha1.Size)) {
+		if sum := sha1.Sum(body); !bytes.Equal(sum[:], checksum) {
+			return nil, fmt.Errorf("%w: checksum mismatch", errInvalidIndex)
+		}
+	}
+
+	version := binary.BigEndian.Uint32(data[4:8])
+	if version < 2 || version > 4 {
+		return nil, fmt.Errorf("%w: unsupported version %d", errInvalidIndex, version)
+	}
+
+	count := binary.BigEndian.Uint32(data[8:12])
+	entries := make([]Entry, 0, count)
+	offset := 12
+	previous := ""
+
+	for i := uint32(0); i < count; i++ {
+		if offset+indexEntryFixedLength > len(body) {
+			return nil, errInvalidIndex
+		}
+
+		start := offset
+		entry := Entry{
+			Mode: binary.BigEndian.Uint32(data[offset+24 : offset+28]),
+			Size: int64(binary.BigEndian.Uint32(data[offset+36 : offset+40])),
+		}
+		copy(entry.Hash[:], data[offset+40:offset+60])
+		flags := binary.BigEndian.Uint16(data[offset+60 : offset+62])
+		entry.Stage = int(flags&indexFlagStageMask) >> indexFlagStageShift
+		offset += indexEntryFixedLength
+
+		intentToAdd := false
+		if flags&indexFlagExtended != 0 {
+			if version < 3 || offset+2 > len(body) {
+				return nil, errInvalidIndex
+			}
+			intentToAdd = binary.BigEndian.Uint16(data[offset:offset+2])&indexFlagIntentToAdd != 0
+			offset += 2
+		}
+
+		if version == 4 {
+			// Names are prefix compressed against the previous entry and are not padded
+			strip, n := readOffsetVarint(data[offset:])
+			if n == 0 || strip > uint64(len(previous)) {
+				return nil, errInvalidIndex
+			}
+			offset += n
+
+			nul := bytes.IndexByte(body[offset:], 0)
+			if nul == -1 {
+				return nil, errInvalidIndex
+			}
+			entry.Path = previous[:len(previous)-int(strip)] + string(data[offset:offset+nul])
+			offset += nul + 1
+		} else {
+			nul := bytes.IndexByte(body[offset:], 0)
+			if nul == -1 {
+				return nil, errInvalidIndex
+			}
+			entry.Path = string(data[offset : offset+nul])
+
+			// Entries are padded with 1 to 8 nul bytes to a multiple of 8
+			length := offset + nul - start
+			offset = start + (length+8)&^7
+		}
+
+		previous = entry.Path
+		if !intentToAdd {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// readOffsetVarint reads the variable length integer used by index v4 names and pack offset deltas
+// returning the number of bytes read or 0 if the data is truncated
+func readOffsetVarint(data []byte) (uint64, int) {
+	if len(data) == 0 {
+		return 0, 0
+	}
+
+	c := data[0]
+	value := uint64(c & 0x7f)
+	n := 1
+	for c&0x80 != 0 {
+		if n >= len(data) {
+			return 0, 0
+		}
+		c = data[n]
+		n++
+		value = ((value + 1) << 7) | uint64(c&0x7f)
+	}
+
+	return value, n
+}
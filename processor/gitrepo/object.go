@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package gitrepo
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// ObjectType is the type of a git object as stored in pack files
+type ObjectType int
+
+const (
+	ObjectCommit   ObjectType = 1
+	ObjectTree     ObjectType = 2
+	ObjectBlob     ObjectType = 3
+	ObjectTag      ObjectType = 4
+	objectOfsDelta ObjectType = 6
+	objectRefDelta ObjectType = 7
+)
+
+var objectTypeNames = map[string]ObjectType{
+	"commit": ObjectCommit,
+	"tree":   ObjectTree,
+	"blob":   ObjectBlob,
+	"tag":    ObjectTag,
+}
+
+// ErrObjectNotFound is returned when an object is in neither the loose objects nor any pack
+var ErrObjectNotFound = errors.New("object not found")
+
+// ReadBlob returns the content of the blob with the id
+func (r *Repository) ReadBlob(hash Hash) ([]byte, error) {
+	objectType, data, err := r.ReadObject(hash)
+	if err != nil {
+		return nil, err
+	}
+	if objectType != ObjectBlob {
+		return nil, fmt.Errorf("object %s is not a blob", hash)
+	}
+	return data, nil
+}
+
+// ReadObject returns the type and content of the object with the id looking in packs and then loose objects.
+// It is safe to call from multiple goroutines.
+func (r *Repository) ReadObject(hash Hash) (ObjectType, []byte, error) {
+	packs, err := r.loadPacks()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, p := range packs {
+		if offset, ok := p.find(hash); ok {
+			return p.read(r, offset, 0)
+		}
+	}
+
+	for _, dir := range r.objectDirs {
+		objectType, data, err := readLooseObject(dir, hash)
+		if err == nil {
+			return objectType, data, nil
+		}
+		if !os.IsNotExist(err) {
+			return 0, nil, err
+		}
+	}
+
+	return 0, nil, fmt.Errorf("%w: %s", ErrObjectNotFound, hash)
+}
+
+// readLooseObject reads a zlib compressed object stored as objects/xx/yyyy
+func readLooseObject(dir string, hash Hash) (ObjectType, []byte, error) {
+	name := hash.String()
+	file, err := os.Open(filepath.Join(dir, name[:2], name[2:]))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer file.Close()
+
+	z, err := zlib.NewReader(bufio.NewReader(file))
+	if err != nil {
+		return 0, nil, fmt.Errorf("object %s: %w", hash, err)
+	}
+	defer z.Close()
+
+	// The content is preceded by a "<type> <size>\0" header
+	reader := bufio.NewReader(z)
+	header, err := reader.ReadBytes(0)
+	if err != nil {
+		return 0, nil, fmt.Errorf("object %s: invalid header", hash)
+	}
+
+	typeName, sizeText, ok := bytes.Cut(header[:len(header)-1], []byte(" "))
+	objectType, known := objectTypeNames[string(typeName)]
+	size, err := strconv.ParseInt(string(sizeText), 10, 64)
+	if !ok || !known || err != nil || size < 0 {
+		return 0, nil, fmt.Errorf("object %s: invalid header", hash)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		return 0, nil, fmt.Errorf("object %s: %w", hash, err)
+	}
+
+	return objectType, data, nil
+}
@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package gitrepo
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// maxDeltaDepth guards against corrupt packs with delta cycles, git itself defaults to 50
+const maxDeltaDepth = 4096
+
+var errInvalidPack = errors.New("invalid pack")
+
+// pack is a pack file along with its version 2 index which is loaded into memory
+type pack struct {
+	file    *os.File
+	size    int64
+	fanout  [256]uint32
+	hashes  []byte
+	offsets []byte
+	large   []byte
+}
+
+// loadPacks opens every pack in the object directories the first time it is called
+func (r *Repository) loadPacks() ([]*pack, error) {
+	r.packsOnce.Do(func() {
+		for _, dir := range r.objectDirs {
+			matches, _ := filepath.Glob(filepath.Join(dir, "pack", "*.idx"))
+			for _, idx := range matches {
+				p, err := openPack(idx, strings.TrimSuffix(idx, ".idx")+".pack")
+				if err != nil {
+					r.packsErr = fmt.Errorf("%s: %w", idx, err)
+					return
+				}
+				r.packs = append(r.packs, p)
+			}
+		}
+	})
+
+	return r.packs, r.packsErr
+}
+
+func openPack(idxPath string, packPath string) (*pack, error) {
+	idx, err := os.ReadFile(idxPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Version 2 index: magic, version, fanout, sorted ids, crcs, 4 byte offsets then 8 byte offsets
+	if len(idx) < 8+256*4 || !bytes.Equal(idx[:4], []byte{0xff, 't', 'O', 'c'}) || binary.BigEndian.Uint32(idx[4:8]) != 2 {
+		return nil, fmt.Errorf("%w: unsupported index version", errInvalidPack)
+	}
+
+	p := &pack{}
+	for i := 0; i < 256; i++ {
+		p.fanout[i] = binary.BigEndian.Uint32(idx[8+i*4:])
+	}
+
+	count := int(p.fanout[255])
+	start := 8 + 256*4
+	if len(idx) < start+count*(20+4+4) {
+		return nil, errInvalidPack
+	}
+	p.hashes = idx[start : start+count*20]
+	p.offsets = idx[start+count*24 : start+count*28]
+	p.large = idx[start+count*28:]
+
+	p.file, err = os.Open(packPath)
+	if err != nil {
+		return nil, err
+	}
+	info, err := p.file.Stat()
+	if err != nil {
+		_ = p.file.Close()
+		return nil, err
+	}
+	p.size = info.Size()
+
+	return p, nil
+}
+
+// find returns the offset of the object in the pack
+func (p *pack) find(hash Hash) (int64, bool) {
+	low := 0
+	if hash[0] > 0 {
+		low = int(p.fanout[hash[0]-1])
+	}
+	high := int(p.fanout[hash[0]])
+
+	i := low + sort.Search(high-low, func(i int) bool {
+		return bytes.Compare(p.hashes[(low+i)*20:(low+i+1)*20], hash[:]) >= 0
+	})
+	if i >= high || !bytes.Equal(p.hashes[i*20:(i+1)*20], hash[:]) {
+		return 0, false
+	}
+
+	offset := binary.BigEndian.Uint32(p.offsets[i*4:])
+	if offset&0x80000000 == 0 {
+		return int64(offset), true
+	}
+
+	// Packs over 2GB keep the offset in a table of 8 byte offsets
+	large := int(offset&0x7fffffff) * 8
+	if large+8 > len(p.large) {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(p.large[large:])), true
+}
+
+// read returns the object at the offset applying any deltas
+func (p *pack) read(r *Repository, offset int64, depth int) (ObjectType, []byte, error) {
+	if depth > maxDeltaDepth || offset < 0 || offset >= p.size {
+		return 0, nil, errInvalidPack
+	}
+
+	reader := bufio.NewReader(io.NewSectionReader(p.file, offset, p.size-offset))
+
+	// The header holds the type in bits 4-6 of the first byte and the inflated size as a varint
+	c, err := reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	objectType := ObjectType((c >> 4) & 7)
+	size := uint64(c & 0x0f)
+	for shift := 4; c&0x80 != 0; shift += 7 {
+		if c, err = reader.ReadByte(); err != nil {
+			return 0, nil, err
+		}
+		size |= uint64(c&0x7f) << shift
+	}
+
+	switch objectType {
+	case ObjectCommit, ObjectTree, ObjectBlob, ObjectTag:
+		data, err := inflate(reader, size)
+		return objectType, data, err
+	case objectOfsDelta:
+		// The base is earlier in the same pack at a negative offset encoded like index v4 names
+		var buf [10]byte
+		n := 0
+		for n < len(buf) {
+			if buf[n], err = reader.ReadByte(); err != nil {
+				return 0, nil, err
+			}
+			n++
+			if buf[n-1]&0x80 == 0 {
+				break
+			}
+		}
+		distance, read := readOffsetVarint(buf[:n])
+		if read == 0 {
+			return 0, nil, errInvalidPack
+		}
+
+		delta, err := inflate(reader, size)
+		if err != nil {
+			return 0, nil, err
+		}
+		baseType, base, err := p.read(r, offset-int64(distance), depth+1)
+		if err != nil {
+			return 0, nil, err
+		}
+		data, err := applyDelta(base, delta)
+		return baseType, data, err
+	case objectRefDelta:
+		var baseHash Hash
+		if _, err := io.ReadFull(reader, baseHash[:]); err != nil {
+			return 0, nil, err
+		}
+
+		delta, err := inflate(reader, size)
+		if err != nil {
+			return 0, nil, err
+		}
+		baseType, base, err := r.ReadObject(baseHash)
+		if err != nil {
+			return 0, nil, err
+		}
+		data, err := applyDelta(base, delta)
+		return baseType, data, err
+	}
+
+	return 0, nil, fmt.Errorf("%w: unknown object type %d", errInvalidPack, objectType)
+}
+
+func inflate(reader io.Reader, size uint64) ([]byte, error) {
+	z, err := zlib.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer z.Close()
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(z, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// applyDelta rebuilds an object from its base and a delta made of copy and insert instructions
+func applyDelta(base []byte, delta []byte) ([]byte, error) {
+	readSize := func() (uint64, bool) {
+		var size uint64
+		for shift := 0; len(delta) > 0; shift += 7 {
+			c := delta[0]
+			delta = delta[1:]
+			size |= uint64(c&0x7f) << shift
+			if c&0x80 == 0 {
+				return size, true
+			}
+		}
+		return 0, false
+	}
+
+	baseSize, ok := readSize()
+	if !ok || baseSize != uint64(len(base)) {
+		return nil, fmt.Errorf("%w: delta base size mismatch", errInvalidPack)
+	}
+	resultSize, ok := readSize()
+	if !ok {
+		return nil, errInvalidPack
+	}
+
+	result := make([]byte, 0, resultSize)
+	for len(delta) > 0 {
+		cmd := delta[0]
+		delta = delta[1:]
+
+		switch {
+		case cmd&0x80 != 0:
+			// Copy from the base with the offset and size bytes present according to the low bits
+			var offset, size uint64
+			for i := uint(0); i < 7; i++ {
+				if cmd&(1<<i) == 0 {
+					continue
+				}
+				if len(delta) == 0 {
+					return nil, errInvalidPack
+				}
+				if i < 4 {
+					offset |= uint64(delta[0]) << (8 * i)
+				} else {
+					size |= uint64(delta[0]) << (8 * (i - 4))
+				}
+				delta = delta[1:]
+			}
+			if size == 0 {
+				size = 0x10000
+			}
+			if offset+size > uint64(len(base)) {
+				return nil, errInvalidPack
+			}
+			result = append(result, base[offset:offset+size]...)
+		case cmd != 0:
+			if int(cmd) > len(delta) {
+				return nil, errInvalidPack
+			}
+			result = append(result, delta[:cmd]...)
+			delta = delta[cmd:]
+		default:
+			return nil, fmt.Errorf("%w: reserved delta instruction", errInvalidPack)
+		}
+	}
+
+	if uint64(len(result)) != resultSize {
+		return nil, fmt.Errorf("%w: delta result size mismatch", errInvalidPack)
+	}
+	return result, nil
+}
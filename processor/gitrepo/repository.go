@@ -0,0 +1,289 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+// Package gitrepo reads just enough of a git repository, the index, refs and objects, to list
+// and read tracked files without needing the git binary. Only SHA-1 repositories are supported.
+package gitrepo
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ErrNotRepository is returned when no repository is found at or above a path
+var ErrNotRepository = errors.New("not a git repository")
+
+// Hash is the SHA-1 id of an object
+type Hash [20]byte
+
+func (h Hash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// ParseHash converts the hex form of an object id
+func ParseHash(s string) (Hash, error) {
+	var h Hash
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != len(h) {
+		return h, fmt.Errorf("invalid object id %q", s)
+	}
+	copy(h[:], b)
+	return h, nil
+}
+
+// File modes of entries in the index and trees
+const (
+	ModeRegular    = 0100644
+	ModeExecutable = 0100755
+	ModeSymlink    = 0120000
+	ModeGitlink    = 0160000
+	ModeTree       = 0040000
+)
+
+// Entry is a file in the index or a tree
+type Entry struct {
+	Path  string // Relative to the work tree using / as the separator
+	Hash  Hash
+	Mode  uint32
+	Size  int64 // Size of the file in the work tree when it was staged, zero for tree entries
+	Stage int   // Non zero for the sides of a merge conflict
+}
+
+// IsRegular reports if the entry is a normal or executable file rather than a symlink or submodule
+func (e Entry) IsRegular() bool {
+	return e.Mode&0170000 == 0100000
+}
+
+// Repository is a git repository with a work tree
+type Repository struct {
+	WorkTree   string
+	gitDir     string
+	commonDir  string
+	objectDirs []string
+
+	packsOnce sync.Once
+	packs     []*pack
+	packsErr  error
+}
+
+// Open finds the repository containing path by looking for .git in it and its parents
+func Open(path string) (*Repository, error) {
+	dir, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+
+	for {
+		gitDir, err := findGitDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		if gitDir != "" {
+			return open(dir, gitDir)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, fmt.Errorf("%w: %s", ErrNotRepository, path)
+		}
+		dir = parent
+	}
+}
+
+// findGitDir returns the git directory for dir if it has a .git directory or a .git file pointing
+// to one as is used by worktrees and submodules
+func findGitDir(dir string) (string, error) {
+	dotGit := filepath.Join(dir, ".git")
+	info, err := os.Stat(dotGit)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if info.IsDir() {
+		return dotGit, nil
+	}
+
+	content, err := os.ReadFile(dotGit)
+	if err != nil {
+		return "", err
+	}
+
+	line := strings.TrimSpace(string(content))
+	if !strings.HasPrefix(line, "gitdir:") {
+		return "", fmt.Errorf("invalid .git file %s", dotGit)
+	}
+
+	gitDir := strings.TrimSpace(strings.TrimPrefix(line, "gitdir:"))
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(dir, gitDir)
+	}
+	return gitDir, nil
+}
+
+func open(workTree string, gitDir string) (*Repository, error) {
+	r := &Repository{
+		WorkTree:  workTree,
+		gitDir:    gitDir,
+		commonDir: gitDir,
+	}
+
+	// Linked worktrees share objects and refs with the main repository
+	if content, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir := strings.TrimSpace(string(content))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+		r.commonDir = commonDir
+	}
+
+	objects := filepath.Join(r.commonDir, "objects")
+	r.objectDirs = append(r.objectDirs, objects)
+
+	if content, err := os.ReadFile(filepath.Join(objects, "info", "alternates")); err == nil {
+		for _, line := range strings.Split(string(content), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			if !filepath.IsAbs(line) {
+				line = filepath.Join(objects, line)
+			}
+			r.objectDirs = append(r.objectDirs, line)
+		}
+	}
+
+	return r, nil
+}
+
+// Head resolves HEAD to the id of the commit it points at
+func (r *Repository) Head() (Hash, error) {
+	return r.resolveRef("HEAD", 0)
+}
+
+func (r *Repository) resolveRef(name string, depth int) (Hash, error) {
+	if depth > 10 {
+		return Hash{}, fmt.Errorf("too many levels of symbolic refs resolving %s", name)
+	}
+
+	// HEAD and other per worktree refs live in the git directory, everything else in the common one
+	for _, dir := range []string{r.gitDir, r.commonDir} {
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			continue
+		}
+
+		value := strings.TrimSpace(string(content))
+		if strings.HasPrefix(value, "ref:") {
+			return r.resolveRef(strings.TrimSpace(strings.TrimPrefix(value, "ref:")), depth+1)
+		}
+		return ParseHash(value)
+	}
+
+	file, err := os.Open(filepath.Join(r.commonDir, "packed-refs"))
+	if err == nil {
+		defer file.Close()
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, "#") || strings.HasPrefix(line, "^") {
+				continue
+			}
+			if hash, ref, ok := strings.Cut(line, " "); ok && ref == name {
+				return ParseHash(hash)
+			}
+		}
+	}
+
+	return Hash{}, fmt.Errorf("unable to resolve ref %s", name)
+}
+
+// HeadTree lists every file in the tree of the commit HEAD points at
+func (r *Repository) HeadTree() ([]Entry, error) {
+	commit, err := r.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	objectType, data, err := r.ReadObject(commit)
+	if err != nil {
+		return nil, err
+	}
+	if objectType != ObjectCommit {
+		return nil, fmt.Errorf("HEAD %s is not a commit", commit)
+	}
+
+	if !bytes.HasPrefix(data, []byte("tree ")) || len(data) < 45 {
+		return nil, fmt.Errorf("invalid commit %s", commit)
+	}
+	tree, err := ParseHash(string(data[5:45]))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	err = r.walkTree(tree, "", &entries)
+	return entries, err
+}
+
+// walkTree flattens the tree adding every non tree entry to entries
+func (r *Repository) walkTree(tree Hash, prefix string, entries *[]Entry) error {
+	objectType, data, err := r.ReadObject(tree)
+	if err != nil {
+		return err
+	}
+	if objectType != ObjectTree {
+		return fmt.Errorf("object %s is not a tree", tree)
+	}
+
+	// Each entry is "<octal mode> <name>\0<20 byte id>"
+	for len(data) > 0 {
+		space := bytes.IndexByte(data, ' ')
+		if space == -1 {
+			return fmt.Errorf("invalid tree %s", tree)
+		}
+		nul := bytes.IndexByte(data[space:], 0)
+		if nul == -1 || space+nul+21 > len(data) {
+			return fmt.Errorf("invalid tree %s", tree)
+		}
+		nul += space
+
+		var mode uint32
+		for _, c := range data[:space] {
+			if c < '0' || c > '7' {
+				return fmt.Errorf("invalid mode in tree %s", tree)
+			}
+			mode = mode<<3 | uint32(c-'0')
+		}
+
+		entry := Entry{
+			Path: prefix + string(data[space+1:nul]),
+			Mode: mode,
+		}
+		copy(entry.Hash[:], data[nul+1:nul+21])
+		data = data[nul+21:]
+
+		if mode&0170000 == ModeTree {
+			if err := r.walkTree(entry.Hash, entry.Path+"/", entries); err != nil {
+				return err
+			}
+			continue
+		}
+
+		*entries = append(*entries, entry)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package gitrepo
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// newTestRepository creates a repository using the git binary so what is read natively can be checked against it
+func newTestRepository(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	t.Setenv("GIT_CONFIG_GLOBAL", filepath.Join(dir, "gitconfig"))
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+
+	runGit(t, dir, "init", "-q", ".")
+	runGit(t, dir, "config", "user.email", "test@example.com")
+	runGit(t, dir, "config", "user.name", "test")
+	return dir
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v %s", args, err, out)
+	}
+	return string(out)
+}
+
+func writeFile(t *testing.T, dir string, name string, content string) {
+	t.Helper()
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	_ = os.MkdirAll(filepath.Dir(path), 0755)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// lsFiles returns the output of git ls-files -s in the same form as formatEntries
+func lsFiles(t *testing.T, dir string) string {
+	return runGit(t, dir, "ls-files", "-s")
+}
+
+func formatEntries(entries []Entry) string {
+	var sb strings.Builder
+	for _, e := range entries {
+		sb.WriteString(fmt.Sprintf("%06o %s %d\t%s\n", e.Mode, e.Hash, e.Stage, e.Path))
+	}
+	return sb.String()
+}
+
+func TestIndexMatchesGit(t *testing.T) {
+	for _, version := range []string{"2", "3", "4"} {
+		t.Run("v"+version, func(t *testing.T) {
+			dir := newTestRepository(t)
+			writeFile(t, dir, "main.go", "package main\n")
+			writeFile(t, dir, "a/b/c/deep.py", "print(1)\n")
+			writeFile(t, dir, "a/b/c/deeper.py", "print(2)\n")
+			writeFile(t, dir, "a/b/other.txt", "text\n")
+			writeFile(t, dir, "a-very-long-directory-name-to-check-padding/x.c", "int x;\n")
+			runGit(t, dir, "add", ".")
+			writeFile(t, dir, "intent.go", "package main\n")
+			runGit(t, dir, "add", "-N", "intent.go")
+			runGit(t, dir, "update-index", "--index-version", version)
+
+			r, err := Open(filepath.Join(dir, "a", "b"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.WorkTree != dir {
+				t.Errorf("expected work tree %s got %s", dir, r.WorkTree)
+			}
+
+			entries, err := r.Index()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := strings.Replace(lsFiles(t, dir), "100644 e69de29bb2d1d6434b8b29ae775ad8c2e48c5391 0\tintent.go\n", "", 1)
+			if got := formatEntries(entries); got != expected {
+				t.Errorf("expected\n%s\ngot\n%s", expected, got)
+			}
+		})
+	}
+}
+
+func TestReadBlobLooseAndPacked(t *testing.T) {
+	dir := newTestRepository(t)
+
+	// Similar content across commits makes git gc store deltas
+	content := strings.Repeat("func line() {}\n", 200)
+	writeFile(t, dir, "main.go", content)
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-q", "-m", "one")
+	for i := 0; i < 5; i++ {
+		content += fmt.Sprintf("// change %d\n", i)
+		writeFile(t, dir, "main.go", content)
+		runGit(t, dir, "commit", "-q", "-am", fmt.Sprintf("change %d", i))
+	}
+
+	check := func() {
+		r, err := Open(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries, err := r.Index()
+		if err != nil || len(entries) != 1 {
+			t.Fatalf("expected one entry got %v %v", entries, err)
+		}
+		blob, err := r.ReadBlob(entries[0].Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(blob) != content {
+			t.Errorf("blob content does not match")
+		}
+
+		// Older versions are the ones stored as deltas once packed
+		for i := 1; i <= 5; i++ {
+			hash, err := ParseHash(strings.TrimSpace(runGit(t, dir, "rev-parse", fmt.Sprintf("HEAD~%d:main.go", i))))
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := runGit(t, dir, "cat-file", "blob", hash.String())
+			blob, err := r.ReadBlob(hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(blob) != expected {
+				t.Errorf("HEAD~%d blob content does not match", i)
+			}
+		}
+	}
+
+	check()
+	runGit(t, dir, "gc", "-q", "--aggressive")
+	check()
+}
+
+func TestHeadTree(t *testing.T) {
+	dir := newTestRepository(t)
+	writeFile(t, dir, "main.go", "package main\n")
+	writeFile(t, dir, "sub/dir/lib.go", "package dir\n")
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-q", "-m", "one")
+	runGit(t, dir, "checkout", "-q", "-b", "feature")
+	runGit(t, dir, "pack-refs", "--all")
+	writeFile(t, dir, "staged.go", "package main\n")
+	runGit(t, dir, "add", ".")
+
+	r, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := r.HeadTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	for _, e := range entries {
+		paths = append(paths, e.Path)
+	}
+	sort.Strings(paths)
+	if strings.Join(paths, ",") != "main.go,sub/dir/lib.go" {
+		t.Errorf("unexpected HEAD tree %v", paths)
+	}
+}
+
+func TestOpenNotRepository(t *testing.T) {
+	if _, err := Open(t.TempDir()); err == nil {
+		t.Error("expected error for directory outside a repository")
+	}
+}
+
+func TestApplyDelta(t *testing.T) {
+	base := []byte("hello world")
+	// base size 11, result size 11, copy 6 bytes from 0, insert "there"
+	delta := []byte{11, 11, 0x90, 6, 5, 't', 'h', 'e', 'r', 'e'}
+	got, err := applyDelta(base, delta)
+	if err != nil || string(got) != "hello there" {
+		t.Errorf("expected hello there got %q %v", got, err)
+	}
+
+	if _, err := applyDelta(base, []byte{10, 11}); err == nil {
+		t.Error("expected error for wrong base size")
+	}
+}
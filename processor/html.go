@@ -0,0 +1,622 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"fmt"
+	"html/template"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	glanguage "golang.org/x/text/language"
+	gmessage "golang.org/x/text/message"
+)
+
+// htmlColors are given to languages in order of how much code they have with anything past the end
+// sharing htmlOtherColor
+var htmlColors = []string{"#3572a5", "#00add8", "#e34c26", "#b07219", "#f1e05a", "#178600", "#563d7c", "#dea584", "#89e051", "#701516", "#4f5d95", "#f34b7d"}
+
+const htmlOtherColor = "#8b949e"
+
+// The treemap is drawn into a fixed view box which the browser scales to the page width
+const (
+	treemapWidth    = 1000.0
+	treemapHeight   = 600.0
+	treemapMaxRects = 5000 // Stops very large trees producing a report too slow to open
+)
+
+// htmlChartSlices is how many languages get their own slice before the rest are grouped as Other
+const htmlChartSlices = 8
+
+// htmlChartRadius is the radius of the donut circles in the template which the slices are measured along
+const htmlChartRadius = 60.0
+
+type htmlReport struct {
+	Version    string
+	Timestamp  string
+	Paths      string
+	Complexity bool
+	Total      LanguageSummary
+	Languages  []htmlLanguage
+	Charts     []htmlChart
+	Treemap    []treemapRect
+	Estimates  []string
+
+	// Directories replaces the languages in the table when --by-dir is set
+	Directories []DirectorySummary
+}
+
+type htmlLanguage struct {
+	LanguageSummary
+	Color string
+}
+
+type htmlChart struct {
+	Title  string
+	Total  int64
+	Slices []htmlSlice
+}
+
+type htmlSlice struct {
+	Label   string
+	Value   int64
+	Color   string
+	Percent string
+	Dash    string // stroke-dasharray drawing the slice as part of the circle
+	Offset  string // stroke-dashoffset moving the slice past the ones before it
+}
+
+type treemapRect struct {
+	X, Y, W, H string
+	Fill       string
+	Title      string
+	Label      string
+	LabelX     string
+	LabelY     string
+	Directory  bool
+}
+
+// treemapNode is a directory, or a file when it has no children, with the code of everything under it
+type treemapNode struct {
+	name     string
+	path     string
+	language string
+	code     int64
+	children map[string]*treemapNode
+}
+
+type treemapBox struct {
+	x, y, w, h float64
+}
+
+// toHtml is a single self-contained page with the totals, charts of each language's share, a treemap of
+// the directories sized by code and a table of every language and file which can be sorted and expanded.
+// Everything is inline so the file works offline, use html-table to embed the table in another page.
+func toHtml(input chan *FileJob) string {
+	return htmlSummarize(input, false)
+}
+
+// toDirectoryHtml is the html report with the table listing each directory and the languages in it
+func toDirectoryHtml(input chan *FileJob) string {
+	return htmlSummarize(input, true)
+}
+
+func htmlSummarize(input chan *FileJob, byDirectory bool) string {
+	startTime := makeTimestampMilli()
+	aggregate := newLanguageAggregator(true, false).consume(input)
+	language := sortLanguageSummary(aggregate.summaries())
+
+	colors := htmlLanguageColors(language)
+
+	report := htmlReport{
+		Version:    Version,
+		Timestamp:  time.Now().UTC().Format(time.RFC3339),
+		Paths:      strings.Join(DirFilePaths, ", "),
+		Complexity: !Complexity,
+		Total:      aggregate.total,
+	}
+
+	var files []*FileJob
+	for _, summary := range language {
+		sortSummaryFiles(&summary)
+		files = append(files, summary.Files...)
+		report.Languages = append(report.Languages, htmlLanguage{LanguageSummary: summary, Color: colors[summary.Name]})
+	}
+
+	report.Charts = []htmlChart{
+		htmlLanguageChart("Code", language, colors, func(l LanguageSummary) int64 { return l.Code }),
+		htmlLanguageChart("Files", language, colors, func(l LanguageSummary) int64 { return l.Count }),
+	}
+
+	if byDirectory {
+		directories := newDirectoryAggregator(DirDepth, DirFilePaths)
+		for _, res := range files {
+			directories.add(res)
+		}
+		report.Directories = directories.summaries()
+	}
+
+	layoutTreemap(buildTreemap(files), treemapBox{0, 0, treemapWidth, treemapHeight}, colors, &report.Treemap)
+
+	var estimates strings.Builder
+	if !Cocomo {
+		if SLOCCountFormat {
+			calculateCocomoSLOCCount(aggregate.total.Code, &estimates)
+		} else {
+			calculateCocomo(aggregate.total.Code, &estimates)
+		}
+	}
+	if !Size {
+		calculateSize(aggregate.total.Bytes, &estimates)
+	}
+	for _, line := range strings.Split(strings.TrimRight(estimates.String(), "\n"), "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			report.Estimates = append(report.Estimates, line)
+		}
+	}
+
+	var str strings.Builder
+	if err := htmlTemplate.Execute(&str, report); err != nil {
+		printError(fmt.Sprintf("unable to write html: %v", err))
+	}
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
+	}
+
+	return str.String()
+}
+
+// htmlLanguageColors gives the languages with the most code the distinct colours so the charts and
+// treemap agree on them no matter how the table is sorted
+func htmlLanguageColors(language []LanguageSummary) map[string]string {
+	byCode := sortLanguageSummaryBy(append([]LanguageSummary{}, language...), "code")
+
+	colors := map[string]string{}
+	for i, summary := range byCode {
+		if i < len(htmlColors) {
+			colors[summary.Name] = htmlColors[i]
+		} else {
+			colors[summary.Name] = htmlOtherColor
+		}
+	}
+
+	return colors
+}
+
+// htmlLanguageChart is a donut of each language's share of the value with the smallest grouped as Other
+func htmlLanguageChart(title string, language []LanguageSummary, colors map[string]string, value func(LanguageSummary) int64) htmlChart {
+	sorted := append([]LanguageSummary{}, language...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return value(sorted[i]) > value(sorted[j])
+	})
+
+	chart := htmlChart{Title: title}
+	var other int64
+	for i, summary := range sorted {
+		chart.Total += value(summary)
+		if i >= htmlChartSlices {
+			other += value(summary)
+			continue
+		}
+		if value(summary) != 0 {
+			chart.Slices = append(chart.Slices, htmlSlice{Label: summary.Name, Value: value(summary), Color: colors[summary.Name]})
+		}
+	}
+	if other != 0 {
+		chart.Slices = append(chart.Slices, htmlSlice{Label: "Other", Value: other, Color: htmlOtherColor})
+	}
+
+	circumference := 2 * math.Pi * htmlChartRadius
+	offset := 0.0 // Negative moves each slice clockwise past the ones before it
+	for i := range chart.Slices {
+		share := float64(chart.Slices[i].Value) / float64(chart.Total)
+		length := share * circumference
+
+		chart.Slices[i].Percent = fmt.Sprintf("%.1f%%", share*100)
+		chart.Slices[i].Dash = fmt.Sprintf("%.2f %.2f", length, circumference-length)
+		chart.Slices[i].Offset = fmt.Sprintf("%.2f", offset)
+		offset -= length
+	}
+
+	return chart
+}
+
+// buildTreemap turns the locations of the files into a tree of directories each with the total code
+// of the files under it
+func buildTreemap(files []*FileJob) *treemapNode {
+	root := &treemapNode{children: map[string]*treemapNode{}}
+
+	for _, f := range files {
+		if f.Code == 0 {
+			continue
+		}
+
+		var parts []string
+		for _, part := range strings.Split(filepath.ToSlash(f.Location), "/") {
+			if part != "" && part != "." {
+				parts = append(parts, part)
+			}
+		}
+		if len(parts) == 0 {
+			parts = []string{f.Filename}
+		}
+
+		node := root
+		node.code += f.Code
+		for i, part := range parts {
+			child, ok := node.children[part]
+			if !ok {
+				child = &treemapNode{name: part, path: strings.Join(parts[:i+1], "/")}
+				if i == len(parts)-1 {
+					child.language = f.Language
+				} else {
+					child.children = map[string]*treemapNode{}
+				}
+				node.children[part] = child
+			}
+
+			child.code += f.Code
+			node = child
+			if node.children == nil {
+				break
+			}
+		}
+	}
+
+	return root
+}
+
+// sortedChildren has the largest first which squarify needs to keep the rectangles close to square
+func (n *treemapNode) sortedChildren() []*treemapNode {
+	var children []*treemapNode
+	for _, child := range n.children {
+		children = append(children, child)
+	}
+
+	sort.Slice(children, func(i, j int) bool {
+		if children[i].code == children[j].code {
+			return children[i].name < children[j].name
+		}
+		return children[i].code > children[j].code
+	})
+
+	return children
+}
+
+// layoutTreemap adds a rectangle for every directory and file under the node. Directories are drawn
+// first with a label when there is room and their contents inset inside them.
+func layoutTreemap(node *treemapNode, box treemapBox, colors map[string]string, rects *[]treemapRect) {
+	children := node.sortedChildren()
+	if len(children) == 0 {
+		return
+	}
+
+	values := make([]float64, len(children))
+	for i, child := range children {
+		values[i] = float64(child.code)
+	}
+
+	for i, b := range squarify(values, box) {
+		if len(*rects) >= treemapMaxRects {
+			return
+		}
+
+		child := children[i]
+		rect := treemapRect{
+			X:     fmt.Sprintf("%.2f", b.x),
+			Y:     fmt.Sprintf("%.2f", b.y),
+			W:     fmt.Sprintf("%.2f", b.w),
+			H:     fmt.Sprintf("%.2f", b.h),
+			Title: fmt.Sprintf("%s (%d code)", child.path, child.code),
+		}
+
+		if child.children == nil {
+			rect.Fill = colors[child.language]
+			if rect.Fill == "" {
+				rect.Fill = htmlOtherColor
+			}
+			if b.w > 40 && b.h > 14 {
+				rect.Label = treemapLabel(child.name, b.w)
+				rect.LabelX = fmt.Sprintf("%.2f", b.x+3)
+				rect.LabelY = fmt.Sprintf("%.2f", b.y+12)
+			}
+			*rects = append(*rects, rect)
+			continue
+		}
+
+		rect.Directory = true
+		inner := treemapBox{b.x + 2, b.y + 2, b.w - 4, b.h - 4}
+		if b.w > 40 && b.h > 30 {
+			rect.Label = treemapLabel(child.name+"/", b.w)
+			rect.LabelX = fmt.Sprintf("%.2f", b.x+3)
+			rect.LabelY = fmt.Sprintf("%.2f", b.y+12)
+			inner.y += 14
+			inner.h -= 14
+		}
+		*rects = append(*rects, rect)
+
+		if inner.w > 2 && inner.h > 2 {
+			layoutTreemap(child, inner, colors, rects)
+		}
+	}
+}
+
+// treemapLabel trims the name to roughly what fits in the width at the font size used
+func treemapLabel(name string, width float64) string {
+	fits := int((width - 6) / 6.5)
+	if len([]rune(name)) <= fits {
+		return name
+	}
+	if fits < 2 {
+		return ""
+	}
+
+	return string([]rune(name)[:fits-1]) + "…"
+}
+
+// squarify lays out the values, which must be sorted largest first, as rectangles filling the box with
+// areas in proportion to them. Each row is filled while adding to it keeps the rectangles closer to square.
+// See Bruls, Huizing and van Wijk "Squarified Treemaps".
+func squarify(values []float64, box treemapBox) []treemapBox {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+
+	boxes := make([]treemapBox, 0, len(values))
+	if total <= 0 {
+		for range values {
+			boxes = append(boxes, treemapBox{box.x, box.y, 0, 0})
+		}
+		return boxes
+	}
+
+	scale := box.w * box.h / total
+	areas := make([]float64, len(values))
+	for i, v := range values {
+		areas[i] = v * scale
+	}
+
+	remaining := box
+	for i := 0; i < len(areas); {
+		side := math.Min(remaining.w, remaining.h)
+
+		j := i + 1
+		for j < len(areas) && squarifyWorst(areas[i:j+1], side) <= squarifyWorst(areas[i:j], side) {
+			j++
+		}
+
+		var sum float64
+		for _, a := range areas[i:j] {
+			sum += a
+		}
+
+		if remaining.w >= remaining.h {
+			// Column down the left side
+			w := sum / remaining.h
+			y := remaining.y
+			for _, a := range areas[i:j] {
+				h := a / w
+				boxes = append(boxes, treemapBox{remaining.x, y, w, h})
+				y += h
+			}
+			remaining.x += w
+			remaining.w -= w
+		} else {
+			// Row across the top
+			h := sum / remaining.w
+			x := remaining.x
+			for _, a := range areas[i:j] {
+				w := a / h
+				boxes = append(boxes, treemapBox{x, remaining.y, w, h})
+				x += w
+			}
+			remaining.y += h
+			remaining.h -= h
+		}
+
+		i = j
+	}
+
+	return boxes
+}
+
+// squarifyWorst is the worst aspect ratio of the row if laid along the side
+func squarifyWorst(row []float64, side float64) float64 {
+	var sum float64
+	largest := 0.0
+	smallest := math.MaxFloat64
+	for _, a := range row {
+		sum += a
+		largest = math.Max(largest, a)
+		smallest = math.Min(smallest, a)
+	}
+
+	return math.Max(side*side*largest/(sum*sum), sum*sum/(side*side*smallest))
+}
+
+var htmlTemplate = template.Must(template.New("html").Funcs(template.FuncMap{
+	"number": func(i int64) string {
+		return gmessage.NewPrinter(glanguage.Make(os.Getenv("LANG"))).Sprintf("%d", i)
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>scc report</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #24292f; }
+h1 { font-size: 1.5rem; margin-bottom: 0.25rem; }
+h2 { font-size: 1.15rem; margin-top: 2rem; }
+.meta { color: #57606a; font-size: 0.85rem; }
+.cards { display: flex; flex-wrap: wrap; gap: 1rem; }
+.card { border: 1px solid #d0d7de; border-radius: 6px; padding: 0.75rem 1rem; min-width: 8rem; }
+.card .value { font-size: 1.3rem; font-weight: 600; }
+.card .label { color: #57606a; font-size: 0.8rem; }
+.estimates { color: #57606a; }
+.charts { display: flex; flex-wrap: wrap; gap: 2rem; }
+.charts figure { margin: 0; display: flex; gap: 1rem; align-items: center; }
+.charts figcaption { font-weight: 600; }
+.legend { list-style: none; padding: 0; margin: 0.5rem 0 0; font-size: 0.85rem; }
+.treemap { width: 100%; height: auto; border: 1px solid #d0d7de; }
+.treemap text { font-size: 11px; fill: #fff; pointer-events: none; }
+.treemap .directory { fill: #d0d7de; stroke: #8c959f; }
+.treemap .directory + text { fill: #24292f; }
+.treemap .file { stroke: #fff; stroke-width: 0.5; }
+table { border-collapse: collapse; width: 100%; font-size: 0.9rem; }
+th, td { padding: 0.35rem 0.6rem; border-bottom: 1px solid #d0d7de; text-align: right; }
+th:first-child, td:first-child { text-align: left; }
+thead th { cursor: pointer; user-select: none; background: #f6f8fa; position: sticky; top: 0; }
+thead th.asc::after { content: " \25b2"; }
+thead th.desc::after { content: " \25bc"; }
+tr.language { cursor: pointer; font-weight: 600; }
+tr.language td:first-child::before { content: "\25b8  "; }
+tbody.open tr.language td:first-child::before { content: "\25be  "; }
+tr.file { display: none; color: #57606a; }
+tbody.open tr.file { display: table-row; }
+tr.file td:first-child { padding-left: 1.8rem; word-break: break-all; }
+tfoot td { font-weight: 600; }
+</style>
+</head>
+<body>
+<h1>scc report</h1>
+<p class="meta">{{.Paths}} &middot; scc {{.Version}} &middot; {{.Timestamp}}</p>
+
+<h2>Summary</h2>
+<div class="cards">
+<div class="card"><div class="value">{{number .Total.Count}}</div><div class="label">Files</div></div>
+<div class="card"><div class="value">{{number .Total.Lines}}</div><div class="label">Lines</div></div>
+<div class="card"><div class="value">{{number .Total.Code}}</div><div class="label">Code</div></div>
+<div class="card"><div class="value">{{number .Total.Comment}}</div><div class="label">Comments</div></div>
+<div class="card"><div class="value">{{number .Total.Blank}}</div><div class="label">Blanks</div></div>
+{{- if .Complexity}}
+<div class="card"><div class="value">{{number .Total.Complexity}}</div><div class="label">Complexity</div></div>
+{{- end}}
+<div class="card"><div class="value">{{number .Total.Bytes}}</div><div class="label">Bytes</div></div>
+</div>
+{{- if .Estimates}}
+<ul class="estimates" id="scc-estimates">
+{{- range .Estimates}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+
+<h2>Languages</h2>
+<div class="charts">
+{{- range .Charts}}
+<figure>
+<svg width="160" height="160" viewBox="0 0 160 160" role="img" aria-label="Share of {{.Title}} by language">
+<circle cx="80" cy="80" r="60" fill="none" stroke="#eaeef2" stroke-width="24"/>
+{{- range .Slices}}
+<circle cx="80" cy="80" r="60" fill="none" stroke="{{.Color}}" stroke-width="24" stroke-dasharray="{{.Dash}}" stroke-dashoffset="{{.Offset}}" transform="rotate(-90 80 80)"><title>{{.Label}} {{.Percent}}</title></circle>
+{{- end}}
+<text x="80" y="85" text-anchor="middle" font-size="14">{{number .Total}}</text>
+</svg>
+<div>
+<figcaption>{{.Title}}</figcaption>
+<ul class="legend">
+{{- range .Slices}}
+<li><svg width="10" height="10"><rect width="10" height="10" fill="{{.Color}}"/></svg> {{.Label}} {{.Percent}}</li>
+{{- end}}
+</ul>
+</div>
+</figure>
+{{- end}}
+</div>
+
+<h2>Code by Directory</h2>
+<svg class="treemap" id="scc-treemap" viewBox="0 0 1000 600" role="img" aria-label="Treemap of code by directory">
+{{- range .Treemap}}
+{{- if .Directory}}
+<rect class="directory" x="{{.X}}" y="{{.Y}}" width="{{.W}}" height="{{.H}}"><title>{{.Title}}</title></rect>
+{{- else}}
+<rect class="file" x="{{.X}}" y="{{.Y}}" width="{{.W}}" height="{{.H}}" fill="{{.Fill}}"><title>{{.Title}}</title></rect>
+{{- end}}
+{{- if .Label}}<text x="{{.LabelX}}" y="{{.LabelY}}">{{.Label}}</text>{{end}}
+{{- end}}
+</svg>
+
+{{- if .Directories}}
+<h2>Directories</h2>
+{{- else}}
+<h2>Files</h2>
+{{- end}}
+<p><button type="button" id="scc-expand">Expand all</button> <button type="button" id="scc-collapse">Collapse all</button></p>
+<table id="scc-report">
+<thead><tr><th>{{if .Directories}}Directory{{else}}Language{{end}}</th><th>Files</th><th>Lines</th><th>Blanks</th><th>Comments</th><th>Code</th>{{if .Complexity}}<th>Complexity</th>{{end}}<th>Bytes</th></tr></thead>
+{{- range .Directories}}
+<tbody>
+<tr class="language"><td>{{.Name}}</td><td>{{.Count}}</td><td>{{.Lines}}</td><td>{{.Blank}}</td><td>{{.Comment}}</td><td>{{.Code}}</td>{{if $.Complexity}}<td>{{.Complexity}}</td>{{end}}<td>{{.Bytes}}</td></tr>
+{{- range .Languages}}
+<tr class="file"><td>{{.Name}}</td><td>{{.Count}}</td><td>{{.Lines}}</td><td>{{.Blank}}</td><td>{{.Comment}}</td><td>{{.Code}}</td>{{if $.Complexity}}<td>{{.Complexity}}</td>{{end}}<td>{{.Bytes}}</td></tr>
+{{- end}}
+</tbody>
+{{- else}}
+{{- range .Languages}}
+<tbody>
+<tr class="language"><td>{{.Name}}</td><td>{{.Count}}</td><td>{{.Lines}}</td><td>{{.Blank}}</td><td>{{.Comment}}</td><td>{{.Code}}</td>{{if $.Complexity}}<td>{{.Complexity}}</td>{{end}}<td>{{.Bytes}}</td></tr>
+{{- range .Files}}
+<tr class="file"><td>{{.Location}}</td><td></td><td>{{.Lines}}</td><td>{{.Blank}}</td><td>{{.Comment}}</td><td>{{.Code}}</td>{{if $.Complexity}}<td>{{.Complexity}}</td>{{end}}<td>{{.Bytes}}</td></tr>
+{{- end}}
+</tbody>
+{{- end}}
+{{- end}}
+<tfoot><tr><td>Total</td><td>{{.Total.Count}}</td><td>{{.Total.Lines}}</td><td>{{.Total.Blank}}</td><td>{{.Total.Comment}}</td><td>{{.Total.Code}}</td>{{if .Complexity}}<td>{{.Total.Complexity}}</td>{{end}}<td>{{.Total.Bytes}}</td></tr></tfoot>
+</table>
+
+<script>
+(function () {
+  var table = document.getElementById("scc-report");
+  var headers = table.tHead.rows[0].cells;
+
+  function value(row, i) {
+    var text = row.cells[i].textContent;
+    return i === 0 ? text.toLowerCase() : Number(text) || 0;
+  }
+
+  function compare(i, direction) {
+    return function (a, b) {
+      var x = value(a, i), y = value(b, i);
+      return (x < y ? -1 : x > y ? 1 : 0) * direction;
+    };
+  }
+
+  // Languages are sorted by their totals and the files under each by their own counts
+  Array.prototype.forEach.call(headers, function (th, i) {
+    th.addEventListener("click", function () {
+      var direction = th.classList.contains("desc") || (i === 0 && !th.classList.contains("asc")) ? 1 : -1;
+      Array.prototype.forEach.call(headers, function (h) { h.classList.remove("asc", "desc"); });
+      th.classList.add(direction === 1 ? "asc" : "desc");
+
+      var bodies = Array.prototype.slice.call(table.tBodies);
+      bodies.sort(function (a, b) { return compare(i, direction)(a.rows[0], b.rows[0]); });
+      bodies.forEach(function (body) {
+        var files = Array.prototype.slice.call(body.rows, 1);
+        files.sort(compare(i, direction));
+        files.forEach(function (row) { body.appendChild(row); });
+        table.insertBefore(body, table.tFoot);
+      });
+    });
+  });
+
+  Array.prototype.forEach.call(table.tBodies, function (body) {
+    body.rows[0].addEventListener("click", function () { body.classList.toggle("open"); });
+  });
+
+  function toggleAll(open) {
+    Array.prototype.forEach.call(table.tBodies, function (body) { body.classList.toggle("open", open); });
+  }
+  document.getElementById("scc-expand").addEventListener("click", function () { toggleAll(true); });
+  document.getElementById("scc-collapse").addEventListener("click", function () { toggleAll(false); });
+})();
+</script>
+</body>
+</html>
+`))
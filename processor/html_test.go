@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func htmlInput() chan *FileJob {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "cmd/main.go", Filename: "main.go", Bytes: 1000, Lines: 10, Code: 8, Comment: 1, Blank: 1, Complexity: 2}
+	inputChan <- &FileJob{Language: "Go", Location: "cmd/util/util.go", Filename: "util.go", Bytes: 500, Lines: 5, Code: 4, Blank: 1}
+	inputChan <- &FileJob{Language: "HTML", Location: "web/<script>.html", Filename: "<script>.html", Bytes: 100, Lines: 3, Code: 3}
+	close(inputChan)
+	return inputChan
+}
+
+func TestToHtmlReport(t *testing.T) {
+	resetMarkdown()
+	defer resetMarkdown()
+
+	res := toHtml(htmlInput())
+
+	for _, s := range []string{
+		`<table id="scc-report">`,
+		`<svg class="treemap" id="scc-treemap"`,
+		`<ul class="estimates" id="scc-estimates">`,
+		`<li>Estimated Cost to Develop (organic) `,
+		`<tr class="language"><td>Go</td><td>2</td><td>15</td><td>2</td><td>1</td><td>12</td><td>2</td><td>1500</td></tr>`,
+		`<tr class="file"><td>cmd/main.go</td><td></td><td>10</td>`,
+		`<title>cmd/util (4 code)</title>`,
+		`<tfoot><tr><td>Total</td><td>3</td><td>18</td>`,
+		`aria-label="Share of Code by language"`,
+	} {
+		if !strings.Contains(res, s) {
+			t.Errorf("expected %q in %s", s, res)
+		}
+	}
+
+	// Files are always listed so they can be expanded even without --by-file
+	if strings.Contains(res, "<script>.html") || !strings.Contains(res, "web/&lt;script&gt;.html") {
+		t.Error("expected file names to be escaped")
+	}
+}
+
+func TestToHtmlSelfContained(t *testing.T) {
+	resetMarkdown()
+	defer resetMarkdown()
+
+	res := toHtml(htmlInput())
+
+	if regexp.MustCompile(`(?i)(src|href)\s*=|https?://|@import|url\(`).MatchString(res) {
+		t.Errorf("expected no external resources in %s", res)
+	}
+}
+
+func TestToHtmlNoComplexityOrEstimates(t *testing.T) {
+	resetMarkdown()
+	defer resetMarkdown()
+	Complexity = true
+	Cocomo = true
+	Size = true
+
+	res := toHtml(htmlInput())
+
+	if strings.Contains(res, "<th>Complexity</th>") || strings.Contains(res, `id="scc-estimates"`) {
+		t.Errorf("expected no complexity or estimates got %s", res)
+	}
+}
+
+func TestToHtmlEmpty(t *testing.T) {
+	resetMarkdown()
+	defer resetMarkdown()
+
+	inputChan := make(chan *FileJob)
+	close(inputChan)
+
+	res := toHtml(inputChan)
+	if !strings.Contains(res, `<tfoot><tr><td>Total</td><td>0</td>`) || strings.Contains(res, `<rect class=`) {
+		t.Errorf("expected empty report got %s", res)
+	}
+}
+
+func TestHtmlLanguageChart(t *testing.T) {
+	var language []LanguageSummary
+	for i := 0; i < htmlChartSlices+2; i++ {
+		language = append(language, LanguageSummary{Name: string(rune('a' + i)), Code: int64(10 - i)})
+	}
+	colors := htmlLanguageColors(language)
+
+	chart := htmlLanguageChart("Code", language, colors, func(l LanguageSummary) int64 { return l.Code })
+
+	if len(chart.Slices) != htmlChartSlices+1 || chart.Slices[htmlChartSlices].Label != "Other" || chart.Slices[htmlChartSlices].Value != 3 {
+		t.Errorf("expected the smallest languages grouped as Other got %v", chart.Slices)
+	}
+	if chart.Total != 55 || chart.Slices[0].Label != "a" || chart.Slices[0].Percent != "18.2%" || chart.Slices[0].Offset != "0.00" {
+		t.Errorf("unexpected chart %v", chart)
+	}
+	if colors["a"] != htmlColors[0] {
+		t.Errorf("expected the most code to get the first colour got %s", colors["a"])
+	}
+}
+
+func TestSquarify(t *testing.T) {
+	box := treemapBox{10, 20, 300, 200}
+	values := []float64{6, 6, 4, 3, 2, 2, 1}
+
+	boxes := squarify(values, box)
+	if len(boxes) != len(values) {
+		t.Fatalf("expected %d boxes got %d", len(values), len(boxes))
+	}
+
+	var area float64
+	for i, b := range boxes {
+		expected := values[i] / 24 * box.w * box.h
+		if math.Abs(b.w*b.h-expected) > 0.001 {
+			t.Errorf("expected box %d to have area %f got %f", i, expected, b.w*b.h)
+		}
+		if b.x < box.x-0.001 || b.y < box.y-0.001 || b.x+b.w > box.x+box.w+0.001 || b.y+b.h > box.y+box.h+0.001 {
+			t.Errorf("expected box %d inside %v got %v", i, box, b)
+		}
+		area += b.w * b.h
+	}
+	if math.Abs(area-box.w*box.h) > 0.001 {
+		t.Errorf("expected the boxes to fill the area got %f", area)
+	}
+}
+
+func TestBuildTreemap(t *testing.T) {
+	root := buildTreemap([]*FileJob{
+		{Location: "./a/b.go", Language: "Go", Code: 3},
+		{Location: "a/c/d.go", Language: "Go", Code: 2},
+		{Location: "e.py", Language: "Python", Code: 1},
+		{Location: "f.txt", Language: "Text", Code: 0},
+	})
+
+	if root.code != 6 || len(root.children) != 2 {
+		t.Fatalf("unexpected root %v", root)
+	}
+
+	a := root.children["a"]
+	if a.code != 5 || a.children["c"].children["d.go"].path != "a/c/d.go" || a.children["b.go"].language != "Go" {
+		t.Errorf("unexpected directory %v", a)
+	}
+
+	children := root.sortedChildren()
+	if children[0].name != "a" || children[1].name != "e.py" || children[1].children != nil {
+		t.Errorf("expected largest first got %v", children)
+	}
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// LanguagesFileEnv is the environment variable used for --languages-file when the flag is not set
+const LanguagesFileEnv = "SCC_LANGUAGES_FILE"
+
+// loadDatabaseWithFile loads the built in language database merging in the languages from
+// the supplied file which add to or replace those built in
+func loadDatabaseWithFile(path string) (map[string]Language, error) {
+	database := loadDatabase()
+	if path == "" {
+		return database, nil
+	}
+
+	languages, err := loadLanguagesFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, language := range languages {
+		if Verbose {
+			if _, ok := database[name]; ok {
+				printWarn(fmt.Sprintf("overriding language %s from %s", name, path))
+			}
+		}
+		database[name] = language
+	}
+
+	return database, nil
+}
+
+// loadLanguagesFile reads and validates a file using the same schema as languages.json
+func loadLanguagesFile(path string) (map[string]Language, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read languages file %s: %v", path, err)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("languages file %s is not a JSON object of languages: %v", path, err)
+	}
+
+	// Sorted so the first error reported is always the same one
+	var names []string
+	for name := range raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	languages := map[string]Language{}
+	for _, name := range names {
+		language, err := decodeLanguage(name, raw[name])
+		if err == nil {
+			err = validateLanguage(name, language)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("languages file %s: %v", path, err)
+		}
+
+		languages[name] = language
+	}
+
+	return languages, nil
+}
+
+func decodeLanguage(name string, raw json.RawMessage) (Language, error) {
+	var language Language
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&language); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			return language, fmt.Errorf("language %q field %q: expected %s got %s", name, typeError.Field, typeError.Type, typeError.Value)
+		}
+
+		// The standard library has no type for this so pull the field out of the message
+		if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			return language, fmt.Errorf("language %q field %s: unknown field", name, field)
+		}
+
+		return language, fmt.Errorf("language %q: %v", name, err)
+	}
+
+	return language, nil
+}
+
+func validateLanguage(name string, language Language) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("language name must not be empty")
+	}
+
+	fieldError := func(field string, message string) error {
+		return fmt.Errorf("language %q field %q: %s", name, field, message)
+	}
+
+	if len(language.Extensions) == 0 && len(language.FileNames) == 0 && len(language.SheBangs) == 0 {
+		return fieldError("extensions", "at least one of extensions, filenames or shebangs is required")
+	}
+
+	for i, extension := range language.Extensions {
+		if extension == "" {
+			return fieldError(fmt.Sprintf("extensions[%d]", i), "must not be empty")
+		}
+		if strings.HasPrefix(extension, ".") {
+			return fieldError(fmt.Sprintf("extensions[%d]", i), fmt.Sprintf("%q should not start with a .", extension))
+		}
+		if extension != strings.ToLower(extension) {
+			return fieldError(fmt.Sprintf("extensions[%d]", i), fmt.Sprintf("%q must be lower case", extension))
+		}
+	}
+
+	for i, filename := range language.FileNames {
+		if filename == "" {
+			return fieldError(fmt.Sprintf("filenames[%d]", i), "must not be empty")
+		}
+		if filename != strings.ToLower(filename) {
+			return fieldError(fmt.Sprintf("filenames[%d]", i), fmt.Sprintf("%q must be lower case", filename))
+		}
+	}
+
+	for i, shebang := range language.SheBangs {
+		if shebang == "" {
+			return fieldError(fmt.Sprintf("shebangs[%d]", i), "must not be empty")
+		}
+	}
+
+	for i, comment := range language.LineComment {
+		if comment == "" {
+			return fieldError(fmt.Sprintf("line_comment[%d]", i), "must not be empty")
+		}
+	}
+
+	for i, check := range language.ComplexityChecks {
+		if check == "" {
+			return fieldError(fmt.Sprintf("complexitychecks[%d]", i), "must not be empty")
+		}
+	}
+
+	for i, multiLine := range language.MultiLine {
+		if len(multiLine) != 2 || multiLine[0] == "" || multiLine[1] == "" {
+			return fieldError(fmt.Sprintf("multi_line[%d]", i), "must be a pair of non empty start and end strings")
+		}
+	}
+
+	for i, quote := range language.Quotes {
+		if quote.Start == "" || quote.End == "" {
+			return fieldError(fmt.Sprintf("quotes[%d]", i), "start and end must not be empty")
+		}
+	}
+
+	for i, opener := range language.FunctionOpeners {
+		if strings.TrimSpace(opener) == "" {
+			return fieldError(fmt.Sprintf("function_openers[%d]", i), "must not be empty")
+		}
+	}
+
+	if language.BlockStyle != "" && language.BlockStyle != BlockStyleBraces && language.BlockStyle != BlockStyleIndent {
+		return fieldError("block_style", fmt.Sprintf("%q must be %s or %s", language.BlockStyle, BlockStyleBraces, BlockStyleIndent))
+	}
+	if len(language.FunctionOpeners) != 0 && language.BlockStyle == "" {
+		return fieldError("block_style", "is required when function_openers is set")
+	}
+
+	for i, rule := range language.Generated {
+		field := fmt.Sprintf("generated[%d]", i)
+		if rule.Name == "" {
+			return fieldError(field+".name", "must not be empty")
+		}
+		if rule.Filename == "" && rule.Marker == "" && rule.Content == "" {
+			return fieldError(field, "must set at least one of filename, marker or content")
+		}
+		if rule.Before != "" && rule.Marker == "" {
+			return fieldError(field+".before", "is only used with marker")
+		}
+
+		for _, pattern := range [][2]string{{"filename", rule.Filename}, {"marker", rule.Marker}, {"before", rule.Before}, {"content", rule.Content}} {
+			if _, err := regexp.Compile(pattern[1]); err != nil {
+				return fieldError(field+"."+pattern[0], fmt.Sprintf("is not a valid regular expression: %v", err))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeLanguagesFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "languages.json")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestLoadDatabaseWithFile(t *testing.T) {
+	path := writeLanguagesFile(t, `{
+		"Widget DSL": {"extensions": ["wdsl"], "line_comment": ["#"], "complexitychecks": ["when "]},
+		"Go": {"extensions": ["go"], "line_comment": ["--"]}
+	}`)
+
+	database, err := loadDatabaseWithFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if database["Widget DSL"].Extensions[0] != "wdsl" {
+		t.Error("expected Widget DSL to be added")
+	}
+
+	if database["Go"].LineComment[0] != "--" || len(database["Go"].Quotes) != 0 {
+		t.Error("expected Go to be replaced")
+	}
+
+	if _, ok := database["Python"]; !ok {
+		t.Error("expected built in languages to remain")
+	}
+}
+
+func TestLoadDatabaseWithFileEmpty(t *testing.T) {
+	database, err := loadDatabaseWithFile("")
+	if err != nil || len(database) != len(loadDatabase()) {
+		t.Errorf("expected built in database got %d languages %v", len(database), err)
+	}
+}
+
+func TestLoadLanguagesFileErrors(t *testing.T) {
+	var cases = []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"missing identification", `{"Widget": {"line_comment": ["#"]}}`, `language "Widget" field "extensions": at least one of`},
+		{"unknown field", `{"Widget": {"extensions": ["w"], "line_coment": ["#"]}}`, `language "Widget" field "line_coment": unknown field`},
+		{"wrong type", `{"Widget": {"extensions": "w"}}`, `language "Widget" field "extensions": expected []string got string`},
+		{"dot extension", `{"Widget": {"extensions": [".w"]}}`, `language "Widget" field "extensions[0]": ".w" should not start with a .`},
+		{"upper case filename", `{"Widget": {"filenames": ["Widgetfile"]}}`, `language "Widget" field "filenames[0]"`},
+		{"bad multi line", `{"Widget": {"extensions": ["w"], "multi_line": [["/*"]]}}`, `language "Widget" field "multi_line[0]"`},
+		{"bad quote", `{"Widget": {"extensions": ["w"], "quotes": [{"start": "\""}]}}`, `language "Widget" field "quotes[0]"`},
+		{"empty complexity", `{"Widget": {"extensions": ["w"], "complexitychecks": [""]}}`, `language "Widget" field "complexitychecks[0]"`},
+		{"unnamed generated rule", `{"Widget": {"extensions": ["w"], "generated": [{"filename": "x"}]}}`, `language "Widget" field "generated[0].name"`},
+		{"empty generated rule", `{"Widget": {"extensions": ["w"], "generated": [{"name": "x"}]}}`, `language "Widget" field "generated[0]": must set at least one`},
+		{"before without marker", `{"Widget": {"extensions": ["w"], "generated": [{"name": "x", "filename": "x", "before": "x"}]}}`, `language "Widget" field "generated[0].before"`},
+		{"bad generated regex", `{"Widget": {"extensions": ["w"], "generated": [{"name": "x", "marker": "("}]}}`, `language "Widget" field "generated[0].marker": is not a valid regular expression`},
+		{"not an object", `["Widget"]`, `is not a JSON object of languages`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := loadLanguagesFile(writeLanguagesFile(t, c.content))
+			if err == nil || !strings.Contains(err.Error(), c.want) {
+				t.Errorf("expected error containing %q got %v", c.want, err)
+			}
+		})
+	}
+}
+
+func TestLoadLanguagesFileMissing(t *testing.T) {
+	_, err := loadLanguagesFile(filepath.Join(t.TempDir(), "missing.json"))
+	if err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestCounterLanguagesFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "main.wdsl"), []byte("# comment\nwhen x\n\nelse y\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := NewConfig()
+	config.LanguagesFile = writeLanguagesFile(t, `{"Widget DSL": {"extensions": ["wdsl"], "line_comment": ["#"], "complexitychecks": ["when ", "else "]}}`)
+
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{dir})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(summary) != 1 || summary[0].Name != "Widget DSL" {
+		t.Fatalf("expected Widget DSL got %+v", summary)
+	}
+
+	if summary[0].Code != 2 || summary[0].Comment != 1 || summary[0].Blank != 1 || summary[0].Complexity != 2 {
+		t.Errorf("unexpected counts %+v", summary[0])
+	}
+}
+
+func TestNewCounterInvalidLanguagesFile(t *testing.T) {
+	config := NewConfig()
+	config.LanguagesFile = writeLanguagesFile(t, `{"Widget": {}}`)
+
+	if _, err := NewCounter(config); err == nil {
+		t.Error("expected error for invalid languages file")
+	}
+}
+
+func TestProcessConstantsInvalidLanguagesFile(t *testing.T) {
+	LanguagesFile = writeLanguagesFile(t, `{"Widget": {}}`)
+	defer func() {
+		LanguagesFile = ""
+		ProcessConstants()
+	}()
+
+	if err := processConstants(LanguagesFile); err == nil {
+		t.Error("expected error for invalid languages file")
+	}
+
+	// Embedding the library must not exit so the built in languages are used instead
+	ProcessConstants()
+	if _, ok := languageDatabase["Go"]; !ok {
+		t.Error("expected the built in languages to be loaded")
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"fmt"
+	"strings"
+)
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"|", `\|`,
+	"*", `\*`,
+	"_", `\_`,
+	"`", "\\`",
+	"<", `\<`,
+	">", `\>`,
+	"[", `\[`,
+	"]", `\]`,
+)
+
+// markdownEscape stops text such as file paths breaking out of a markdown table cell or being formatted
+func markdownEscape(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// markdownRow writes the cells as a row of a GitHub flavoured markdown table
+func markdownRow(str *strings.Builder, cells ...string) {
+	str.WriteString("| ")
+	str.WriteString(strings.Join(cells, " | "))
+	str.WriteString(" |\n")
+}
+
+// markdownHeader writes the header of a table where the first text columns are left aligned and the
+// rest are numbers so right aligned
+func markdownHeader(str *strings.Builder, text int, columns ...string) {
+	markdownRow(str, columns...)
+
+	var align []string
+	for i := range columns {
+		if i < text {
+			align = append(align, ":---")
+		} else {
+			align = append(align, "---:")
+		}
+	}
+	markdownRow(str, align...)
+}
+
+// toMarkdown produces the same information as tabular, or wide when --wide is set, as GitHub flavoured
+// markdown tables suitable for pasting into pull requests and wikis
+func toMarkdown(input chan *FileJob) string {
+	var str strings.Builder
+
+	columns := []string{"Language", "Files", "Lines", "Blanks", "Comments", "Code"}
+	if !Complexity {
+		columns = append(columns, "Complexity")
+	}
+	if More {
+		columns = append(columns, "Cognitive", "Complexity/Lines")
+	}
+
+	row := func(name string, files string, res LanguageSummary) {
+		cells := []string{name, files, fmt.Sprint(res.Lines), fmt.Sprint(res.Blank), fmt.Sprint(res.Comment), fmt.Sprint(res.Code)}
+		if !Complexity {
+			cells = append(cells, fmt.Sprint(res.Complexity))
+		}
+		if More {
+			cells = append(cells, fmt.Sprint(res.CognitiveComplexity), fmt.Sprintf("%.2f", res.WeightedComplexity))
+		}
+		markdownRow(&str, cells...)
+	}
+
+	aggregate := newLanguageAggregator(Files, ByFunction).consume(input)
+	language := sortLanguageSummary(aggregate.summaries())
+
+	markdownHeader(&str, 1, columns...)
+	for _, summary := range language {
+		name := markdownEscape(summary.Name)
+		if Files {
+			// Languages stand out from the files listed under them
+			name = "**" + name + "**"
+		}
+		row(name, fmt.Sprint(summary.Count), summary)
+
+		if Files {
+			sortSummaryFiles(&summary)
+			for _, res := range summary.Files {
+				file := LanguageSummary{}
+				addToSummary(&file, res)
+				row(markdownEscape(res.Location), "", file)
+			}
+		}
+	}
+	row("**Total**", fmt.Sprint(aggregate.total.Count), aggregate.total)
+
+	if ByFunction {
+		str.WriteString("\n")
+
+		columns := []string{"Function", "Location", "Start", "Lines", "Code", "Complexity"}
+		if More {
+			columns = append(columns, "Cognitive")
+		}
+		markdownHeader(&str, 2, columns...)
+
+		for _, f := range sortFunctions(aggregate.functions) {
+			cells := []string{markdownEscape(f.Name), markdownEscape(f.Location), fmt.Sprint(f.StartLine), fmt.Sprint(f.Lines), fmt.Sprint(f.Code), fmt.Sprint(f.Complexity)}
+			if More {
+				cells = append(cells, fmt.Sprint(f.CognitiveComplexity))
+			}
+			markdownRow(&str, cells...)
+		}
+	}
+
+	markdownEstimates(&str, aggregate.total)
+
+	return strings.TrimRight(str.String(), "\n")
+}
+
+// markdownEstimates writes the same COCOMO and size text tabular shows as a list so each stays on its own line
+func markdownEstimates(str *strings.Builder, total LanguageSummary) {
+	var estimates strings.Builder
+	if !Cocomo {
+		if SLOCCountFormat {
+			calculateCocomoSLOCCount(total.Code, &estimates)
+		} else {
+			calculateCocomo(total.Code, &estimates)
+		}
+	}
+	if !Size {
+		calculateSize(total.Bytes, &estimates)
+	}
+
+	if estimates.Len() != 0 {
+		str.WriteString("\n")
+		for _, line := range strings.Split(strings.TrimRight(estimates.String(), "\n"), "\n") {
+			str.WriteString("- " + markdownEscape(strings.Join(strings.Fields(line), " ")) + "\n")
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func markdownInput() chan *FileJob {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "a|b/main.go", Filename: "main.go", Bytes: 1000, Lines: 10, Code: 8, Comment: 1, Blank: 1, Complexity: 2, CognitiveComplexity: 3, WeightedComplexity: 25,
+		Functions: []FunctionJob{{Name: "main", StartLine: 1, EndLine: 5, Lines: 5, Code: 4, Complexity: 2, CognitiveComplexity: 3}}}
+	inputChan <- &FileJob{Language: "Python", Location: "pkg/__init__.py", Filename: "__init__.py", Bytes: 10, Lines: 1, Code: 1}
+	close(inputChan)
+	return inputChan
+}
+
+func resetMarkdown() {
+	Files = false
+	More = false
+	ByFunction = false
+	Complexity = false
+	Cocomo = false
+	Size = false
+	SortBy = ""
+}
+
+func TestMarkdownEscape(t *testing.T) {
+	if got := markdownEscape(`a|b*c_d\e` + "`f`" + `[g]<h>`); got != `a\|b\*c\_d\\e`+"\\`f\\`"+`\[g\]\<h\>` {
+		t.Errorf("unexpected escape %s", got)
+	}
+}
+
+func TestToMarkdown(t *testing.T) {
+	resetMarkdown()
+	defer resetMarkdown()
+
+	res := toMarkdown(markdownInput())
+
+	expected := `| Language | Files | Lines | Blanks | Comments | Code | Complexity |
+| :--- | ---: | ---: | ---: | ---: | ---: | ---: |
+| Go | 1 | 10 | 1 | 1 | 8 | 2 |
+| Python | 1 | 1 | 0 | 0 | 1 | 0 |
+| **Total** | 2 | 11 | 1 | 1 | 9 | 2 |
+
+- Estimated Cost to Develop (organic) `
+	if !strings.HasPrefix(res, expected) {
+		t.Errorf("expected %s got %s", expected, res)
+	}
+	if !strings.HasSuffix(res, "- Processed 1010 bytes, 0.001 megabytes (SI)") {
+		t.Errorf("expected size at the end got %s", res)
+	}
+}
+
+func TestToMarkdownByFile(t *testing.T) {
+	resetMarkdown()
+	defer resetMarkdown()
+	Files = true
+	Cocomo = true
+	Size = true
+	SortBy = "name"
+
+	res := toMarkdown(markdownInput())
+
+	expected := `| Language | Files | Lines | Blanks | Comments | Code | Complexity |
+| :--- | ---: | ---: | ---: | ---: | ---: | ---: |
+| **Go** | 1 | 10 | 1 | 1 | 8 | 2 |
+| a\|b/main.go |  | 10 | 1 | 1 | 8 | 2 |
+| **Python** | 1 | 1 | 0 | 0 | 1 | 0 |
+| pkg/\_\_init\_\_.py |  | 1 | 0 | 0 | 1 | 0 |
+| **Total** | 2 | 11 | 1 | 1 | 9 | 2 |`
+	if res != expected {
+		t.Errorf("expected %s got %s", expected, res)
+	}
+}
+
+func TestToMarkdownWide(t *testing.T) {
+	resetMarkdown()
+	defer resetMarkdown()
+	More = true
+	ByFunction = true
+	Cocomo = true
+	Size = true
+
+	res := toMarkdown(markdownInput())
+
+	for _, s := range []string{
+		"| Language | Files | Lines | Blanks | Comments | Code | Complexity | Cognitive | Complexity/Lines |",
+		"| Go | 1 | 10 | 1 | 1 | 8 | 2 | 3 | 25.00 |",
+		"| Function | Location | Start | Lines | Code | Complexity | Cognitive |\n| :--- | :--- | ---: |",
+		"| main | a\\|b/main.go | 1 | 5 | 4 | 2 | 3 |",
+	} {
+		if !strings.Contains(res, s) {
+			t.Errorf("expected %q in %s", s, res)
+		}
+	}
+}
+
+func TestToMarkdownNoComplexity(t *testing.T) {
+	resetMarkdown()
+	defer resetMarkdown()
+	Complexity = true
+	Cocomo = true
+	Size = true
+
+	res := toMarkdown(markdownInput())
+	if strings.Contains(res, "Complexity") || !strings.Contains(res, "| Go | 1 | 10 | 1 | 1 | 8 |\n") {
+		t.Errorf("expected no complexity column got %s", res)
+	}
+}
+
+func TestFormatNameWideMarkdown(t *testing.T) {
+	defer func() {
+		More = false
+		Format = ""
+	}()
+
+	More = true
+	Format = "md"
+	if formatName() != "md" {
+		t.Errorf("expected markdown to keep its format with --wide got %s", formatName())
+	}
+
+	Format = "json"
+	if formatName() != "wide" {
+		t.Errorf("expected --wide to replace other formats got %s", formatName())
+	}
+}
+
+func TestFileSummarizeMultiMarkdown(t *testing.T) {
+	resetMarkdown()
+	defer resetMarkdown()
+
+	path := filepath.Join(t.TempDir(), "out.md")
+	FormatMulti = "markdown:" + path + ",json:stdout"
+	defer func() { FormatMulti = "" }()
+
+	res := fileSummarize(markdownInput())
+	if !strings.Contains(res, `"Name":"Go"`) {
+		t.Errorf("expected json on stdout got %s", res)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "| **Total** | 2 | 11 | 1 | 1 | 9 | 2 |") {
+		t.Errorf("expected markdown written to file got %s", content)
+	}
+}
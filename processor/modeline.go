@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// modelineLines is how many lines at the start and end of a file are searched for a modeline
+const modelineLines = 5
+
+// modelineBytes limits how much of the start and end of a file is searched so minified files stay cheap
+const modelineBytes = 1_000
+
+// modelineLanguage sets the language of the job from an Emacs or Vim modeline returning true if it did
+func (c *Counter) modelineLanguage(job *FileJob) bool {
+	mode := modeline(job.Content)
+	if mode == "" {
+		return false
+	}
+
+	language, ok := c.resolveLanguage(mode)
+	if !ok {
+		if Verbose {
+			printWarn(fmt.Sprintf("unknown language %s in modeline for %s", mode, job.Location))
+		}
+		return false
+	}
+
+	job.Language, job.LanguageConfidence = c.determineLanguageConfidence(job.Filename, job.Language, language, job.Content)
+
+	if Verbose {
+		printWarn(fmt.Sprintf("detected modeline %s for %s", job.Language, job.Location))
+	}
+
+	return true
+}
+
+// unknownModelineLanguage finds the languages named by the modeline of a file whose extension is not known.
+// Only the start and end of the file are read so files which are not code cost little to rule out.
+func (c *Counter) unknownModelineLanguage(path string, size int64) []string {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer fd.Close()
+
+	var content []byte
+	if size <= 2*modelineBytes {
+		content, _ = io.ReadAll(io.LimitReader(fd, 2*modelineBytes))
+	} else {
+		head := make([]byte, modelineBytes)
+		tail := make([]byte, modelineBytes)
+		if _, err := fd.ReadAt(head, 0); err != nil {
+			return nil
+		}
+		if _, err := fd.ReadAt(tail, size-modelineBytes); err != nil && err != io.EOF {
+			return nil
+		}
+
+		// The line break keeps a line cut off at the end of the head apart from the start of the tail
+		content = append(append(head, '\n'), tail...)
+	}
+
+	mode := modeline(content)
+	if mode == "" {
+		return nil
+	}
+
+	language, ok := c.resolveLanguage(mode)
+	if !ok {
+		if Verbose {
+			printWarn(fmt.Sprintf("unknown language %s in modeline for %s", mode, path))
+		}
+		return nil
+	}
+
+	return language
+}
+
+// modeline returns the mode of an Emacs -*- mode: python -*- modeline in the first lines of the content
+// or the filetype of a Vim vim: set ft=ruby: modeline in the first or last lines. It is empty when there
+// is neither.
+func modeline(content []byte) string {
+	head := content
+	if len(head) > modelineBytes {
+		head = head[:modelineBytes]
+	}
+	headLines := bytes.SplitN(head, []byte("\n"), modelineLines+1)
+	if len(headLines) > modelineLines {
+		headLines = headLines[:modelineLines]
+	}
+
+	for _, line := range headLines {
+		if mode := emacsModeline(string(line)); mode != "" {
+			return mode
+		}
+		if mode := vimModeline(string(line)); mode != "" {
+			return mode
+		}
+	}
+
+	tail := bytes.TrimRight(content, "\r\n")
+	if len(tail) > modelineBytes {
+		tail = tail[len(tail)-modelineBytes:]
+	}
+	tailLines := bytes.Split(tail, []byte("\n"))
+	if len(tailLines) > modelineLines {
+		tailLines = tailLines[len(tailLines)-modelineLines:]
+	}
+
+	for _, line := range tailLines {
+		if mode := vimModeline(string(line)); mode != "" {
+			return mode
+		}
+	}
+
+	return ""
+}
+
+// emacsModeline handles both -*- python -*- and -*- mode: python; coding: utf-8 -*-
+func emacsModeline(line string) string {
+	start := strings.Index(line, "-*-")
+	if start == -1 {
+		return ""
+	}
+
+	end := strings.Index(line[start+3:], "-*-")
+	if end == -1 {
+		return ""
+	}
+
+	variables := strings.TrimSpace(line[start+3 : start+3+end])
+	if !strings.Contains(variables, ":") {
+		return variables
+	}
+
+	for _, variable := range strings.Split(variables, ";") {
+		name, value, found := strings.Cut(variable, ":")
+		if found && strings.EqualFold(strings.TrimSpace(name), "mode") {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return ""
+}
+
+// vimModeline handles both vim: ft=ruby sw=2 and vim: set filetype=ruby: where the marker, which may also
+// be vi: or ex:, must start the line or follow whitespace
+func vimModeline(line string) string {
+	for _, marker := range []string{"vim:", "Vim:", "vi:", "ex:"} {
+		for offset := 0; ; {
+			i := strings.Index(line[offset:], marker)
+			if i == -1 {
+				break
+			}
+			i += offset
+			offset = i + len(marker)
+
+			if i != 0 && line[i-1] != ' ' && line[i-1] != '\t' {
+				continue
+			}
+
+			options := strings.TrimLeft(line[offset:], " \t")
+			separators := " \t:"
+			if strings.HasPrefix(options, "set ") || strings.HasPrefix(options, "se ") {
+				// The second form ends at the next : and everything after is ignored
+				options, _, _ = strings.Cut(options[strings.IndexByte(options, ' '):], ":")
+				separators = " \t"
+			}
+
+			for _, option := range strings.FieldsFunc(options, func(r rune) bool { return strings.ContainsRune(separators, r) }) {
+				name, value, found := strings.Cut(option, "=")
+				if found && (name == "ft" || name == "filetype" || name == "syntax" || name == "syn") {
+					return value
+				}
+			}
+		}
+	}
+
+	return ""
+}
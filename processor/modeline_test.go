@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestModeline(t *testing.T) {
+	cases := []struct {
+		content  string
+		expected string
+	}{
+		{"# -*- python -*-\nprint(1)\n", "python"},
+		{"#!/bin/sh\n# -*- mode: ruby; coding: utf-8 -*-\n", "ruby"},
+		{";; -*- Mode: Emacs-Lisp; lexical-binding: t -*-\n", "Emacs-Lisp"},
+		{"# -*- coding: utf-8 -*-\nprint(1)\n", ""},
+		{"x = 1\n# vim: set ft=ruby:\n", "ruby"},
+		{"x = 1\n# vim: set ts=2 filetype=ruby : ft=python\n", "ruby"},
+		{"/* vim: ts=4 sw=4 ft=c */\nint x;\n", "c"},
+		{"# vi:syntax=sh\n", "sh"},
+		{"// ex: ft=go\n", "go"},
+		{"// taxi: ft=go\n", ""},
+		{"vim: filetype=perl\n", "perl"},
+		{"# vim: ts=2\n", ""},
+		{"no modeline here\n", ""},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := modeline([]byte(c.content)); got != c.expected {
+			t.Errorf("content %q expected %q got %q", c.content, c.expected, got)
+		}
+	}
+}
+
+func TestModelineHeadAndTail(t *testing.T) {
+	middle := strings.Repeat("code\n", 20)
+
+	if got := modeline([]byte("line\n" + middle + "# vim: ft=ruby\n\n")); got != "ruby" {
+		t.Errorf("expected Vim modeline in the last lines got %q", got)
+	}
+	if got := modeline([]byte(middle + "# -*- ruby -*-\n" + middle)); got != "" {
+		t.Errorf("expected Emacs modeline in the middle to be ignored got %q", got)
+	}
+	if got := modeline([]byte(middle + "# -*- ruby -*-\n")); got != "" {
+		t.Errorf("expected Emacs modeline at the end to be ignored got %q", got)
+	}
+	if got := modeline([]byte(strings.Repeat("x", 5_000) + "\n# vim: ft=ruby\n")); got != "ruby" {
+		t.Errorf("expected Vim modeline after a long line got %q", got)
+	}
+}
+
+func TestModelineLanguage(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+
+	job := &FileJob{Language: "Java", PossibleLanguages: []string{"Java"}, Content: []byte("// -*- C++ -*-\nint x;\n")}
+	if !counter.modelineLanguage(job) || job.Language != "C++" {
+		t.Errorf("expected C++ from the modeline got %s", job.Language)
+	}
+
+	job = &FileJob{Language: "Java", PossibleLanguages: []string{"Java"}, Content: []byte("// vim: ft=nothing\n")}
+	if counter.modelineLanguage(job) || job.Language != "Java" {
+		t.Errorf("expected unknown modeline to be ignored got %s", job.Language)
+	}
+}
+
+func TestCountFileModelinePrecedence(t *testing.T) {
+	config := NewConfig()
+	config.RemapAll = "-*- C++ -*-:C Header"
+	counter := mustNewCounter(t, config)
+
+	job := &FileJob{Filename: "a.java", Language: "Java", PossibleLanguages: []string{"Java"}, Content: []byte("// -*- C++ -*-\nint x;\n")}
+	if !counter.countFile(job) || job.Language != "C Header" {
+		t.Errorf("expected remap to win over the modeline got %s", job.Language)
+	}
+
+	job = &FileJob{Filename: "b.inc", Language: "PHP", PossibleLanguages: []string{"PHP"}, Content: []byte("// vim: ft=c\n"), languageAttribute: true}
+	if !counter.countFile(job) || job.Language != "PHP" {
+		t.Errorf("expected linguist-language to win over the modeline got %s", job.Language)
+	}
+}
+
+func TestCounterRunUnknownExtensionModeline(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"tool.weird":  "# vim: set ft=python:\nif x:\n    y()\n",
+		"long.weird":  "x = 1\n" + strings.Repeat("# padding\n", 500) + "# -*- mode: python -*-\n# vim: ft=ruby\n",
+		"notes.weird": "nothing to see here\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	config := NewConfig()
+	config.Files = true
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{dir})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	var counted []string
+	for _, language := range summary {
+		for _, f := range language.Files {
+			counted = append(counted, language.Name+" "+f.Filename)
+		}
+	}
+	sort.Strings(counted)
+	if strings.Join(counted, ",") != "Python tool.weird,Ruby long.weird" {
+		t.Errorf("expected only the files with a modeline counted got %v", counted)
+	}
+}
@@ -3,6 +3,7 @@
 package processor
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"os"
@@ -13,6 +14,8 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -64,6 +67,12 @@ var GeneratedMarkers []string
 // IgnoreGenerated ignore printing counts for generated files
 var IgnoreGenerated = false
 
+// Vendored enables vendored file detection
+var Vendored = false
+
+// IgnoreVendored ignore printing counts for vendored files
+var IgnoreVendored = false
+
 // Complexity toggles complexity calculation
 var Complexity = false
 
@@ -94,9 +103,18 @@ var GitIgnore = false
 // Ignore disables ignore file checks
 var Ignore = false
 
+// Sccrc disables .sccrc file checks
+var Sccrc = false
+
+// GitAttributes disables .gitattributes file checks
+var GitAttributes = false
+
 // DisableCheckBinary toggles checking for binary files using NUL bytes
 var DisableCheckBinary = false
 
+// GuessEncoding enables transcoding UTF-16 and UTF-32 files which have no byte order mark
+var GuessEncoding = false
+
 // SortBy sets which column output in formatter should be sorted by
 var SortBy = ""
 
@@ -162,7 +180,6 @@ var EAF float64 = 1.0
 
 // GcFileCount is the number of files to process before turning the GC back on
 var GcFileCount = 10000
-var gcPercent = -1
 var isLazy = false
 
 // NoLarge if set true will ignore files over a certain number of lines or bytes
@@ -177,6 +194,68 @@ var LargeLineCount int64 = 40000
 // LargeByteCount number of bytes before being counted as a large file based on https://github.com/pinpt/ripsrc/blob/master/ripsrc/fileinfo/fileinfo.go#L44
 var LargeByteCount int64 = 1000000
 
+// Diff compares the two supplied paths or git revisions reporting the lines added and removed
+var Diff = false
+
+// LanguagesFile is a JSON file in the same format as languages.json whose languages add to or replace the built in ones
+var LanguagesFile = ""
+
+// CacheDir is the directory used to cache counts between runs, empty disables the cache
+var CacheDir = ""
+
+// ByFunction finds the functions in each file reporting their lines and complexity
+var ByFunction = false
+
+// ByDir reports the counts for each directory rolled up to DirDepth rather than for each language
+var ByDir = false
+
+// DirDepth is how many directories below each path the counts are rolled up to with ByDir
+var DirDepth = 1
+
+// MaxComplexity is the complexity above which a file is a violation, disabled when 0
+var MaxComplexity int64 = 0
+
+// MaxLines is the number of lines above which a file is a violation, disabled when 0
+var MaxLines int64 = 0
+
+// MaxComplexityLines is the complexity for every 100 lines of code above which a file is a violation, disabled when 0
+var MaxComplexityLines float64 = 0
+
+// ViolationMinified makes any file identified as minified a violation
+var ViolationMinified = false
+
+// ViolationGenerated makes any file identified as generated a violation
+var ViolationGenerated = false
+
+// FailOnViolation exits with a non zero exit code when any file is a violation
+var FailOnViolation = false
+
+// CompareTo is a report written by --format json which the count is compared to
+var CompareTo = ""
+
+// QualityGates is the YAML file of rules every file, language and the total must keep within
+var QualityGates = ""
+
+// GitTracked counts only files tracked by git reading the index rather than walking the directories
+var GitTracked = false
+
+// GitStaged counts the staged content of files tracked by git rather than the working tree
+var GitStaged = false
+
+// GitHead counts the files and their content committed at HEAD rather than the working tree
+var GitHead = false
+
+// Timeout stops processing after the duration outputting whatever was counted so far, 0 means no timeout
+var Timeout time.Duration
+
+// runContext is the context of the command line run which the outputs check to mark partial results
+var runContext = context.Background()
+
+// incomplete is true when the run timed out so the output only has the files counted before then
+func incomplete() bool {
+	return runContext.Err() != nil
+}
+
 // DirFilePaths is not set via flags but by arguments following the flags for file or directory to process
 var DirFilePaths = []string{}
 
@@ -205,10 +284,39 @@ var startTimeMilli = makeTimestampMilli()
 // ConfigureLimits configures ulimits where possible
 var ConfigureLimits func()
 
-// ConfigureGc needs to be set outside of ProcessConstants because it should only be enabled in command line
-// mode https://github.com/boyter/scc/issues/32
-func ConfigureGc() {
-	gcPercent = debug.SetGCPercent(gcPercent)
+// ConfigureGc used to turn the GC off for the whole process.
+//
+// Deprecated: set Config.GcFileCount instead which only turns the GC off while files are being read
+// https://github.com/boyter/scc/issues/32
+func ConfigureGc() {}
+
+// gcPause tracks how many runs want the GC off so it is only turned back on once none of them do
+var gcPause struct {
+	sync.Mutex
+	runs    int
+	percent int
+}
+
+// pauseGc turns the GC off until every run which paused it has called resumeGc
+func pauseGc() {
+	gcPause.Lock()
+	defer gcPause.Unlock()
+
+	if gcPause.runs == 0 {
+		gcPause.percent = debug.SetGCPercent(-1)
+	}
+	gcPause.runs++
+}
+
+// resumeGc puts the GC back how it was before pauseGc once no other run wants it off
+func resumeGc() {
+	gcPause.Lock()
+	defer gcPause.Unlock()
+
+	gcPause.runs--
+	if gcPause.runs == 0 {
+		debug.SetGCPercent(gcPause.percent)
+	}
 }
 
 // ConfigureLazy is a simple setter used to turn on lazy loading used only by command line
@@ -217,24 +325,26 @@ func ConfigureLazy(lazy bool) {
 }
 
 // ProcessConstants is responsible for setting up the language features based on the JSON file that is stored in constants
-// Needs to be called at least once in order for anything to actually happen
+// Needs to be called at least once in order for anything to actually happen. If LanguagesFile cannot be loaded the
+// error is printed and only the built in languages are used, NewCounter returns the error instead.
 func ProcessConstants() {
-	languageDatabase = loadDatabase()
-
-	startTime := makeTimestampNano()
-	for name, value := range languageDatabase {
-		for _, ext := range value.Extensions {
-			ExtensionToLanguage[ext] = append(ExtensionToLanguage[ext], name)
-		}
-
-		for _, fname := range value.FileNames {
-			FilenameToLanguage[fname] = name
-		}
+	if err := processConstants(LanguagesFile); err != nil {
+		printError(err.Error())
+		_ = processConstants("")
+	}
+}
 
-		if len(value.SheBangs) != 0 {
-			ShebangLookup[name] = value.SheBangs
-		}
+// processConstants sets up the language features with the languages from the file added returning an error
+// before anything is changed if the file cannot be loaded
+func processConstants(languagesFile string) error {
+	database, err := loadDatabaseWithFile(languagesFile)
+	if err != nil {
+		return err
 	}
+	languageDatabase = database
+
+	startTime := makeTimestampNano()
+	buildLanguageLookups(languageDatabase, ExtensionToLanguage, FilenameToLanguage, ShebangLookup)
 
 	// If we have anything in CountAs set it up now
 	if len(CountAs) != 0 {
@@ -245,7 +355,37 @@ func ProcessConstants() {
 		printTrace(fmt.Sprintf("nanoseconds build extension to language: %d", makeTimestampNano()-startTime))
 	}
 
-	// Configure COCOMO setting
+	configureCocomo()
+
+	// If lazy is set then we want to load in the features as we find them not in one go
+	// however otherwise being used as a library so just load them all in
+	if !isLazy {
+		startTime = makeTimestampMilli()
+		for name, value := range languageDatabase {
+			processLanguageFeature(name, value)
+		}
+
+		if Trace {
+			printTrace(fmt.Sprintf("milliseconds build language features: %d", makeTimestampMilli()-startTime))
+		}
+	} else {
+		if Trace {
+			printTrace("configured to lazy load language features")
+		}
+	}
+
+	// Fix for https://github.com/boyter/scc/issues/250
+	fixedPath := []string{}
+	for _, path := range PathDenyList {
+		fixedPath = append(fixedPath, strings.TrimRight(path, "/"))
+	}
+	PathDenyList = fixedPath
+
+	return nil
+}
+
+// configureCocomo sets up a custom COCOMO project type falling back to organic if it cannot be parsed
+func configureCocomo() {
 	_, ok := projectType[strings.ToLower(CocomoProjectType)]
 	if !ok {
 		// lets see if we can turn it into a custom one
@@ -268,107 +408,120 @@ func ProcessConstants() {
 			CocomoProjectType = "organic"
 		}
 	}
+}
 
-	// If lazy is set then we want to load in the features as we find them not in one go
-	// however otherwise being used as a library so just load them all in
-	if !isLazy {
-		startTime = makeTimestampMilli()
-		for name, value := range languageDatabase {
-			processLanguageFeature(name, value)
-		}
+// Configure and setup any count-as params the use has supplied
+func setupCountAs() {
+	applyCountAs(CountAs, languageDatabase, ExtensionToLanguage)
+}
 
-		if Trace {
-			printTrace(fmt.Sprintf("milliseconds build language features: %d", makeTimestampMilli()-startTime))
+// buildLanguageLookups populates the extension, filename and #! lookups from the language database
+func buildLanguageLookups(database map[string]Language, extensionToLanguage map[string][]string, filenameToLanguage map[string]string, shebangLookup map[string][]string) {
+	for name, value := range database {
+		for _, ext := range value.Extensions {
+			extensionToLanguage[ext] = append(extensionToLanguage[ext], name)
 		}
-	} else {
-		if Trace {
-			printTrace("configured to lazy load language features")
+
+		for _, fname := range value.FileNames {
+			filenameToLanguage[fname] = name
 		}
-	}
 
-	// Fix for https://github.com/boyter/scc/issues/250
-	fixedPath := []string{}
-	for _, path := range PathDenyList {
-		fixedPath = append(fixedPath, strings.TrimRight(path, "/"))
+		if len(value.SheBangs) != 0 {
+			shebangLookup[name] = value.SheBangs
+		}
 	}
-	PathDenyList = fixedPath
 }
 
-// Configure and setup any count-as params the use has supplied
-func setupCountAs() {
-	for _, s := range strings.Split(CountAs, ",") {
+func applyCountAs(countAs string, database map[string]Language, extensionToLanguage map[string][]string) {
+	for _, s := range strings.Split(countAs, ",") {
 		t := strings.Split(s, ":")
 		if len(t) == 2 {
-
-			identified := false
-
-			// There are two cases here.
-			// first is they provide the name e.g. "Cargo Lock"
-			// second is that the user supplies the extension EG wsdl
-			// we should support BOTH cases
 			// always remember we only need to validate t[1] as that's the one
 			// that tells us where we are trying to map
+			target, ok := resolveCountAs(t[1], database, extensionToLanguage)
+			if ok {
+				extensionToLanguage[strings.ToLower(t[0])] = target
 
-			// See if we can identify based on language name which is the most
-			// reliable as the name should be unique
-			for name := range languageDatabase {
-				if strings.ToLower(name) == strings.ToLower(t[1]) {
-					ExtensionToLanguage[strings.ToLower(t[0])] = []string{name}
-					identified = true
-					if Debug {
-						printDebug(fmt.Sprintf("set to count extension: %s as language %s by language", t[0], name))
-					}
+				if Debug {
+					printDebug(fmt.Sprintf("set to count extension: %s as language %s", t[0], target))
 				}
 			}
+		}
+	}
+}
 
-			// If the above did not work, its a matter of extension match
-			// note that this is less reliable as some languages share extensions
-			if !identified {
-				target, ok := ExtensionToLanguage[strings.ToLower(t[1])]
-
-				if ok {
-					ExtensionToLanguage[strings.ToLower(t[0])] = target
-
-					if Debug {
-						printDebug(fmt.Sprintf("set to count extension: %s as language %s by extension", t[0], target))
-					}
-				}
-			}
+// resolveCountAs works out the languages that something should be counted as.
+// There are two cases here.
+// first is they provide the name e.g. "Cargo Lock"
+// second is that the user supplies the extension EG wsdl
+// we should support BOTH cases
+func resolveCountAs(target string, database map[string]Language, extensionToLanguage map[string][]string) ([]string, bool) {
+	// See if we can identify based on language name which is the most
+	// reliable as the name should be unique
+	for name := range database {
+		if strings.ToLower(name) == strings.ToLower(target) {
+			return []string{name}, true
 		}
 	}
+
+	// If the above did not work, its a matter of extension match
+	// note that this is less reliable as some languages share extensions
+	language, ok := extensionToLanguage[strings.ToLower(target)]
+	return language, ok
 }
 
 // LoadLanguageFeature will load a single feature as requested given the name
 func LoadLanguageFeature(loadName string) {
-	if !isLazy {
+	globalCounter().loadLanguageFeature(loadName)
+}
+
+func (c *Counter) loadLanguageFeature(loadName string) {
+	if !c.lazy {
 		return
 	}
 
 	// Check if already loaded and if so return because we don't need to do it again
-	LanguageFeaturesMutex.Lock()
-	_, ok := LanguageFeatures[loadName]
-	LanguageFeaturesMutex.Unlock()
+	c.languageFeaturesMutex.Lock()
+	_, ok := c.languageFeatures[loadName]
+	c.languageFeaturesMutex.Unlock()
 	if ok {
 		return
 	}
 
-	var name string
-	var value Language
-
-	for name, value = range languageDatabase {
-		if name == loadName {
-			break
-		}
-	}
+	value := c.languageDatabase[loadName]
 
 	startTime := makeTimestampNano()
-	processLanguageFeature(loadName, value)
+	feature := buildLanguageFeature(value, c.Config.NoComplexity, c.Config.Functions)
+
+	c.languageFeaturesMutex.Lock()
+	c.languageFeatures[loadName] = feature
+	c.languageFeaturesMutex.Unlock()
+
 	if Trace {
 		printTrace(fmt.Sprintf("nanoseconds to build language %s features: %d", loadName, makeTimestampNano()-startTime))
 	}
 }
 
+// getLanguageFeature returns the features for the language which may not have been loaded yet
+func (c *Counter) getLanguageFeature(name string) LanguageFeature {
+	c.languageFeaturesMutex.Lock()
+	langFeatures := c.languageFeatures[name]
+	c.languageFeaturesMutex.Unlock()
+
+	return langFeatures
+}
+
 func processLanguageFeature(name string, value Language) {
+	feature := buildLanguageFeature(value, Complexity, ByFunction)
+
+	LanguageFeaturesMutex.Lock()
+	LanguageFeatures[name] = feature
+	LanguageFeaturesMutex.Unlock()
+}
+
+// buildLanguageFeature turns the language into the tries used for matching. Function and block tokens
+// are only added when functions are wanted as they are otherwise just more to match.
+func buildLanguageFeature(value Language, noComplexity bool, functions bool) LanguageFeature {
 	complexityTrie := &Trie{}
 	slCommentTrie := &Trie{}
 	mlCommentTrie := &Trie{}
@@ -384,11 +537,11 @@ func processLanguageFeature(name string, value Language) {
 	for _, v := range value.ComplexityChecks {
 		complexityMask |= v[0]
 		complexityTrie.Insert(TComplexity, []byte(v))
-		if !Complexity {
+		if !noComplexity {
 			tokenTrie.Insert(TComplexity, []byte(v))
 		}
 	}
-	if !Complexity {
+	if !noComplexity {
 		processMask |= complexityMask
 	}
 
@@ -413,8 +566,23 @@ func processLanguageFeature(name string, value Language) {
 	}
 	processMask |= stringMask
 
-	LanguageFeaturesMutex.Lock()
-	LanguageFeatures[name] = LanguageFeature{
+	// Blocks are tracked to find functions and to weight complexity by how deeply it is nested
+	blockStyle := ""
+	if (functions || !noComplexity) && value.BlockStyle != "" {
+		blockStyle = value.BlockStyle
+		for _, v := range value.FunctionOpeners {
+			processMask |= v[0]
+			tokenTrie.Insert(TFunction, []byte(v))
+		}
+
+		if blockStyle == BlockStyleBraces {
+			processMask |= '{' | '}'
+			tokenTrie.Insert(TBlockOpen, []byte("{"))
+			tokenTrie.Insert(TBlockClose, []byte("}"))
+		}
+	}
+
+	return LanguageFeature{
 		Complexity:            complexityTrie,
 		MultiLineComments:     mlCommentTrie,
 		SingleLineComments:    slCommentTrie,
@@ -428,8 +596,10 @@ func processLanguageFeature(name string, value Language) {
 		ProcessMask:           processMask,
 		Keywords:              value.Keywords,
 		Quotes:                value.Quotes,
+		BlockStyle:            blockStyle,
+		FunctionOpeners:       len(value.FunctionOpeners) != 0,
+		Generated:             compileGeneratedRules(value.Generated),
 	}
-	LanguageFeaturesMutex.Unlock()
 }
 
 func processFlags() {
@@ -451,14 +621,23 @@ func processFlags() {
 		Generated = true
 	}
 
-	if IgnoreMinified {
+	if IgnoreMinified || ViolationMinified {
 		Minified = true
 	}
 
-	if IgnoreGenerated {
+	if IgnoreGenerated || ViolationGenerated {
 		Generated = true
 	}
 
+	if IgnoreVendored {
+		Vendored = true
+	}
+
+	// Complexity thresholds need the complexity calculated to mean anything
+	if (MaxComplexity > 0 || MaxComplexityLines > 0) && Complexity {
+		Complexity = false
+	}
+
 	if Debug {
 		printDebug(fmt.Sprintf("Path Deny List: %v", PathDenyList))
 		printDebug(fmt.Sprintf("Sort By: %s", SortBy))
@@ -472,6 +651,7 @@ func processFlags() {
 		printDebug(fmt.Sprintf("Cocomo: %t", !Cocomo))
 		printDebug(fmt.Sprintf("Minified/Generated Detection: %t/%t", Minified, Generated))
 		printDebug(fmt.Sprintf("Ignore Minified/Generated: %t/%t", IgnoreMinified, IgnoreGenerated))
+		printDebug(fmt.Sprintf("Vendored Detection/Ignore: %t/%t", Vendored, IgnoreVendored))
 		printDebug(fmt.Sprintf("IncludeSymLinks: %t", IncludeSymLinks))
 	}
 }
@@ -498,7 +678,11 @@ func loadDatabase() map[string]Language {
 }
 
 func printLanguages() {
-	database := loadDatabase()
+	database, err := loadDatabaseWithFile(LanguagesFile)
+	if err != nil {
+		printError(err.Error())
+		os.Exit(1)
+	}
 	var names []string
 
 	for key := range database {
@@ -514,21 +698,89 @@ func printLanguages() {
 	}
 }
 
-// Process is the main entry point of the command line it sets everything up and starts running
+// Process is the main entry point of the command line it sets everything up from the flags and starts running
 func Process() {
+	ProcessConfig(FlagConfig())
+}
+
+// ProcessConfig runs the command line counting with a single Counter built from the config. The output and
+// everything else which is not counting is still controlled by the package level flags.
+func ProcessConfig(config Config) {
 	if Languages {
 		printLanguages()
 		return
 	}
 
-	ProcessConstants()
-	processFlags()
+	if config.GitStaged && config.GitHead {
+		fmt.Println(errGitModes.Error())
+		os.Exit(1)
+	}
+
+	counter, err := NewCounter(config)
+	if err != nil {
+		printError(err.Error())
+		os.Exit(1)
+	}
+	configureCocomo()
+
+	validate := validateFormats
+	switch {
+	case Diff:
+		validate = validateDiffFormat
+	case CompareTo != "":
+		validate = validateCompareFormat
+	}
+	if err := validate(); err != nil {
+		printError(err.Error())
+		os.Exit(1)
+	}
+
+	if ByDir && DirDepth < 1 {
+		printError(fmt.Sprintf("--dir-depth must be at least 1 got %d", DirDepth))
+		os.Exit(1)
+	}
+
+	var gates *qualityGates
+	if QualityGates != "" {
+		var err error
+		if gates, err = loadQualityGates(QualityGates); err != nil {
+			printError(err.Error())
+			os.Exit(1)
+		}
+
+		// Complexity needs to be calculated for any rule checking it to mean anything
+		if gates.needsComplexity() {
+			Complexity = false
+			counter.Config.NoComplexity = false
+		}
+	}
 
 	// Clean up any invalid arguments before setting everything up
 	if len(DirFilePaths) == 0 {
 		DirFilePaths = append(DirFilePaths, ".")
 	}
 
+	// The timeout covers everything including --diff
+	ctx := context.Background()
+	if Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, Timeout)
+		defer cancel()
+	}
+	runContext = ctx
+
+	// Diff accepts git revisions as well as paths so it checks them itself
+	if Diff {
+		// Neither can be checked against lines which were added or removed
+		if gates != nil || FailOnViolation {
+			printError("--quality-gates and --fail-on-violation cannot be used with --diff")
+			os.Exit(1)
+		}
+
+		processDiff(ctx, counter)
+		return
+	}
+
 	// Check if the paths or files added exist and exit if not
 	for _, f := range DirFilePaths {
 		fpath := filepath.Clean(f)
@@ -539,38 +791,80 @@ func Process() {
 		}
 	}
 
-	SortBy = strings.ToLower(SortBy)
+	SortBy = counter.Config.SortBy
 
 	if Debug {
 		printDebug(fmt.Sprintf("NumCPU: %d", runtime.NumCPU()))
 		printDebug(fmt.Sprintf("SortBy: %s", SortBy))
-		printDebug(fmt.Sprintf("PathDenyList: %v", PathDenyList))
+		printDebug(fmt.Sprintf("PathDenyList: %v", counter.Config.PathDenyList))
+	}
+
+	// Thresholds and quality gates check every file as it is counted whatever is done with them after
+	var violations int64
+	watch := func(queue chan *FileJob) chan *FileJob {
+		if FailOnViolation {
+			queue = countViolations(queue, &violations)
+		}
+		if gates != nil {
+			queue = gates.watch(queue)
+		}
+		return queue
 	}
 
-	fileListQueue := make(chan *FileJob, FileListQueueSize)             // Files ready to be read from disk
-	fileSummaryJobQueue := make(chan *FileJob, FileSummaryJobQueueSize) // Files ready to be summarised
+	if CompareTo != "" {
+		processCompare(ctx, counter, watch)
+	} else {
+		processSummary(ctx, counter, watch)
+	}
 
-	go func() {
-		directoryWalker := NewDirectoryWalker(fileListQueue)
+	gatesPassed := gates == nil || gates.report(os.Stderr)
 
-		for _, f := range DirFilePaths {
-			err := directoryWalker.Start(f)
-			if err != nil {
-				fmt.Printf("failed to walk %s: %v", f, err)
-				os.Exit(1)
-			}
-		}
+	if n := atomic.LoadInt64(&violations); n != 0 {
+		printError(fmt.Sprintf("%d files exceeded a threshold", n))
+		os.Exit(exitCodeViolation)
+	}
+
+	if !gatesPassed {
+		os.Exit(exitCodeGate)
+	}
+}
+
+// processSummary counts the paths writing them out in every format asked for
+func processSummary(ctx context.Context, counter *Counter, watch func(chan *FileJob) chan *FileJob) {
+	fileSummaryJobQueue, wait := counter.start(ctx, DirFilePaths)
+	fileSummaryJobQueue = watch(fileSummaryJobQueue)
 
-		directoryWalker.Run()
-	}()
-	go fileProcessorWorker(fileListQueue, fileSummaryJobQueue)
+	result, outputErr := summarizeOutput(fileSummaryJobQueue)
 
-	result := fileSummarize(fileSummaryJobQueue)
+	if err := wait(); err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+
+	if outputErr != nil {
+		printError(outputErr.Error())
+		os.Exit(1)
+	}
 
-	if FileOutput == "" {
+	switch {
+	case streamsOutput():
+		// Already written out as each file was counted
+		if FileOutput != "" {
+			fmt.Println("results written to " + FileOutput)
+		}
+	case FileOutput == "":
 		fmt.Println(result)
-	} else {
-		_ = os.WriteFile(FileOutput, []byte(result), 0644)
+	default:
+		if err := os.WriteFile(FileOutput, []byte(result), 0644); err != nil {
+			printError(err.Error())
+			os.Exit(1)
+		}
 		fmt.Println("results written to " + FileOutput)
 	}
+
+	// Whatever was counted before the timeout has been output but it should never be mistaken for a full count
+	if err := ctx.Err(); err != nil {
+		printError(fmt.Sprintf("%v: %v", ErrIncomplete, err))
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Formatter turns the counted files into output. Input is closed once every file has been counted and
+// must be read until then as with --format-multi every output is fed from the same pass over the files.
+type Formatter interface {
+	Format(input chan *FileJob) string
+}
+
+// StreamFormatter is a Formatter which writes its output as files arrive rather than holding everything
+// until the end such as csv-stream
+type StreamFormatter interface {
+	Formatter
+	Stream(input chan *FileJob, w io.Writer) error
+}
+
+// FormatterFunc allows an ordinary function to be used as a Formatter
+type FormatterFunc func(input chan *FileJob) string
+
+// Format calls f(input)
+func (f FormatterFunc) Format(input chan *FileJob) string {
+	return f(input)
+}
+
+// StreamFunc allows an ordinary function which writes output as files arrive to be used as a StreamFormatter
+type StreamFunc func(input chan *FileJob, w io.Writer) error
+
+// Format streams to stdout returning nothing as the output has already been written
+func (f StreamFunc) Format(input chan *FileJob) string {
+	if err := f(input, os.Stdout); err != nil {
+		printError(err.Error())
+	}
+
+	return ""
+}
+
+// Stream calls f(input, w)
+func (f StreamFunc) Stream(input chan *FileJob, w io.Writer) error {
+	return f(input, w)
+}
+
+var formatterNames []string
+var formatterRegistry = map[string]Formatter{}
+var formatterAliases = map[string]string{}
+var formatterMutex = sync.Mutex{}
+
+// RegisterFormatter makes a formatter available to --format and --format-multi using the name which is
+// case insensitive. Registering a name which already exists replaces the formatter, including the one
+// used with --by-dir. With --by-dir the formatter is given every file as it would be without it.
+func RegisterFormatter(name string, formatter Formatter) {
+	name = strings.ToLower(name)
+
+	formatterMutex.Lock()
+	defer formatterMutex.Unlock()
+
+	delete(directoryFormatterRegistry, name)
+	registerFormatter(name, formatter)
+}
+
+// registerFormatter adds a built in formatter leaving any --by-dir version of it in place
+func registerFormatter(name string, formatter Formatter) {
+	if _, ok := formatterRegistry[name]; !ok {
+		formatterNames = append(formatterNames, name)
+	}
+	formatterRegistry[name] = formatter
+}
+
+// FormatterNames returns the names of every registered formatter in the order they were registered
+func FormatterNames() []string {
+	formatterMutex.Lock()
+	defer formatterMutex.Unlock()
+
+	return append([]string{}, formatterNames...)
+}
+
+// getFormatter finds the formatter by name or alias which with --by-dir is the one for directories
+// if there is one
+func getFormatter(name string) (Formatter, bool) {
+	name = strings.ToLower(name)
+
+	formatterMutex.Lock()
+	defer formatterMutex.Unlock()
+
+	if alias, ok := formatterAliases[name]; ok {
+		name = alias
+	}
+
+	if ByDir {
+		if formatter, ok := directoryFormatterRegistry[name]; ok {
+			return formatter, true
+		}
+	}
+
+	formatter, ok := formatterRegistry[name]
+	return formatter, ok
+}
+
+func errUnknownFormat(name string) error {
+	return fmt.Errorf("unknown format %q expected one of [%s]", name, strings.Join(FormatterNames(), ", "))
+}
+
+// validateFormats checks --format and every --format-multi entry names a registered formatter
+func validateFormats() error {
+	if _, ok := getFormatter(formatName()); !ok {
+		return errUnknownFormat(formatName())
+	}
+
+	if FormatMulti == "" {
+		return nil
+	}
+
+	for _, s := range strings.Split(FormatMulti, ",") {
+		t := strings.SplitN(s, ":", 2)
+		if len(t) != 2 {
+			return fmt.Errorf("format-multi entry %q should be format:destination such as csv:file.csv or json:stdout", s)
+		}
+
+		if _, ok := getFormatter(t[0]); !ok {
+			return errUnknownFormat(t[0])
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	registerFormatter("tabular", FormatterFunc(fileSummarizeShort))
+	registerFormatter("wide", FormatterFunc(fileSummarizeLong))
+	registerFormatter("json", FormatterFunc(toJSON))
+	registerFormatter("csv", FormatterFunc(toCSV))
+	registerFormatter("csv-stream", StreamFunc(writeCSVStream))
+	registerFormatter("cloc-yaml", FormatterFunc(toClocYAML))
+	registerFormatter("html", FormatterFunc(toHtml))
+	registerFormatter("html-table", FormatterFunc(toHtmlTable))
+	registerFormatter("sql", FormatterFunc(toSql))
+	registerFormatter("sql-insert", FormatterFunc(toSqlInsert))
+	registerFormatter("openmetrics", FormatterFunc(toOpenMetrics))
+	registerFormatter("json-stream", StreamFunc(writeJSONStream))
+	registerFormatter("json2", FormatterFunc(toJSONReport))
+	registerFormatter("sarif", FormatterFunc(toSARIF))
+	registerFormatter("markdown", FormatterFunc(toMarkdown))
+
+	formatterAliases["cloc-yml"] = "cloc-yaml"
+	formatterAliases["jsonl"] = "json-stream"
+	formatterAliases["ndjson"] = "json-stream"
+	formatterAliases["md"] = "markdown"
+}
+
+// streamsOutput is true when the output of --format is written as files arrive rather than returned
+func streamsOutput() bool {
+	if FormatMulti != "" {
+		return false
+	}
+
+	formatter, ok := getFormatter(formatName())
+	if !ok {
+		return false
+	}
+
+	_, ok = formatter.(StreamFormatter)
+	return ok
+}
+
+// writeStream sends the output of a StreamFormatter to stdout or the named file. The input is always
+// drained so the workers feeding it can finish even when the file cannot be opened.
+func writeStream(formatter StreamFormatter, input chan *FileJob, destination string) error {
+	if destination == "stdout" {
+		return formatter.Stream(input, os.Stdout)
+	}
+
+	f, err := os.OpenFile(destination, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		for range input {
+		}
+		return err
+	}
+
+	if err := formatter.Stream(input, f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
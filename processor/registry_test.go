@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func countingFormatter(input chan *FileJob) string {
+	count := 0
+	for range input {
+		count++
+	}
+	return fmt.Sprintf("counted %d", count)
+}
+
+func TestRegisterFormatter(t *testing.T) {
+	RegisterFormatter("Counting", FormatterFunc(countingFormatter))
+	defer func() {
+		formatterMutex.Lock()
+		delete(formatterRegistry, "counting")
+		formatterNames = formatterNames[:len(formatterNames)-1]
+		formatterMutex.Unlock()
+	}()
+
+	if names := FormatterNames(); names[len(names)-1] != "counting" {
+		t.Errorf("expected counting to be registered got %v", names)
+	}
+
+	Format = "counting"
+	More = false
+	defer func() { Format = "" }()
+
+	inputChan := make(chan *FileJob, 2)
+	inputChan <- &FileJob{Language: "Go"}
+	inputChan <- &FileJob{Language: "Go"}
+	close(inputChan)
+
+	if res := fileSummarize(inputChan); res != "counted 2" {
+		t.Errorf("expected registered formatter to be used got %s", res)
+	}
+}
+
+func TestValidateFormats(t *testing.T) {
+	defer func() {
+		Format = ""
+		FormatMulti = ""
+	}()
+
+	cases := []struct {
+		format  string
+		multi   string
+		invalid bool
+	}{
+		{"", "", false},
+		{"JSON", "", false},
+		{"cloc-yml", "", false},
+		{"unknown", "", true},
+		{"", "csv:out.csv,json:stdout", false},
+		{"", "csv:C:\\out.csv", false},
+		{"", "csv:out.csv,unknown:stdout", true},
+		{"", "csv", true},
+	}
+
+	More = false
+	for _, tc := range cases {
+		Format = tc.format
+		FormatMulti = tc.multi
+
+		err := validateFormats()
+		if (err != nil) != tc.invalid {
+			t.Errorf("format %q multi %q expected invalid %t got %v", tc.format, tc.multi, tc.invalid, err)
+		}
+	}
+}
+
+func TestFileSummarizeMultiStream(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "out.csv")
+	FormatMulti = "csv-stream:" + csvPath + ",json:stdout"
+	defer func() { FormatMulti = "" }()
+
+	inputChan := make(chan *FileJob, 1)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", Filename: "main.go", Code: 10}
+	close(inputChan)
+
+	res := fileSummarize(inputChan)
+	if !strings.Contains(res, `"Name":"Go"`) {
+		t.Errorf("expected json on stdout got %s", res)
+	}
+
+	content, err := os.ReadFile(csvPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), `Go,"main.go","main.go",0,10,`) {
+		t.Errorf("expected csv-stream written to file got %s", content)
+	}
+}
+
+// summarizeWithin fails the test if summarizeOutput has not returned in time rather than hanging forever
+func summarizeWithin(t *testing.T, files int) (string, error) {
+	inputChan := make(chan *FileJob)
+	go func() {
+		for i := 0; i < files; i++ {
+			inputChan <- &FileJob{Language: "Go", Location: "main.go", Filename: "main.go", Code: 10}
+		}
+		close(inputChan)
+	}()
+
+	type output struct {
+		result string
+		err    error
+	}
+	done := make(chan output)
+	go func() {
+		result, err := summarizeOutput(inputChan)
+		done <- output{result, err}
+	}()
+
+	select {
+	case o := <-done:
+		return o.result, o.err
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the output to be written without blocking the files arriving")
+	}
+	return "", nil
+}
+
+func TestFileSummarizeMultiStreamUnwritable(t *testing.T) {
+	FormatMulti = "csv-stream:" + filepath.Join(t.TempDir(), "missing", "out.csv") + ",json:stdout"
+	defer func() { FormatMulti = "" }()
+
+	res, err := summarizeWithin(t, FileSummaryJobQueueSize*2)
+	if !strings.Contains(res, `"Name":"Go"`) {
+		t.Errorf("expected json on stdout got %s", res)
+	}
+	if err == nil || !strings.Contains(err.Error(), "csv-stream") {
+		t.Errorf("expected error for the csv-stream output got %v", err)
+	}
+}
+
+func TestFileSummarizeStreamUnwritable(t *testing.T) {
+	defer func() {
+		Format = ""
+		FileOutput = ""
+	}()
+
+	for _, format := range []string{"csv-stream", "json-stream"} {
+		Format = format
+		FileOutput = filepath.Join(t.TempDir(), "missing", "out")
+
+		if _, err := summarizeWithin(t, FileSummaryJobQueueSize*2); err == nil {
+			t.Errorf("expected error writing %s to a missing directory", format)
+		}
+	}
+}
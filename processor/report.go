@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// jsonReportVersion is the version of the json2 report which is described by json2.schema.json. It is
+// incremented whenever a field is removed or changes meaning so consumers can check what they are reading.
+const jsonReportVersion = 1
+
+// jsonReport is the root object written by json2
+type jsonReport struct {
+	SchemaVersion int
+	Metadata      jsonReportMetadata
+	Languages     []jsonReportLanguage
+	Total         jsonReportCounts
+	Cocomo        *jsonReportCocomo     `json:",omitempty"` // Omitted with --no-cocomo
+	Size          *jsonReportSize       `json:",omitempty"` // Omitted with --no-size
+	Directories   []jsonReportDirectory `json:",omitempty"` // Only with --by-dir
+}
+
+type jsonReportMetadata struct {
+	Version        string // Version of scc which wrote the report
+	Paths          []string
+	Flags          jsonReportFlags
+	Timestamp      string // RFC 3339 in UTC
+	ElapsedSeconds float64
+	Incomplete     bool `json:",omitempty"` // Only when --timeout stopped the count early
+}
+
+// jsonReportFlags are the options which change what is counted or estimated
+type jsonReportFlags struct {
+	ByFile            bool
+	ByFunction        bool
+	ByDir             bool
+	DirDepth          int
+	NoComplexity      bool
+	NoDuplicates      bool
+	Minified          bool
+	Generated         bool
+	IgnoreMinified    bool
+	IgnoreGenerated   bool
+	Vendored          bool
+	IgnoreVendored    bool
+	NoGitIgnore       bool
+	NoIgnore          bool
+	NoLarge           bool
+	IncludeExt        []string
+	ExcludeExt        []string
+	ExcludeDir        []string
+	NotMatch          []string
+	CountAs           string
+	SortBy            string
+	CocomoProjectType string
+	AverageWage       int64
+	Overhead          float64
+	EAF               float64
+	SizeUnit          string
+}
+
+type jsonReportCounts struct {
+	Files               int64
+	Bytes               int64
+	Lines               int64
+	Code                int64
+	Comment             int64
+	Blank               int64
+	Complexity          int64
+	CognitiveComplexity int64
+	WeightedComplexity  float64
+}
+
+type jsonReportLanguage struct {
+	Name string
+	jsonReportCounts
+	FileList []jsonReportFile `json:",omitempty"` // Only with --by-file
+}
+
+type jsonReportDirectory struct {
+	Name string
+	jsonReportCounts
+	Languages []jsonReportLanguage
+}
+
+type jsonReportFile struct {
+	Location            string
+	Filename            string
+	Bytes               int64
+	Lines               int64
+	Code                int64
+	Comment             int64
+	Blank               int64
+	Complexity          int64
+	CognitiveComplexity int64
+	WeightedComplexity  float64
+	Minified            bool
+	Generated           bool
+	Vendored            bool
+	GeneratedRule       string        `json:",omitempty"` // Only when the file was identified as generated
+	Functions           []FunctionJob `json:",omitempty"` // Only with --by-function
+	LanguageConfidence  float64       `json:",omitempty"` // Only when the language was guessed by the classifier
+}
+
+type jsonReportCocomo struct {
+	ProjectType             string
+	EstimatedEffort         float64 // Person months
+	EstimatedScheduleMonths float64
+	EstimatedPeople         float64
+	EstimatedCost           float64
+	CurrencySymbol          string
+	AverageWage             int64
+	Overhead                float64
+	EAF                     float64
+}
+
+type jsonReportSize struct {
+	Bytes     int64
+	Megabytes *float64 `json:",omitempty"` // Omitted for units which cannot be measured
+	Unit      string
+}
+
+// toJSONReport is the json2 format which unlike json wraps the languages in a versioned report along
+// with how it was produced, the totals and the COCOMO and size estimates tabular shows
+func toJSONReport(input chan *FileJob) string {
+	startTime := makeTimestampMilli()
+	aggregate := newLanguageAggregator(Files, false).consume(input)
+	jsonString, _ := json.Marshal(buildJSONReport(aggregate))
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
+	}
+
+	return string(jsonString)
+}
+
+func buildJSONReport(aggregate *languageAggregator) jsonReport {
+	report := jsonReport{
+		SchemaVersion: jsonReportVersion,
+		Metadata: jsonReportMetadata{
+			Version:        Version,
+			Paths:          append([]string{}, DirFilePaths...),
+			Flags:          reportFlags(),
+			Timestamp:      time.Now().UTC().Format(time.RFC3339),
+			ElapsedSeconds: float64(makeTimestampMilli()-startTimeMilli) * 0.001,
+			Incomplete:     incomplete(),
+		},
+		Languages: []jsonReportLanguage{},
+		Total:     reportCounts(aggregate.total),
+	}
+
+	for _, summary := range sortLanguageSummary(aggregate.summaries()) {
+		language := jsonReportLanguage{
+			Name:             summary.Name,
+			jsonReportCounts: reportCounts(summary),
+		}
+
+		for _, res := range summary.Files {
+			language.FileList = append(language.FileList, jsonReportFile{
+				Location:            res.Location,
+				Filename:            res.Filename,
+				Bytes:               res.Bytes,
+				Lines:               res.Lines,
+				Code:                res.Code,
+				Comment:             res.Comment,
+				Blank:               res.Blank,
+				Complexity:          res.Complexity,
+				CognitiveComplexity: res.CognitiveComplexity,
+				WeightedComplexity:  res.WeightedComplexity,
+				Minified:            res.Minified,
+				Generated:           res.Generated,
+				Vendored:            res.Vendored,
+				GeneratedRule:       res.GeneratedRule,
+				Functions:           res.Functions,
+				LanguageConfidence:  res.LanguageConfidence,
+			})
+		}
+
+		report.Languages = append(report.Languages, language)
+	}
+
+	if !Cocomo {
+		effort := EstimateEffort(aggregate.total.Code, EAF)
+		schedule := EstimateScheduleMonths(effort)
+
+		report.Cocomo = &jsonReportCocomo{
+			ProjectType:             CocomoProjectType,
+			EstimatedEffort:         effort,
+			EstimatedScheduleMonths: schedule,
+			EstimatedPeople:         finiteOrZero(effort / schedule), // Nothing to do when there is no code
+			EstimatedCost:           EstimateCost(effort, AverageWage, Overhead),
+			CurrencySymbol:          CurrencySymbol,
+			AverageWage:             AverageWage,
+			Overhead:                Overhead,
+			EAF:                     EAF,
+		}
+	}
+
+	if !Size {
+		size, unit, _ := sizeInMegabytes(aggregate.total.Bytes)
+		report.Size = &jsonReportSize{
+			Bytes: aggregate.total.Bytes,
+			Unit:  unit,
+		}
+		if !math.IsNaN(size) {
+			report.Size.Megabytes = &size
+		}
+	}
+
+	return report
+}
+
+func reportCounts(summary LanguageSummary) jsonReportCounts {
+	return jsonReportCounts{
+		Files:               summary.Count,
+		Bytes:               summary.Bytes,
+		Lines:               summary.Lines,
+		Code:                summary.Code,
+		Comment:             summary.Comment,
+		Blank:               summary.Blank,
+		Complexity:          summary.Complexity,
+		CognitiveComplexity: summary.CognitiveComplexity,
+		WeightedComplexity:  summary.WeightedComplexity,
+	}
+}
+
+func reportFlags() jsonReportFlags {
+	return jsonReportFlags{
+		ByFile:            Files,
+		ByFunction:        ByFunction,
+		ByDir:             ByDir,
+		DirDepth:          DirDepth,
+		NoComplexity:      Complexity,
+		NoDuplicates:      Duplicates,
+		Minified:          Minified || MinifiedGenerated,
+		Generated:         Generated || MinifiedGenerated,
+		IgnoreMinified:    IgnoreMinified || IgnoreMinifiedGenerate,
+		IgnoreGenerated:   IgnoreGenerated || IgnoreMinifiedGenerate,
+		Vendored:          Vendored || IgnoreVendored,
+		IgnoreVendored:    IgnoreVendored,
+		NoGitIgnore:       GitIgnore,
+		NoIgnore:          Ignore,
+		NoLarge:           NoLarge,
+		IncludeExt:        append([]string{}, AllowListExtensions...),
+		ExcludeExt:        append([]string{}, ExcludeListExtensions...),
+		ExcludeDir:        append([]string{}, PathDenyList...),
+		NotMatch:          append([]string{}, Exclude...),
+		CountAs:           CountAs,
+		SortBy:            SortBy,
+		CocomoProjectType: CocomoProjectType,
+		AverageWage:       AverageWage,
+		Overhead:          Overhead,
+		EAF:               EAF,
+		SizeUnit:          SizeUnit,
+	}
+}
+
+func finiteOrZero(f float64) float64 {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0
+	}
+	return f
+}
@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func reportInput() chan *FileJob {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "a.go", Filename: "a.go", Bytes: 100, Lines: 10, Code: 8, Comment: 1, Blank: 1, Complexity: 2, CognitiveComplexity: 3, WeightedComplexity: 25,
+		Functions: []FunctionJob{{Name: "main", StartLine: 1, EndLine: 5, Lines: 5, Code: 4, Complexity: 2, CognitiveComplexity: 3}}}
+	inputChan <- &FileJob{Language: "Go", Location: "b.go", Filename: "b.go", Bytes: 50, Lines: 5, Code: 4, Blank: 1, Generated: true}
+	inputChan <- &FileJob{Language: "Python", Location: "c.py", Filename: "c.py", Bytes: 10, Lines: 1, Code: 1, Minified: true}
+	close(inputChan)
+	return inputChan
+}
+
+func loadReportSchema(t *testing.T) map[string]interface{} {
+	content, err := os.ReadFile("../json2.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal(content, &schema); err != nil {
+		t.Fatal(err)
+	}
+	return schema
+}
+
+// validateSchema checks the value against the subset of JSON Schema json2.schema.json uses returning every
+// problem found
+func validateSchema(root map[string]interface{}, schema map[string]interface{}, value interface{}, path string) []string {
+	if ref, ok := schema["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/$defs/")
+		schema = root["$defs"].(map[string]interface{})[name].(map[string]interface{})
+	}
+
+	var problems []string
+	if expected, ok := schema["const"]; ok && expected != value {
+		problems = append(problems, fmt.Sprintf("%s expected %v got %v", path, expected, value))
+	}
+
+	switch schema["type"] {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return append(problems, path+" expected object")
+		}
+
+		properties, _ := schema["properties"].(map[string]interface{})
+		required, _ := schema["required"].([]interface{})
+		for _, name := range required {
+			if _, ok := object[name.(string)]; !ok {
+				problems = append(problems, fmt.Sprintf("%s missing required %s", path, name))
+			}
+		}
+
+		for name, v := range object {
+			property, ok := properties[name].(map[string]interface{})
+			if !ok {
+				if schema["additionalProperties"] == false {
+					problems = append(problems, fmt.Sprintf("%s has unexpected %s", path, name))
+				}
+				continue
+			}
+			problems = append(problems, validateSchema(root, property, v, path+"."+name)...)
+		}
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			return append(problems, path+" expected array")
+		}
+
+		items, _ := schema["items"].(map[string]interface{})
+		for i, v := range array {
+			problems = append(problems, validateSchema(root, items, v, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return append(problems, path+" expected string")
+		}
+
+		if schema["format"] == "date-time" {
+			if _, err := time.Parse(time.RFC3339, s); err != nil {
+				problems = append(problems, fmt.Sprintf("%s expected date-time got %s", path, s))
+			}
+		}
+	case "integer", "number":
+		n, ok := value.(float64)
+		if !ok {
+			return append(problems, path+" expected "+schema["type"].(string))
+		}
+
+		if schema["type"] == "integer" && n != float64(int64(n)) {
+			problems = append(problems, fmt.Sprintf("%s expected integer got %v", path, n))
+		}
+		if minimum, ok := schema["minimum"].(float64); ok && n < minimum {
+			problems = append(problems, fmt.Sprintf("%s expected at least %v got %v", path, minimum, n))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			problems = append(problems, path+" expected boolean")
+		}
+	}
+
+	return problems
+}
+
+func assertReportConforms(t *testing.T, output string) map[string]interface{} {
+	t.Helper()
+
+	var report map[string]interface{}
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		t.Fatalf("expected valid json got %s", output)
+	}
+
+	schema := loadReportSchema(t)
+	for _, problem := range validateSchema(schema, schema, report, "$") {
+		t.Error(problem)
+	}
+
+	return report
+}
+
+func TestValidateSchemaFindsProblems(t *testing.T) {
+	schema := loadReportSchema(t)
+
+	var report map[string]interface{}
+	_ = json.Unmarshal([]byte(`{"SchemaVersion":2,"Languages":{},"Total":{"Files":-1},"Extra":true}`), &report)
+
+	problems := strings.Join(validateSchema(schema, schema, report, "$"), "\n")
+	for _, expected := range []string{"$.SchemaVersion expected 1 got 2", "missing required Metadata", "$.Languages expected array", "unexpected Extra", "$.Total missing required Code", "$.Total.Files expected at least 0"} {
+		if !strings.Contains(problems, expected) {
+			t.Errorf("expected %q in %s", expected, problems)
+		}
+	}
+}
+
+func TestToJSONReportConforms(t *testing.T) {
+	DirFilePaths = []string{"."}
+	Files = false
+	Cocomo = false
+	Size = false
+	defer func() {
+		DirFilePaths = []string{}
+	}()
+
+	report := assertReportConforms(t, toJSONReport(reportInput()))
+
+	if report["SchemaVersion"] != float64(jsonReportVersion) {
+		t.Errorf("expected schema version %d got %v", jsonReportVersion, report["SchemaVersion"])
+	}
+
+	total := report["Total"].(map[string]interface{})
+	if total["Files"] != float64(3) || total["Code"] != float64(13) || total["Bytes"] != float64(160) {
+		t.Errorf("unexpected totals %v", total)
+	}
+
+	languages := report["Languages"].([]interface{})
+	if len(languages) != 2 {
+		t.Fatalf("expected 2 languages got %v", languages)
+	}
+	if _, ok := languages[0].(map[string]interface{})["FileList"]; ok {
+		t.Error("expected no file list without --by-file")
+	}
+
+	if _, ok := report["Cocomo"]; !ok {
+		t.Error("expected cocomo estimates")
+	}
+	size := report["Size"].(map[string]interface{})
+	if size["Bytes"] != float64(160) || size["Unit"] != "SI" || size["Megabytes"] != 0.00016 {
+		t.Errorf("unexpected size %v", size)
+	}
+}
+
+func TestToJSONReportByFileConforms(t *testing.T) {
+	Files = true
+	defer func() {
+		Files = false
+	}()
+
+	report := assertReportConforms(t, toJSONReport(reportInput()))
+
+	files := 0
+	for _, language := range report["Languages"].([]interface{}) {
+		files += len(language.(map[string]interface{})["FileList"].([]interface{}))
+	}
+	if files != 3 {
+		t.Errorf("expected every file listed got %d", files)
+	}
+
+	flags := report["Metadata"].(map[string]interface{})["Flags"].(map[string]interface{})
+	if flags["ByFile"] != true {
+		t.Errorf("expected ByFile flag set got %v", flags)
+	}
+}
+
+func TestToJSONReportNoEstimates(t *testing.T) {
+	Cocomo = true
+	Size = true
+	defer func() {
+		Cocomo = false
+		Size = false
+	}()
+
+	report := assertReportConforms(t, toJSONReport(reportInput()))
+
+	if _, ok := report["Cocomo"]; ok {
+		t.Error("expected no cocomo with --no-cocomo")
+	}
+	if _, ok := report["Size"]; ok {
+		t.Error("expected no size with --no-size")
+	}
+}
+
+func TestToJSONReportIncomplete(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	runContext = ctx
+	defer func() { runContext = context.Background() }()
+
+	report := assertReportConforms(t, toJSONReport(reportInput()))
+	if report["Metadata"].(map[string]interface{})["Incomplete"] != true {
+		t.Errorf("expected the report marked incomplete got %v", report["Metadata"])
+	}
+}
+
+func TestToJSONReportEmpty(t *testing.T) {
+	SizeUnit = "xkcd-imaginary"
+	Cocomo = false
+	Size = false
+	defer func() {
+		SizeUnit = "si"
+	}()
+
+	inputChan := make(chan *FileJob)
+	close(inputChan)
+
+	report := assertReportConforms(t, toJSONReport(inputChan))
+
+	cocomo := report["Cocomo"].(map[string]interface{})
+	if cocomo["EstimatedPeople"] != float64(0) || cocomo["EstimatedCost"] != float64(0) {
+		t.Errorf("expected no estimate without code got %v", cocomo)
+	}
+	if _, ok := report["Size"].(map[string]interface{})["Megabytes"]; ok {
+		t.Error("expected no megabytes for a unit which cannot be measured")
+	}
+}
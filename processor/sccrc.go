@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/boyter/scc/v3/processor/gitignore"
+	"github.com/minio/blake2b-simd"
+	"gopkg.in/yaml.v2"
+)
+
+// SccrcFilename is the name of the per directory configuration file which is picked up during the walk
+const SccrcFilename = ".sccrc"
+
+// sccrc is the YAML structure of a .sccrc file
+type sccrc struct {
+	Exclude          []string          `yaml:"exclude"`
+	CountAs          map[string]string `yaml:"count-as"`
+	GeneratedMarkers []string          `yaml:"generated-markers"`
+	RemapUnknown     *string           `yaml:"remap-unknown"`
+	RemapAll         *string           `yaml:"remap-all"`
+}
+
+// directorySettings are the settings which apply to a directory and everything below it once all
+// the .sccrc and .gitattributes files from the root of the walk down have been applied
+type directorySettings struct {
+	excludes         []*regexp.Regexp
+	countAs          map[string][]string
+	generatedMarkers []string
+	remapUnknown     string
+	remapAll         string
+	attributes       []gitignore.GitAttributes
+	attributesHashes []string // Identify the .gitattributes files so cached counts are not reused once one changes
+	fingerprint      string
+}
+
+// rootSettings are the settings from the Config which apply when no .sccrc has been found
+func (c *Counter) rootSettings() *directorySettings {
+	return &directorySettings{
+		generatedMarkers: c.Config.GeneratedMarkers,
+		remapUnknown:     c.Config.RemapUnknown,
+		remapAll:         c.Config.RemapAll,
+	}
+}
+
+// settings returns the settings that apply to the job
+func (c *Counter) settings(job *FileJob) *directorySettings {
+	if job.settings != nil {
+		return job.settings
+	}
+
+	return c.rootSettings()
+}
+
+// loadSccrc reads the .sccrc at path and applies it on top of the parent settings. Excludes are added to
+// those of the parent while everything else set in the file replaces what the parent had.
+func (c *Counter) loadSccrc(path string, parent *directorySettings) (*directorySettings, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rc sccrc
+	if err := yaml.UnmarshalStrict(data, &rc); err != nil {
+		return nil, err
+	}
+
+	if parent == nil {
+		parent = c.rootSettings()
+	}
+
+	settings := &directorySettings{
+		excludes:         append([]*regexp.Regexp{}, parent.excludes...),
+		countAs:          map[string][]string{},
+		generatedMarkers: parent.generatedMarkers,
+		remapUnknown:     parent.remapUnknown,
+		remapAll:         parent.remapAll,
+		attributes:       parent.attributes,
+		attributesHashes: parent.attributesHashes,
+	}
+
+	for _, exclude := range rc.Exclude {
+		r, err := regexp.Compile(exclude)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude %s: %v", exclude, err)
+		}
+		settings.excludes = append(settings.excludes, r)
+	}
+
+	for extension, language := range parent.countAs {
+		settings.countAs[extension] = language
+	}
+	for extension, target := range rc.CountAs {
+		language, ok := resolveCountAs(target, c.languageDatabase, c.extensionToLanguage)
+		if !ok {
+			return nil, fmt.Errorf("unable to count %s as unknown language or extension %s", extension, target)
+		}
+		settings.countAs[strings.ToLower(extension)] = language
+	}
+
+	if rc.GeneratedMarkers != nil {
+		settings.generatedMarkers = rc.GeneratedMarkers
+	}
+	if rc.RemapUnknown != nil {
+		settings.remapUnknown = *rc.RemapUnknown
+	}
+	if rc.RemapAll != nil {
+		settings.remapAll = *rc.RemapAll
+	}
+
+	settings.fingerprint = settings.computeFingerprint()
+	return settings, nil
+}
+
+// settingsFingerprint identifies the .sccrc settings the job was counted with
+func (job *FileJob) settingsFingerprint() string {
+	if job.settings == nil {
+		return ""
+	}
+
+	return job.settings.fingerprint
+}
+
+// countAsLanguage returns the languages a file should be counted as if a .sccrc has mapped its extension
+func (settings *directorySettings) countAsLanguage(name string) ([]string, bool) {
+	if settings == nil || len(settings.countAs) == 0 {
+		return nil, false
+	}
+
+	extension := getExtension(name)
+	if language, ok := settings.countAs[extension]; ok {
+		return language, true
+	}
+
+	language, ok := settings.countAs[getExtension(extension)]
+	return language, ok
+}
+
+// excluded checks the name and path against the excludes from .sccrc files
+func (settings *directorySettings) excluded(name string, path string) bool {
+	if settings == nil {
+		return false
+	}
+
+	for _, exclude := range settings.excludes {
+		if exclude.MatchString(name) || exclude.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// computeFingerprint identifies the settings which change how a file is counted so cached counts
+// are not reused once a .sccrc or .gitattributes changes
+func (settings *directorySettings) computeFingerprint() string {
+	var extensions []string
+	for extension := range settings.countAs {
+		extensions = append(extensions, extension)
+	}
+	sort.Strings(extensions)
+
+	countAs := []string{}
+	for _, extension := range extensions {
+		countAs = append(countAs, extension+":"+strings.Join(settings.countAs[extension], "|"))
+	}
+
+	data, _ := json.Marshal([]interface{}{countAs, settings.generatedMarkers, settings.remapUnknown, settings.remapAll, settings.attributesHashes})
+	sum := blake2b.Sum256(data)
+	return fmt.Sprintf("%x", sum[:8])
+}
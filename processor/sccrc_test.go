@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSccrcHierarchy(t *testing.T) {
+	config := NewConfig()
+	config.Files = true
+	config.Generated = true
+
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{"../examples/sccrc/"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	found := map[string]string{}
+	for _, l := range summary {
+		for _, f := range l.Files {
+			found[f.Filename] = f.Language
+		}
+	}
+
+	expected := map[string]string{
+		"main.wdg":   "Go",
+		"script.wdg": "Python (gen)",
+		"gen.py":     "Python (gen)",
+	}
+
+	for name, language := range expected {
+		if found[name] != language {
+			t.Errorf("expected %s to be %s got %q", name, language, found[name])
+		}
+	}
+
+	// Excluded by the root .sccrc and the sub directory .sccrc respectively
+	for _, name := range []string{"ignored.go", "script_test.py"} {
+		if _, ok := found[name]; ok {
+			t.Errorf("expected %s to be excluded", name)
+		}
+	}
+}
+
+func TestSccrcDisabled(t *testing.T) {
+	config := NewConfig()
+	config.Files = true
+	config.NoSccrc = true
+
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{"../examples/sccrc/"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	for _, l := range summary {
+		for _, f := range l.Files {
+			if strings.HasSuffix(f.Filename, ".wdg") {
+				t.Errorf("expected %s to be unknown without .sccrc", f.Filename)
+			}
+		}
+	}
+
+	if _, ok := findLanguageSummary(summary, "Go"); !ok {
+		t.Error("expected skipped/ignored.go to be counted without .sccrc")
+	}
+}
+
+func TestLoadSccrcInherits(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+	dir := t.TempDir()
+
+	parentPath := filepath.Join(dir, "parent")
+	if err := os.WriteFile(parentPath, []byte("remap-all: '\"x\":\"Go\"'\nexclude: [vendor]\ncount-as: {wdg: go}\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	childPath := filepath.Join(dir, "child")
+	if err := os.WriteFile(childPath, []byte("remap-unknown: '\"y\":\"Python\"'\nexclude: [third_party]\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	parent, err := counter.loadSccrc(parentPath, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	child, err := counter.loadSccrc(childPath, parent)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if child.remapAll != `"x":"Go"` || child.remapUnknown != `"y":"Python"` {
+		t.Errorf("expected remaps to be inherited and set got %q %q", child.remapAll, child.remapUnknown)
+	}
+
+	if !child.excluded("vendor", "a/vendor") || !child.excluded("third_party", "a/third_party") {
+		t.Error("expected excludes to stack")
+	}
+
+	if parent.excluded("third_party", "a/third_party") {
+		t.Error("expected child excludes to not leak into parent")
+	}
+
+	if language, ok := child.countAsLanguage("index.wdg"); !ok || language[0] != "Go" {
+		t.Errorf("expected count as to be inherited got %v", language)
+	}
+
+	if child.generatedMarkers[0] != "do not edit" {
+		t.Errorf("expected generated markers from the config got %v", child.generatedMarkers)
+	}
+
+	if parent.fingerprint == child.fingerprint {
+		t.Error("expected different settings to have different fingerprints")
+	}
+}
+
+func TestLoadSccrcErrors(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+
+	for _, content := range []string{
+		"unknown-key: true\n",
+		"exclude: [\"(\"]\n",
+		"count-as: {wdg: not a real language}\n",
+	} {
+		path := filepath.Join(t.TempDir(), SccrcFilename)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := counter.loadSccrc(path, nil); err == nil {
+			t.Errorf("expected error for %q", content)
+		}
+	}
+}
@@ -14,6 +14,9 @@ const (
 	TSlcomment
 	TMlcomment
 	TComplexity
+	TFunction
+	TBlockOpen
+	TBlockClose
 )
 
 // Quote is a struct which holds rules and start/end values for string quotes
@@ -26,16 +29,29 @@ type Quote struct {
 
 // Language is a struct which contains the values for each language stored in languages.json
 type Language struct {
-	LineComment      []string   `json:"line_comment"`
-	ComplexityChecks []string   `json:"complexitychecks"`
-	Extensions       []string   `json:"extensions"`
-	ExtensionFile    bool       `json:"extensionFile"`
-	MultiLine        [][]string `json:"multi_line"`
-	Quotes           []Quote    `json:"quotes"`
-	NestedMultiLine  bool       `json:"nestedmultiline"`
-	Keywords         []string   `json:"keywords"`
-	FileNames        []string   `json:"filenames"`
-	SheBangs         []string   `json:"shebangs"`
+	LineComment      []string        `json:"line_comment"`
+	ComplexityChecks []string        `json:"complexitychecks"`
+	Extensions       []string        `json:"extensions"`
+	ExtensionFile    bool            `json:"extensionFile"`
+	MultiLine        [][]string      `json:"multi_line"`
+	Quotes           []Quote         `json:"quotes"`
+	NestedMultiLine  bool            `json:"nestedmultiline"`
+	Keywords         []string        `json:"keywords"`
+	FileNames        []string        `json:"filenames"`
+	SheBangs         []string        `json:"shebangs"`
+	FunctionOpeners  []string        `json:"function_openers,omitempty"` // Tokens which start a function definition such as "func "
+	BlockStyle       string          `json:"block_style,omitempty"`      // How blocks are delimited either braces or indent
+	Generated        []GeneratedRule `json:"generated,omitempty"`        // Rules which identify generated files of this language
+}
+
+// GeneratedRule identifies a generated file. Each pattern is a regular expression and every one set must
+// match for the rule to fire.
+type GeneratedRule struct {
+	Name     string `json:"name"`
+	Filename string `json:"filename,omitempty"` // Matched against the file name
+	Marker   string `json:"marker,omitempty"`   // Matched against each line of the head of the file
+	Before   string `json:"before,omitempty"`   // Line the head ends at such as the package clause, otherwise the head is the first 1000 bytes
+	Content  string `json:"content,omitempty"`  // Matched anywhere in the file
 }
 
 // LanguageFeature is a struct which represents the conversion from Language into what is used for matching
@@ -53,6 +69,9 @@ type LanguageFeature struct {
 	ProcessMask           byte
 	Keywords              []string
 	Quotes                []Quote
+	BlockStyle            string
+	FunctionOpeners       bool // Set when the language has function openers so function bodies can be found
+	Generated             []generatedRule
 }
 
 // FileJobCallback is an interface that FileJobs can implement to get a per line callback with the line type
@@ -63,41 +82,52 @@ type FileJobCallback interface {
 
 // FileJob is a struct used to hold all of the results of processing internally before sent to the formatter
 type FileJob struct {
-	Language           string
-	PossibleLanguages  []string // Used to hold potentially more than one language which populates language when determined
-	Filename           string
-	Extension          string
-	Location           string
-	Symlocation        string
-	Content            []byte `json:"-"`
-	Bytes              int64
-	Lines              int64
-	Code               int64
-	Comment            int64
-	Blank              int64
-	Complexity         int64
-	WeightedComplexity float64
-	Hash               hash.Hash
-	Callback           FileJobCallback
-	Binary             bool
-	Minified           bool
-	Generated          bool
-	EndPoint           int
+	Language            string
+	PossibleLanguages   []string // Used to hold potentially more than one language which populates language when determined
+	Filename            string
+	Extension           string
+	Location            string
+	Symlocation         string
+	Content             []byte `json:"-"`
+	Bytes               int64
+	Lines               int64
+	Code                int64
+	Comment             int64
+	Blank               int64
+	Complexity          int64
+	WeightedComplexity  float64
+	CognitiveComplexity int64
+	Hash                hash.Hash
+	Callback            FileJobCallback
+	Binary              bool
+	Minified            bool
+	Generated           bool
+	Vendored            bool   // Set by the rules in vendor.json or linguist-vendored in .gitattributes
+	GeneratedRule       string `json:",omitempty"` // Which rule identified the file as generated
+	EndPoint            int
+	Functions           []FunctionJob          `json:",omitempty"`
+	LanguageConfidence  float64                `json:",omitempty"` // Probability the classifier gave Language when the extension is shared by several
+	modTime             int64                  // Used by the cache to know if the file has changed
+	settings            *directorySettings     // Settings from .sccrc and .gitattributes files which apply to this file if any
+	languageAttribute   bool                   // Set when linguist-language gave the language so modelines are skipped
+	readContent         func() ([]byte, error) // Reads the content from somewhere other than the file such as a git blob
+	cachedHash          []byte                 // The duplicate hash restored from the cache as Hash is only set by counting
 }
 
 // LanguageSummary is used to hold summarised results for a single language
 type LanguageSummary struct {
-	Name               string
-	Bytes              int64
-	CodeBytes          int64
-	Lines              int64
-	Code               int64
-	Comment            int64
-	Blank              int64
-	Complexity         int64
-	Count              int64
-	WeightedComplexity float64
-	Files              []*FileJob
+	Name                string
+	Bytes               int64
+	CodeBytes           int64
+	Lines               int64
+	Code                int64
+	Comment             int64
+	Blank               int64
+	Complexity          int64
+	Count               int64
+	WeightedComplexity  float64
+	CognitiveComplexity int64
+	Files               []*FileJob
 }
 
 // OpenClose is used to hold an open/close pair for matching such as multi line comments
@@ -123,6 +153,13 @@ func (c *CheckDuplicates) Add(key int64, hash []byte) {
 	}
 }
 
+// reset clears all hashes so that nothing is considered a duplicate
+func (c *CheckDuplicates) reset() {
+	c.mux.Lock()
+	c.hashes = make(map[int64][][]byte)
+	c.mux.Unlock()
+}
+
 // Check is a non thread safe check to see if the key exists already need to use mutex inside struct before calling this
 func (c *CheckDuplicates) Check(key int64, hash []byte) bool {
 	hashes, ok := c.hashes[key]
@@ -0,0 +1,286 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// exitCodeViolation is the exit code when --fail-on-violation is set and a file breaks a threshold so it
+// can be told apart from scc itself failing
+const exitCodeViolation = 2
+
+// thresholdRule is a limit set on the command line which every file is checked against
+type thresholdRule struct {
+	ID          string
+	Name        string
+	Description string
+	enabled     func() bool
+	// measure returns the value measured for the file, the limit it was checked against and if it broke it
+	measure func(res *FileJob) (float64, float64, bool)
+}
+
+// violation is a file breaking a threshold rule
+type violation struct {
+	Rule  thresholdRule
+	Value float64
+	Limit float64
+}
+
+func (v violation) message(res *FileJob) string {
+	switch v.Rule.ID {
+	case "minified":
+		return fmt.Sprintf("%s is minified", res.Location)
+	case "generated":
+		return fmt.Sprintf("%s is generated", res.Location)
+	}
+
+	return fmt.Sprintf("%s has %s of %s exceeding the limit of %s", res.Location, v.Rule.Name, formatMeasure(v.Value), formatMeasure(v.Limit))
+}
+
+func formatMeasure(f float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", f), "0"), ".")
+}
+
+var thresholdRules = []thresholdRule{
+	{
+		ID:          "max-complexity",
+		Name:        "complexity",
+		Description: "File complexity is above --max-complexity",
+		enabled:     func() bool { return MaxComplexity > 0 },
+		measure: func(res *FileJob) (float64, float64, bool) {
+			return float64(res.Complexity), float64(MaxComplexity), res.Complexity > MaxComplexity
+		},
+	},
+	{
+		ID:          "max-lines",
+		Name:        "lines",
+		Description: "File lines are above --max-lines",
+		enabled:     func() bool { return MaxLines > 0 },
+		measure: func(res *FileJob) (float64, float64, bool) {
+			return float64(res.Lines), float64(MaxLines), res.Lines > MaxLines
+		},
+	},
+	{
+		ID:          "max-complexity-lines",
+		Name:        "complexity/lines",
+		Description: "File complexity for every 100 lines of code is above --max-complexity-lines",
+		enabled:     func() bool { return MaxComplexityLines > 0 },
+		measure: func(res *FileJob) (float64, float64, bool) {
+			return res.WeightedComplexity, MaxComplexityLines, res.WeightedComplexity > MaxComplexityLines
+		},
+	},
+	{
+		ID:          "minified",
+		Name:        "minified",
+		Description: "File was identified as minified with --violation-min set",
+		enabled:     func() bool { return ViolationMinified },
+		measure: func(res *FileJob) (float64, float64, bool) {
+			return boolMeasure(res.Minified), 0, res.Minified
+		},
+	},
+	{
+		ID:          "generated",
+		Name:        "generated",
+		Description: "File was identified as generated with --violation-gen set",
+		enabled:     func() bool { return ViolationGenerated },
+		measure: func(res *FileJob) (float64, float64, bool) {
+			return boolMeasure(res.Generated), 0, res.Generated
+		},
+	},
+}
+
+func boolMeasure(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// enabledThresholdRules returns the rules set on the command line
+func enabledThresholdRules() []thresholdRule {
+	var rules []thresholdRule
+	for _, rule := range thresholdRules {
+		if rule.enabled() {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+// fileViolations checks the file against every rule set on the command line
+func fileViolations(res *FileJob, rules []thresholdRule) []violation {
+	var violations []violation
+	for _, rule := range rules {
+		if value, limit, violated := rule.measure(res); violated {
+			violations = append(violations, violation{Rule: rule, Value: value, Limit: limit})
+		}
+	}
+
+	return violations
+}
+
+// countViolations passes every file through counting those which break a threshold so --fail-on-violation
+// works whatever the output format. The count is complete once the returned channel is closed.
+func countViolations(input chan *FileJob, count *int64) chan *FileJob {
+	rules := enabledThresholdRules()
+	output := make(chan *FileJob, FileSummaryJobQueueSize)
+
+	go func() {
+		for res := range input {
+			if violations := fileViolations(res, rules); len(violations) != 0 {
+				atomic.AddInt64(count, 1)
+
+				if Verbose {
+					for _, v := range violations {
+						printWarn(v.message(res))
+					}
+				}
+			}
+			output <- res
+		}
+		close(output)
+	}()
+
+	return output
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	Name             string       `json:"name"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	RuleIndex  int                    `json:"ruleIndex"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifRegion struct {
+	StartLine int64 `json:"startLine"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// toSARIF reports every file which breaks a threshold as a SARIF 2.1.0 result for code scanning tools.
+// Results are warnings unless --fail-on-violation is set when they are errors.
+func toSARIF(input chan *FileJob) string {
+	rules := enabledThresholdRules()
+
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "scc",
+				Version:        Version,
+				InformationURI: "https://github.com/boyter/scc",
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	ruleIndex := map[string]int{}
+	for i, rule := range rules {
+		ruleIndex[rule.ID] = i
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:               rule.ID,
+			Name:             rule.Name,
+			ShortDescription: sarifMessage{Text: rule.Description},
+		})
+	}
+
+	level := "warning"
+	if FailOnViolation {
+		level = "error"
+	}
+
+	for res := range input {
+		for _, v := range fileViolations(res, rules) {
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    v.Rule.ID,
+				RuleIndex: ruleIndex[v.Rule.ID],
+				Level:     level,
+				Message:   sarifMessage{Text: v.message(res)},
+				Locations: []sarifLocation{{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: sarifURI(res.Location)},
+						Region:           sarifRegion{StartLine: 1}, // Thresholds apply to the whole file
+					},
+				}},
+				Properties: map[string]interface{}{
+					"value": v.Value,
+					"limit": v.Limit,
+				},
+			})
+		}
+	}
+
+	// Files arrive in whatever order they were counted so sort to keep the output stable between runs
+	sort.SliceStable(run.Results, func(i, j int) bool {
+		a, b := run.Results[i], run.Results[j]
+		if a.Locations[0].PhysicalLocation.ArtifactLocation.URI != b.Locations[0].PhysicalLocation.ArtifactLocation.URI {
+			return a.Locations[0].PhysicalLocation.ArtifactLocation.URI < b.Locations[0].PhysicalLocation.ArtifactLocation.URI
+		}
+		return a.RuleIndex < b.RuleIndex
+	})
+
+	jsonString, _ := json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+
+	return string(jsonString)
+}
+
+// sarifURI turns the location into a relative URI as code scanning tools expect
+func sarifURI(location string) string {
+	return strings.TrimPrefix(filepath.ToSlash(location), "./")
+}
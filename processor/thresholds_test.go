@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func resetThresholds() {
+	MaxComplexity = 0
+	MaxLines = 0
+	MaxComplexityLines = 0
+	ViolationMinified = false
+	ViolationGenerated = false
+	FailOnViolation = false
+}
+
+func TestFileViolations(t *testing.T) {
+	defer resetThresholds()
+
+	res := &FileJob{Location: "a.go", Lines: 100, Code: 50, Complexity: 10, WeightedComplexity: 20, Minified: true}
+
+	if violations := fileViolations(res, enabledThresholdRules()); len(violations) != 0 {
+		t.Errorf("expected no violations without thresholds got %v", violations)
+	}
+
+	MaxComplexity = 10
+	MaxLines = 99
+	MaxComplexityLines = 19.5
+	ViolationMinified = true
+	ViolationGenerated = true
+
+	violations := fileViolations(res, enabledThresholdRules())
+	var ids []string
+	for _, v := range violations {
+		ids = append(ids, v.Rule.ID)
+	}
+
+	expected := []string{"max-lines", "max-complexity-lines", "minified"}
+	if len(ids) != len(expected) {
+		t.Fatalf("expected %v got %v", expected, ids)
+	}
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Errorf("expected %v got %v", expected, ids)
+		}
+	}
+
+	if violations[0].Value != 100 || violations[0].Limit != 99 {
+		t.Errorf("expected measured value and limit got %v", violations[0])
+	}
+	if msg := violations[1].message(res); msg != "a.go has complexity/lines of 20 exceeding the limit of 19.5" {
+		t.Errorf("unexpected message %s", msg)
+	}
+}
+
+func TestToSARIF(t *testing.T) {
+	defer resetThresholds()
+	MaxComplexity = 5
+	MaxLines = 10
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Location: "./b.go", Lines: 20, Complexity: 6}
+	inputChan <- &FileJob{Location: "a.go", Lines: 5, Complexity: 6}
+	inputChan <- &FileJob{Location: "c.go", Lines: 5, Complexity: 1}
+	close(inputChan)
+
+	var log sarifLog
+	if err := json.Unmarshal([]byte(toSARIF(inputChan)), &log); err != nil {
+		t.Fatal(err)
+	}
+
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("expected a single 2.1.0 run got %v", log)
+	}
+
+	run := log.Runs[0]
+	if len(run.Tool.Driver.Rules) != 2 || run.Tool.Driver.Rules[0].ID != "max-complexity" || run.Tool.Driver.Rules[1].ID != "max-lines" {
+		t.Errorf("expected only the enabled rules got %v", run.Tool.Driver.Rules)
+	}
+
+	if len(run.Results) != 3 {
+		t.Fatalf("expected 3 results got %v", run.Results)
+	}
+
+	expected := []struct {
+		uri    string
+		ruleID string
+		value  float64
+	}{
+		{"a.go", "max-complexity", 6},
+		{"b.go", "max-complexity", 6},
+		{"b.go", "max-lines", 20},
+	}
+	for i, e := range expected {
+		result := run.Results[i]
+		if result.Locations[0].PhysicalLocation.ArtifactLocation.URI != e.uri || result.RuleID != e.ruleID || result.Properties["value"] != e.value {
+			t.Errorf("expected %v got %v", e, result)
+		}
+		if result.Level != "warning" {
+			t.Errorf("expected warning without --fail-on-violation got %s", result.Level)
+		}
+		if run.Tool.Driver.Rules[result.RuleIndex].ID != result.RuleID {
+			t.Errorf("rule index %d does not match %s", result.RuleIndex, result.RuleID)
+		}
+	}
+}
+
+func TestToSARIFNoViolations(t *testing.T) {
+	defer resetThresholds()
+	FailOnViolation = true
+
+	inputChan := make(chan *FileJob, 1)
+	inputChan <- &FileJob{Location: "a.go", Lines: 5000, Complexity: 500}
+	close(inputChan)
+
+	var log sarifLog
+	if err := json.Unmarshal([]byte(toSARIF(inputChan)), &log); err != nil {
+		t.Fatal(err)
+	}
+
+	if log.Runs[0].Results == nil || len(log.Runs[0].Results) != 0 {
+		t.Errorf("expected empty results without thresholds got %v", log.Runs[0].Results)
+	}
+}
+
+func TestCountViolations(t *testing.T) {
+	defer resetThresholds()
+	ViolationGenerated = true
+
+	inputChan := make(chan *FileJob, 3)
+	inputChan <- &FileJob{Location: "a.go", Generated: true}
+	inputChan <- &FileJob{Location: "b.go"}
+	inputChan <- &FileJob{Location: "c.go", Generated: true}
+	close(inputChan)
+
+	var count int64
+	passed := 0
+	for range countViolations(inputChan, &count) {
+		passed++
+	}
+
+	if passed != 3 {
+		t.Errorf("expected every file passed through got %d", passed)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 violations got %d", count)
+	}
+}
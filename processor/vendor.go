@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var vendorOnce sync.Once
+var vendorRegexp *regexp.Regexp
+
+// getVendorRegexp combines the rules from vendor.json which go generate builds into vendorRules into a
+// single regular expression the first time it is needed
+func getVendorRegexp() *regexp.Regexp {
+	vendorOnce.Do(func() {
+		data, err := base64.StdEncoding.DecodeString(vendorRules)
+		if err != nil {
+			panic(fmt.Sprintf("failed to base64 decode vendor rules: %v", err))
+		}
+
+		var rules []string
+		if err := json.Unmarshal(data, &rules); err != nil {
+			panic(fmt.Sprintf("vendor rules json invalid: %v", err))
+		}
+
+		vendorRegexp = regexp.MustCompile("(?:" + strings.Join(rules, ")|(?:") + ")")
+	})
+
+	return vendorRegexp
+}
+
+// vendored checks if the file at path is vendored. A linguist-vendored attribute decides either way,
+// otherwise the rules are matched against the path relative to where the walk started.
+func (c *Counter) vendored(settings *directorySettings, path string, relative string) bool {
+	if !c.Config.Vendored {
+		return false
+	}
+
+	if vendored, ok := settings.linguistAttribute(path, "linguist-vendored"); ok {
+		if vendored && Verbose {
+			printWarn(fmt.Sprintf("identified as vendored by %s: %s", GitAttributesFilename, path))
+		}
+		return vendored
+	}
+
+	if getVendorRegexp().MatchString(filepath.ToSlash(relative)) {
+		if Verbose {
+			printWarn(fmt.Sprintf("identified as vendored by path: %s", path))
+		}
+		return true
+	}
+
+	return false
+}
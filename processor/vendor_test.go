@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: MIT OR Unlicense
+
+package processor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVendorRules(t *testing.T) {
+	cases := map[string]bool{
+		"vendor/github.com/pkg/errors/errors.go": true,
+		"src/node_modules/left-pad/index.js":     true,
+		"lib/third_party/zlib/inflate.c":         true,
+		"static/js/jquery-3.1.1.js":              true,
+		"static/js/app.min.js":                   true,
+		"Pods/Alamofire/Source/Request.swift":    true,
+		"gradlew":                                true,
+		"main.go":                                false,
+		"processor/vendor.go":                    false,
+		"src/vendoring/plan.go":                  false,
+		"static/js/app.js":                       false,
+	}
+
+	for path, expected := range cases {
+		if got := getVendorRegexp().MatchString(path); got != expected {
+			t.Errorf("expected %v for %s got %v", expected, path, got)
+		}
+	}
+}
+
+func TestVendoredDisabled(t *testing.T) {
+	counter := mustNewCounter(t, NewConfig())
+
+	if counter.vendored(nil, "vendor/lib.go", "vendor/lib.go") {
+		t.Error("expected nothing to be vendored without Vendored set")
+	}
+}
+
+func TestVendoredRelative(t *testing.T) {
+	config := NewConfig()
+	config.Vendored = true
+	counter := mustNewCounter(t, config)
+
+	// Only the path below where the walk started counts so a checkout inside a vendor directory is not all vendored
+	if counter.vendored(nil, "/home/vendor/project/main.go", "main.go") {
+		t.Error("expected the absolute path to be ignored")
+	}
+	if !counter.vendored(nil, "/home/project/vendor/lib.go", "vendor/lib.go") {
+		t.Error("expected the relative path to be vendored")
+	}
+}
+
+func TestVendoredAttributeOverrides(t *testing.T) {
+	dir := t.TempDir()
+	_ = os.MkdirAll(filepath.Join(dir, "vendor"), 0700)
+	_ = os.MkdirAll(filepath.Join(dir, "lib"), 0700)
+	_ = os.WriteFile(filepath.Join(dir, GitAttributesFilename), []byte("vendor/ours.go -linguist-vendored\nlib/** linguist-vendored\n"), 0600)
+	_ = os.WriteFile(filepath.Join(dir, "vendor", "ours.go"), []byte("package vendor\n"), 0600)
+	_ = os.WriteFile(filepath.Join(dir, "vendor", "theirs.go"), []byte("package vendor\n"), 0600)
+	_ = os.WriteFile(filepath.Join(dir, "lib", "copied.go"), []byte("package lib\n"), 0600)
+	_ = os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0600)
+
+	config := NewConfig()
+	config.Files = true
+	config.Vendored = true
+
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{dir})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := map[string]bool{"ours.go": false, "theirs.go": true, "copied.go": true, "main.go": false}
+	for _, l := range summary {
+		for _, f := range l.Files {
+			if f.Vendored != expected[f.Filename] {
+				t.Errorf("expected vendored %v for %s got %v", expected[f.Filename], f.Filename, f.Vendored)
+			}
+		}
+	}
+
+	if s, ok := findLanguageSummary(summary, "Go (vendored)"); !ok || s.Count != 2 {
+		t.Errorf("expected 2 vendored Go files got %v", s)
+	}
+}
+
+func TestIgnoreVendored(t *testing.T) {
+	dir := t.TempDir()
+	_ = os.MkdirAll(filepath.Join(dir, "node_modules", "pad"), 0700)
+	_ = os.WriteFile(filepath.Join(dir, "node_modules", "pad", "index.js"), []byte("module.exports = 1;\n"), 0600)
+	_ = os.WriteFile(filepath.Join(dir, "app.js"), []byte("require('pad');\n"), 0600)
+
+	config := NewConfig()
+	config.IgnoreVendored = true
+
+	summary, err := mustNewCounter(t, config).Run(context.Background(), []string{dir})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(summary) != 1 || summary[0].Name != "JavaScript" || summary[0].Count != 1 {
+		t.Errorf("expected only app.js to be counted got %v", summary)
+	}
+}
+
+func TestIgnoreVendoredNotRead(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "index.js")
+	_ = os.WriteFile(path, []byte("module.exports = 1;\n"), 0600)
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := NewConfig()
+	config.IgnoreVendored = true
+	counter := mustNewCounter(t, config)
+
+	if job := counter.newFileJob(path, "node_modules/pad/index.js", "index.js", fileInfo, counter.rootSettings()); job != nil {
+		t.Errorf("expected vendored file to be skipped before it is read got %v", job.Location)
+	}
+	if job := counter.newFileJob(path, "index.js", "index.js", fileInfo, counter.rootSettings()); job == nil {
+		t.Error("expected file which is not vendored to be read")
+	}
+}
@@ -4,10 +4,10 @@ package processor
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/minio/blake2b-simd"
 	"hash"
-	"runtime/debug"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -40,7 +40,8 @@ const (
 )
 
 // ByteOrderMarks are taken from https://en.wikipedia.org/wiki/Byte_order_mark#Byte_order_marks_by_encoding
-// These indicate that we cannot count the file correctly so we can at least warn the user
+// UTF-16 and UTF-32 are transcoded to UTF-8 before counting, the others indicate that we cannot count the
+// file correctly so we can at least warn the user
 var ByteOrderMarks = [][]byte{
 	{254, 255},            // UTF-16 BE
 	{255, 254},            // UTF-16 LE
@@ -90,8 +91,8 @@ func isWhitespace(currentByte byte) bool {
 
 // Check if this file is binary by checking for nul byte and if so bail out
 // this is how GNU Grep, git and ripgrep check for binary files
-func isBinary(index int, currentByte byte) bool {
-	if index < 10000 && !DisableCheckBinary && currentByte == 0 {
+func (c *Counter) isBinary(index int, currentByte byte) bool {
+	if index < 10000 && !c.Config.DisableCheckBinary && currentByte == 0 {
 		return true
 	}
 
@@ -202,7 +203,7 @@ func docStringState(fileJob *FileJob, index int, endPoint int, stringTrie *Trie,
 	return index, currentState
 }
 
-func codeState(
+func (c *Counter) codeState(
 	fileJob *FileJob,
 	index int,
 	endPoint int,
@@ -211,6 +212,7 @@ func codeState(
 	endComments [][]byte,
 	langFeatures LanguageFeature,
 	digest *hash.Hash,
+	blocks *blockTracker,
 ) (int, int64, []byte, [][]byte, bool) {
 	// Hacky fix to https://github.com/boyter/scc/issues/181
 	if endPoint > len(fileJob.Content) {
@@ -225,13 +227,13 @@ func codeState(
 			return i, currentState, endString, endComments, false
 		}
 
-		if isBinary(i, curByte) {
+		if c.isBinary(i, curByte) {
 			fileJob.Binary = true
 			return i, currentState, endString, endComments, false
 		}
 
 		if shouldProcess(curByte, langFeatures.ProcessMask) {
-			if Duplicates {
+			if c.Config.NoDuplicates {
 				// Technically this is wrong because we skip bytes so this is not a true
 				// hash of the file contents, but for duplicate files it shouldn't matter
 				// as both will skip the same way
@@ -270,7 +272,23 @@ func codeState(
 			case TComplexity:
 				if index == 0 || isWhitespace(fileJob.Content[index-1]) {
 					fileJob.Complexity++
+					if blocks != nil {
+						fileJob.CognitiveComplexity += blocks.complexity(curByte)
+					} else {
+						fileJob.CognitiveComplexity++
+					}
+				}
+
+			case TFunction:
+				if index == 0 || isWhitespace(fileJob.Content[index-1]) {
+					blocks.opener(fileJob.Content, index+offsetJump, fileJob.Lines+1)
 				}
+
+			case TBlockOpen:
+				blocks.openBrace()
+
+			case TBlockClose:
+				blocks.closeBrace(fileJob.Lines + 1)
 			}
 		}
 	}
@@ -329,6 +347,7 @@ func blankState(
 	endComments [][]byte,
 	endString []byte,
 	langFeatures LanguageFeature,
+	blocks *blockTracker,
 ) (int, int64, []byte, [][]byte, bool) {
 	switch tokenType, offsetJump, endString := langFeatures.Tokens.Match(fileJob.Content[index:]); tokenType {
 	case TMlcomment:
@@ -359,8 +378,27 @@ func blankState(
 		currentState = SCode
 		if index == 0 || isWhitespace(fileJob.Content[index-1]) {
 			fileJob.Complexity++
+			if blocks != nil {
+				fileJob.CognitiveComplexity += blocks.complexity(fileJob.Content[index])
+			} else {
+				fileJob.CognitiveComplexity++
+			}
 		}
 
+	case TFunction:
+		currentState = SCode
+		if index == 0 || isWhitespace(fileJob.Content[index-1]) {
+			blocks.opener(fileJob.Content, index+offsetJump, fileJob.Lines+1)
+		}
+
+	case TBlockOpen:
+		currentState = SCode
+		blocks.openBrace()
+
+	case TBlockClose:
+		currentState = SCode
+		blocks.closeBrace(fileJob.Lines + 1)
+
 	default:
 		currentState = SCode
 	}
@@ -402,24 +440,31 @@ func verifyIgnoreEscape(langFeatures LanguageFeature, fileJob *FileJob, index in
 // Newlines belong to the line they started on so a file of \n means only 1 line
 // This is the 'hot' path for the application and needs to be as fast as possible
 func CountStats(fileJob *FileJob) {
+	globalCounter().CountStats(fileJob)
+}
+
+// CountStats will process the fileJob using the configuration of the Counter
+// the fileJob needs to have its Language, Content and Bytes set before being called
+func (c *Counter) CountStats(fileJob *FileJob) {
+	config := &c.Config
+
 	// For determining duplicates we need the below. The reason for creating
 	// the byte array here is to avoid GC pressure. MD5 is in the standard library
 	// and is fast enough to not warrant murmur3 hashing. No need to be
 	// crypto secure here either so no need to eat the performance cost of a better
 	// hash method
-	if Duplicates {
+	if config.NoDuplicates {
 		fileJob.Hash = blake2b.New256()
 	}
 
-	// If the file has a length of 0 it is is empty then we say it has no lines
-	if fileJob.Bytes == 0 {
+	// If the file has a length of 0 it is is empty then we say it has no lines. The content is used
+	// rather than the bytes as it may have been transcoded to UTF-8 from what is on disk
+	if fileJob.Bytes == 0 || len(fileJob.Content) == 0 {
 		fileJob.Lines = 0
 		return
 	}
 
-	LanguageFeaturesMutex.Lock()
-	langFeatures := LanguageFeatures[fileJob.Language]
-	LanguageFeaturesMutex.Unlock()
+	langFeatures := c.getLanguageFeature(fileJob.Language)
 
 	if langFeatures.Complexity == nil {
 		langFeatures.Complexity = &Trie{}
@@ -437,7 +482,7 @@ func CountStats(fileJob *FileJob) {
 		langFeatures.Tokens = &Trie{}
 	}
 
-	endPoint := int(fileJob.Bytes - 1)
+	endPoint := len(fileJob.Content) - 1
 	currentState := SBlank
 	endComments := [][]byte{}
 	endString := []byte{}
@@ -445,7 +490,16 @@ func CountStats(fileJob *FileJob) {
 	// TODO needs to be set via langFeatures.Quotes[0].IgnoreEscape for the matching feature
 	ignoreEscape := false
 
-	for index := checkBomSkip(fileJob); index < int(fileJob.Bytes); index++ {
+	// Only set when the language has a block style and functions or complexity are wanted
+	blocks := newBlockTracker(langFeatures.BlockStyle, langFeatures.FunctionOpeners)
+	if blocks != nil && config.Functions {
+		defer func() {
+			fileJob.Functions = blocks.finish()
+		}()
+	}
+
+	lineStart := checkBomSkip(fileJob)
+	for index := lineStart; index < len(fileJob.Content); index++ {
 		// Based on our current state determine if the state should change by checking
 		// what the character is. The below is very CPU bound so need to be careful if
 		// changing anything in here and profile/measure afterwards!
@@ -454,7 +508,7 @@ func CountStats(fileJob *FileJob) {
 
 			switch currentState {
 			case SCode:
-				index, currentState, endString, endComments, ignoreEscape = codeState(
+				index, currentState, endString, endComments, ignoreEscape = c.codeState(
 					fileJob,
 					index,
 					endPoint,
@@ -463,6 +517,7 @@ func CountStats(fileJob *FileJob) {
 					endComments,
 					langFeatures,
 					&fileJob.Hash,
+					blocks,
 				)
 			case SString:
 				index, currentState = stringState(fileJob, index, endPoint, langFeatures.Strings, endString, currentState, ignoreEscape)
@@ -483,6 +538,14 @@ func CountStats(fileJob *FileJob) {
 			case SBlank, SMulticommentBlank:
 				// From blank we can move into comment, move into a multiline comment
 				// or move into code but we can only do one.
+				// Comments are not part of the structure so only code can end an indented block. This needs
+				// to happen before the line is processed so anything on it is counted in the right block.
+				if currentState == SBlank && blocks != nil && blocks.style == BlockStyleIndent {
+					if tokenType, _, _ := langFeatures.Tokens.Match(fileJob.Content[index:]); tokenType != TSlcomment && tokenType != TMlcomment {
+						blocks.lineStart(index - lineStart)
+					}
+				}
+
 				index, currentState, endString, endComments, ignoreEscape = blankState(
 					fileJob,
 					index,
@@ -491,6 +554,7 @@ func CountStats(fileJob *FileJob) {
 					endComments,
 					endString,
 					langFeatures,
+					blocks,
 				)
 			}
 		}
@@ -512,8 +576,13 @@ func CountStats(fileJob *FileJob) {
 		// we are currently in
 		if fileJob.Content[index] == '\n' || index >= endPoint {
 			fileJob.Lines++
+			lineStart = index + 1
+
+			if blocks != nil {
+				blocks.lineEnd(fileJob.Lines, currentState == SCode || currentState == SString || currentState == SCommentCode || currentState == SMulticommentCode)
+			}
 
-			if NoLarge && fileJob.Lines >= LargeLineCount {
+			if config.NoLarge && fileJob.Lines >= config.LargeLineCount {
 				// Save memory by unsetting the content as we no longer require it
 				fileJob.Content = nil
 				return
@@ -566,51 +635,47 @@ func CountStats(fileJob *FileJob) {
 		}
 	}
 
-	if Duplicates {
+	if config.NoDuplicates {
 		fileJob.Hash.Sum(nil)
 	}
 
 	isGenerated := false
 
-	if Generated {
-		headLen := 1000
-		if headLen >= len(fileJob.Content) {
-			headLen = len(fileJob.Content) - 1
-		}
-		head := bytes.ToLower(fileJob.Content[0:headLen])
-		for _, marker := range GeneratedMarkers {
-			if bytes.Contains(head, bytes.ToLower([]byte(marker))) {
-				fileJob.Generated = true
-				fileJob.Language = fileJob.Language + " (gen)"
-				isGenerated = true
+	if config.Generated {
+		if rule := c.generatedBy(fileJob, langFeatures.Generated); rule != "" {
+			isGenerated = true
+			fileJob.Generated = true
+			fileJob.GeneratedRule = rule
+			fileJob.Language = fileJob.Language + " (gen)"
 
-				if Verbose {
-					printWarn(fmt.Sprintf("%s identified as isGenerated with heading comment", fileJob.Filename))
-				}
-
-				break
+			if Verbose {
+				printWarn(fmt.Sprintf("%s identified as generated by rule %s", fileJob.Filename, rule))
 			}
 		}
 	}
 
 	// check if 0 as well to avoid divide by zero https://github.com/boyter/scc/issues/223
-	if !isGenerated && Minified && fileJob.Lines != 0 {
+	if !isGenerated && config.Minified && fileJob.Lines != 0 {
 		avgLineByteCount := len(fileJob.Content) / int(fileJob.Lines)
-		minifiedGeneratedCheck(avgLineByteCount, fileJob)
+		c.minifiedGeneratedCheck(avgLineByteCount, fileJob)
+	}
+
+	if fileJob.Vendored {
+		fileJob.Language = fileJob.Language + " (vendored)"
 	}
 }
 
-func minifiedGeneratedCheck(avgLineByteCount int, fileJob *FileJob) {
-	if avgLineByteCount >= MinifiedGeneratedLineByteLength {
+func (c *Counter) minifiedGeneratedCheck(avgLineByteCount int, fileJob *FileJob) {
+	if avgLineByteCount >= c.Config.MinifiedGeneratedLineByteLength {
 		fileJob.Minified = true
 		fileJob.Language = fileJob.Language + " (min)"
 
 		if Verbose {
-			printWarn(fmt.Sprintf("%s identified as minified/generated with average line byte length of %d >= %d", fileJob.Filename, avgLineByteCount, MinifiedGeneratedLineByteLength))
+			printWarn(fmt.Sprintf("%s identified as minified/generated with average line byte length of %d >= %d", fileJob.Filename, avgLineByteCount, c.Config.MinifiedGeneratedLineByteLength))
 		}
 	} else {
 		if Debug {
-			printDebug(fmt.Sprintf("%s not identified as minified/generated with average line byte length of %d < %d", fileJob.Filename, avgLineByteCount, MinifiedGeneratedLineByteLength))
+			printDebug(fmt.Sprintf("%s not identified as minified/generated with average line byte length of %d < %d", fileJob.Filename, avgLineByteCount, c.Config.MinifiedGeneratedLineByteLength))
 		}
 	}
 }
@@ -626,7 +691,8 @@ func checkBomSkip(fileJob *FileJob) int {
 		return 3
 	}
 
-	// If we have one of the other BOM then we might not be able to count correctly so if verbose let the user know
+	// If we have one of the other BOM then we might not be able to count correctly so if verbose let the user know.
+	// UTF-16 and UTF-32 will only be here when CountStats is called directly as countFile transcodes them.
 	if Verbose {
 		for _, v := range ByteOrderMarks {
 			if bytes.HasPrefix(fileJob.Content, v) {
@@ -641,31 +707,63 @@ func checkBomSkip(fileJob *FileJob) int {
 // Reads and processes files from input chan in parallel, and sends results to
 // output chan
 func fileProcessorWorker(input chan *FileJob, output chan *FileJob) {
+	globalCounter().fileProcessorWorker(context.Background(), nil, input, output)
+}
+
+// fileProcessorWorker reads and counts every file from input sending the results to output.
+// Once the context is done remaining input is drained without being read so nothing upstream blocks.
+// When there is a cache unchanged files are not read at all and the cache is saved once everything is done.
+func (c *Counter) fileProcessorWorker(ctx context.Context, cache *fileCache, input chan *FileJob, output chan *FileJob) {
 	var startTime int64
 	var fileCount int64
-	var gcEnabled int64
 	var wg sync.WaitGroup
 
-	for i := 0; i < FileProcessJobWorkers; i++ {
+	// The GC is turned back on once enough files have been read or everything is done whichever is first
+	var gcOnce sync.Once
+	resume := func() {}
+	if c.Config.GcFileCount > 0 {
+		pauseGc()
+		resume = func() { gcOnce.Do(resumeGc) }
+	}
+
+	for i := 0; i < c.Config.FileProcessJobWorkers; i++ {
 		wg.Add(1)
 		go func() {
 			reader := NewFileReader()
 
 			for job := range input {
+				if ctx.Err() != nil {
+					continue
+				}
+
 				atomic.CompareAndSwapInt64(&startTime, 0, makeTimestampMilli())
 
+				// Jobs with a callback need to see every line so can never come from the cache
+				useCache := cache != nil && job.Callback == nil
+				if useCache {
+					if entry, ok := cache.lookup(job); ok && c.cacheEntryUsable(entry) {
+						if c.processCacheHit(cache, job, entry) {
+							output <- job
+						}
+						continue
+					}
+				}
+
 				loc := job.Location
 				if job.Symlocation != "" {
 					loc = job.Symlocation
 				}
 
 				fileStartTime := makeTimestampNano()
-				content, err := reader.ReadFile(loc, int(job.Bytes))
-				atomic.AddInt64(&fileCount, 1)
-
-				if atomic.LoadInt64(&gcEnabled) == 0 && atomic.LoadInt64(&fileCount) >= int64(GcFileCount) {
-					debug.SetGCPercent(gcPercent)
-					atomic.AddInt64(&gcEnabled, 1)
+				var content []byte
+				var err error
+				if job.readContent != nil {
+					content, err = c.readJobContent(job)
+				} else {
+					content, err = reader.ReadFileContext(ctx, loc, int(job.Bytes))
+				}
+				if atomic.AddInt64(&fileCount, 1) == int64(c.Config.GcFileCount) {
+					resume()
 					if Verbose {
 						printWarn("read file limit exceeded GC re-enabled")
 					}
@@ -677,11 +775,22 @@ func fileProcessorWorker(input chan *FileJob, output chan *FileJob) {
 
 				if err == nil {
 					job.Content = content
-					if processFile(job) {
+
+					keep := false
+					if useCache {
+						keep = c.processFileCached(cache, job)
+					} else {
+						keep = c.processFile(job)
+					}
+
+					// Nothing after counting needs the content so drop it rather than holding every file in memory
+					job.Content = nil
+
+					if keep {
 						output <- job
 					}
 				} else {
-					if Verbose {
+					if Verbose && ctx.Err() == nil {
 						printWarn(fmt.Sprintf("error reading: %s %s", job.Location, err))
 					}
 				}
@@ -693,6 +802,14 @@ func fileProcessorWorker(input chan *FileJob, output chan *FileJob) {
 
 	go func() {
 		wg.Wait()
+		resume()
+
+		if cache != nil {
+			if err := cache.save(ctx.Err() == nil); err != nil {
+				printError(fmt.Sprintf("unable to save cache: %v", err))
+			}
+		}
+
 		close(output)
 
 		if Debug {
@@ -702,95 +819,177 @@ func fileProcessorWorker(input chan *FileJob, output chan *FileJob) {
 
 }
 
+// readJobContent reads a job which does not come from a file such as a git blob. The size is only
+// known once read so it is set here and the large file check done again.
+func (c *Counter) readJobContent(job *FileJob) ([]byte, error) {
+	content, err := job.readContent()
+	if err != nil {
+		return nil, err
+	}
+
+	job.Bytes = int64(len(content))
+	if c.Config.NoLarge && job.Bytes >= c.Config.LargeByteCount {
+		return nil, fmt.Errorf("skipping large file due to byte size")
+	}
+
+	return content, nil
+}
+
 // Process a single file
 // File must have been read to job.Content already
-func processFile(job *FileJob) bool {
+func (c *Counter) processFile(job *FileJob) bool {
 	fileStartTime := makeTimestampNano()
 
+	if !c.countFile(job) {
+		return false
+	}
+
+	var duplicateHash []byte
+	if c.Config.NoDuplicates {
+		duplicateHash = job.Hash.Sum(nil)
+	}
+	keep := c.keepFile(job, duplicateHash)
+
+	if Trace {
+		printTrace(fmt.Sprintf("nanoseconds process: %s: %d", job.Location, makeTimestampNano()-fileStartTime))
+	}
+
+	return keep
+}
+
+// processFileCached is processFile but reuses the counts from the cache when the content of the
+// file has not changed since it was cached, recording the counts in the cache otherwise
+func (c *Counter) processFileCached(cache *fileCache, job *FileJob) bool {
+	contentHash := blake2b.Sum256(job.Content)
+
+	if entry, ok := cache.lookupContent(job, contentHash[:]); ok && c.cacheEntryUsable(entry) {
+		return c.processCacheHit(cache, job, entry)
+	}
+
+	if !c.countFile(job) {
+		return false
+	}
+
+	var duplicateHash []byte
+	if c.Config.NoDuplicates {
+		duplicateHash = job.Hash.Sum(nil)
+	}
+	cache.store(job.Location, newCacheEntry(job, contentHash[:], duplicateHash))
+
+	return c.keepFile(job, duplicateHash)
+}
+
+// processCacheHit restores the counts from the cache entry without reading or counting the file
+func (c *Counter) processCacheHit(cache *fileCache, job *FileJob, entry cacheEntry) bool {
+	if Trace {
+		printTrace(fmt.Sprintf("using cached counts: %s", job.Location))
+	}
+
+	entry.apply(job)
+	cache.store(job.Location, entry)
+	return c.keepFile(job, entry.DuplicateHash)
+}
+
+// cacheEntryUsable checks the entry has everything the current configuration needs
+func (c *Counter) cacheEntryUsable(entry cacheEntry) bool {
+	return !c.Config.NoDuplicates || len(entry.DuplicateHash) != 0
+}
+
+// countFile works out the language of the file and counts it returning false if it cannot be counted
+func (c *Counter) countFile(job *FileJob) bool {
+	// Before anything else looks at the content so detection and counting all see UTF-8
+	c.transcode(job)
 	contents := job.Content
 
 	// Needs to always run to ensure the language is set
-	job.Language = DetermineLanguage(job.Filename, job.Language, job.PossibleLanguages, job.Content)
+	job.Language, job.LanguageConfidence = c.determineLanguageConfidence(job.Filename, job.Language, job.PossibleLanguages, job.Content)
 
 	remapped := false
-	if RemapAll != "" {
-		hardRemapLanguage(job)
+	settings := c.settings(job)
+	if settings.remapAll != "" {
+		remapped = c.hardRemapLanguage(job, settings.remapAll)
 	}
 
-	// If the type is #! we should check to see if we can identify
-	if job.Language == SheBang {
-		if RemapUnknown != "" {
-			remapped = unknownRemapLanguage(job)
-		}
+	if job.Language == SheBang && settings.remapUnknown != "" {
+		remapped = c.unknownRemapLanguage(job, settings.remapUnknown)
+	}
 
-		// if we didn't remap we then want to see if its a #! map
-		if remapped == false {
-			cutoff := 200
+	// A modeline is what the author says the file is so it beats the extension and #! but not a remap
+	// or linguist-language which are what the user says
+	if !remapped && !job.languageAttribute {
+		c.modelineLanguage(job)
+	}
 
-			// To avoid runtime panic check if the content we are cutting is smaller than 200
-			if len(contents) < cutoff {
-				cutoff = len(contents)
-			}
+	// If the type is still #! we should check to see if we can identify
+	if job.Language == SheBang {
+		cutoff := 200
 
-			lang, err := DetectSheBang(string(contents[:cutoff]))
-			if err != nil {
-				if Verbose {
-					printWarn(fmt.Sprintf("unable to determine #! language for %s", job.Location))
-				}
-				return false
-			}
+		// To avoid runtime panic check if the content we are cutting is smaller than 200
+		if len(contents) < cutoff {
+			cutoff = len(contents)
+		}
+
+		lang, err := c.detectSheBang(string(contents[:cutoff]))
+		if err != nil {
 			if Verbose {
-				printWarn(fmt.Sprintf("detected #! %s for %s", lang, job.Location))
+				printWarn(fmt.Sprintf("unable to determine #! language for %s", job.Location))
 			}
-
-			job.Language = lang
-			LoadLanguageFeature(lang)
+			return false
 		}
+		if Verbose {
+			printWarn(fmt.Sprintf("detected #! %s for %s", lang, job.Location))
+		}
+
+		job.Language = lang
+		c.loadLanguageFeature(lang)
 	}
 
-	CountStats(job)
+	c.CountStats(job)
+	job.WeightedComplexity = weightedComplexity(job)
+
+	return true
+}
 
-	if Duplicates {
-		duplicates.mux.Lock()
-		jobHash := job.Hash.Sum(nil)
-		if duplicates.Check(job.Bytes, jobHash) {
+// keepFile checks if the counted file should be in the results
+func (c *Counter) keepFile(job *FileJob, jobHash []byte) bool {
+	config := &c.Config
+
+	if config.NoDuplicates {
+		c.duplicates.mux.Lock()
+		if c.duplicates.Check(job.Bytes, jobHash) {
 			if Verbose {
 				printWarn(fmt.Sprintf("skipping duplicate file: %s", job.Location))
 			}
 
-			duplicates.mux.Unlock()
+			c.duplicates.mux.Unlock()
 			return false
 		}
 
-		duplicates.Add(job.Bytes, jobHash)
-		duplicates.mux.Unlock()
+		c.duplicates.Add(job.Bytes, jobHash)
+		c.duplicates.mux.Unlock()
 	}
 
-	if IgnoreMinified && job.Minified {
+	if config.IgnoreMinified && job.Minified {
 		if Verbose {
 			printWarn(fmt.Sprintf("skipping minified file: %s", job.Location))
 		}
 		return false
 	}
 
-	if IgnoreGenerated && job.Generated {
+	if config.IgnoreGenerated && job.Generated {
 		if Verbose {
 			printWarn(fmt.Sprintf("skipping generated file: %s", job.Location))
 		}
 		return false
 	}
 
-	if NoLarge && job.Lines >= LargeLineCount {
+	if config.NoLarge && job.Lines >= config.LargeLineCount {
 		if Verbose {
 			printWarn(fmt.Sprintf("skipping large file due to line length: %s", job.Location))
 		}
 		return false
 	}
 
-	if Trace {
-		printTrace(fmt.Sprintf("nanoseconds process: %s: %d", job.Location, makeTimestampNano()-fileStartTime))
-	}
-
 	if job.Binary {
 		if Verbose {
 			printWarn(fmt.Sprintf("skipping file identified as binary: %s", job.Location))
@@ -801,9 +1000,9 @@ func processFile(job *FileJob) bool {
 	return true
 }
 
-func hardRemapLanguage(job *FileJob) bool {
+func (c *Counter) hardRemapLanguage(job *FileJob, remapAll string) bool {
 	remapped := false
-	for _, s := range strings.Split(RemapAll, ",") {
+	for _, s := range strings.Split(remapAll, ",") {
 		t := strings.Split(s, ":")
 		if len(t) == 2 {
 			cutoff := 1000 // 1000 bytes into the file to look
@@ -816,6 +1015,7 @@ func hardRemapLanguage(job *FileJob) bool {
 			if strings.Contains(string(job.Content[:cutoff]), t[0]) {
 				job.Language = t[1]
 				remapped = true
+				c.loadLanguageFeature(job.Language)
 
 				if Verbose {
 					printWarn(fmt.Sprintf("hard remapping: %s to %s", job.Location, job.Language))
@@ -827,9 +1027,9 @@ func hardRemapLanguage(job *FileJob) bool {
 	return remapped
 }
 
-func unknownRemapLanguage(job *FileJob) bool {
+func (c *Counter) unknownRemapLanguage(job *FileJob, remapUnknown string) bool {
 	remapped := false
-	for _, s := range strings.Split(RemapUnknown, ",") {
+	for _, s := range strings.Split(remapUnknown, ",") {
 		t := strings.Split(s, ":")
 		if len(t) == 2 {
 			cutoff := 1000 // 1000 bytes into the file to look
@@ -846,6 +1046,7 @@ func unknownRemapLanguage(job *FileJob) bool {
 
 				job.Language = t[1]
 				remapped = true
+				c.loadLanguageFeature(job.Language)
 			}
 		}
 	}
@@ -23,7 +23,7 @@ func TestIsWhitespace(t *testing.T) {
 func TestIsBinaryTrue(t *testing.T) {
 	DisableCheckBinary = false
 
-	if !isBinary(0, 0) {
+	if !globalCounter().isBinary(0, 0) {
 		t.Errorf("Expected to be true")
 	}
 }
@@ -31,7 +31,7 @@ func TestIsBinaryTrue(t *testing.T) {
 func TestIsBinaryDisableCheck(t *testing.T) {
 	DisableCheckBinary = true
 
-	if isBinary(0, 0) {
+	if globalCounter().isBinary(0, 0) {
 		t.Errorf("Expected to be false")
 	}
 }
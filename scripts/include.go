@@ -8,11 +8,19 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 )
 
 const constantsFile = "./processor/constants.go"
 
+// classifierDirectory holds a directory named after each language containing samples of it
+const classifierDirectory = "./examples/classifier"
+
+// vendorFile holds the regular expressions for paths of vendored files
+const vendorFile = "./vendor.json"
+
 func fatalf(f string, v ...interface{}) {
 	fmt.Fprintf(os.Stderr, f+"\n", v...)
 	os.Exit(1)
@@ -59,6 +67,14 @@ func generateConstants() error {
 		}
 	}
 
+	if err := writeClassifierSamples(out); err != nil {
+		return err
+	}
+
+	if err := writeVendorRules(out); err != nil {
+		return err
+	}
+
 	// Close out constants
 	out.Write([]byte(")\n"))
 	out.Close()
@@ -70,6 +86,65 @@ func generateConstants() error {
 	return nil
 }
 
+// Reads the samples the language classifier is trained on and encodes them as
+// a JSON object of language to the content of each sample
+func writeClassifierSamples(out io.Writer) error {
+	languages, err := os.ReadDir(classifierDirectory)
+	if err != nil {
+		return fmt.Errorf("failed to read classifier samples: %v", err)
+	}
+
+	samples := map[string][]string{}
+	for _, language := range languages {
+		if !language.IsDir() {
+			continue
+		}
+
+		files, err := os.ReadDir(filepath.Join(classifierDirectory, language.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read classifier samples for '%s': %v", language.Name(), err)
+		}
+
+		for _, f := range files {
+			content, err := os.ReadFile(filepath.Join(classifierDirectory, language.Name(), f.Name()))
+			if err != nil {
+				return fmt.Errorf("failed to read classifier sample '%s': %v", f.Name(), err)
+			}
+			samples[language.Name()] = append(samples[language.Name()], string(content))
+		}
+	}
+
+	data, err := json.Marshal(samples)
+	if err != nil {
+		return fmt.Errorf("failed to encode classifier samples: %v", err)
+	}
+
+	out.Write([]byte("classifierSamples = `" + base64.StdEncoding.EncodeToString(data) + "`\n"))
+	return nil
+}
+
+// Reads the vendored path rules checking each is a valid regular expression
+func writeVendorRules(out io.Writer) error {
+	data, err := os.ReadFile(vendorFile)
+	if err != nil {
+		return fmt.Errorf("failed to read vendor rules: %v", err)
+	}
+
+	var rules []string
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return fmt.Errorf("failed to validate json in file '%s': %v", vendorFile, err)
+	}
+
+	for _, rule := range rules {
+		if _, err := regexp.Compile(rule); err != nil {
+			return fmt.Errorf("invalid vendor rule '%s': %v", rule, err)
+		}
+	}
+
+	out.Write([]byte("vendorRules = `" + base64.StdEncoding.EncodeToString(data) + "`\n"))
+	return nil
+}
+
 func main() {
 	if err := generateConstants(); err != nil {
 		fmt.Fprintf(os.Stderr, "failed to generate constants: %v\n", err)